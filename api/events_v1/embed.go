@@ -0,0 +1,9 @@
+package events_v1
+
+import _ "embed"
+
+// DescriptorSet схема событий последней выпущенной версии (FileDescriptorSet).
+// Обновляется через make events-descriptor только после проверки совместимости
+//
+//go:embed events_v1.binpb
+var DescriptorSet []byte
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// checkCompatible возвращает список несовместимых изменений схемы next относительно prev.
+// Сообщения и значения enum нельзя удалять, у полей нельзя менять номер, имя (json), тип и принадлежность oneof.
+// Удалить поле или значение можно, только зарезервировав его номер и имя
+func checkCompatible(prev, next protoreflect.FileDescriptor) []string {
+	var problems []string
+
+	msgs := prev.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		problems = append(problems, checkMessage(msgs.Get(i), next)...)
+	}
+
+	enums := prev.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		problems = append(problems, checkEnum(enums.Get(i), next)...)
+	}
+
+	return problems
+}
+
+func checkMessage(prev protoreflect.MessageDescriptor, nextFile protoreflect.FileDescriptor) []string {
+	next := findMessageDesc(nextFile, prev.FullName())
+	if next == nil {
+		return []string{fmt.Sprintf("message %s removed", prev.FullName())}
+	}
+
+	var problems []string
+	fields := prev.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		pf := fields.Get(i)
+		nf := next.Fields().ByNumber(pf.Number())
+		if nf == nil {
+			if !next.ReservedRanges().Has(pf.Number()) || !next.ReservedNames().Has(pf.Name()) {
+				problems = append(problems, fmt.Sprintf("field %s removed without reserving number and name", pf.FullName()))
+			}
+			continue
+		}
+
+		if nf.Name() != pf.Name() || nf.JSONName() != pf.JSONName() {
+			problems = append(problems, fmt.Sprintf("field %s renamed to %s", pf.FullName(), nf.Name()))
+		}
+		if nf.Kind() != pf.Kind() || nf.Cardinality() != pf.Cardinality() {
+			problems = append(problems, fmt.Sprintf("field %s changed type", pf.FullName()))
+		}
+		if pf.Message() != nil && nf.Message() != nil && pf.Message().FullName() != nf.Message().FullName() {
+			problems = append(problems, fmt.Sprintf("field %s changed message type", pf.FullName()))
+		}
+		if pf.Enum() != nil && nf.Enum() != nil && pf.Enum().FullName() != nf.Enum().FullName() {
+			problems = append(problems, fmt.Sprintf("field %s changed enum type", pf.FullName()))
+		}
+		if oneofName(pf) != oneofName(nf) {
+			problems = append(problems, fmt.Sprintf("field %s moved between oneofs", pf.FullName()))
+		}
+	}
+
+	nested := prev.Messages()
+	for i := 0; i < nested.Len(); i++ {
+		problems = append(problems, checkMessage(nested.Get(i), nextFile)...)
+	}
+
+	enums := prev.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		problems = append(problems, checkEnum(enums.Get(i), nextFile)...)
+	}
+
+	return problems
+}
+
+func checkEnum(prev protoreflect.EnumDescriptor, nextFile protoreflect.FileDescriptor) []string {
+	desc := findDesc(nextFile, prev.FullName())
+	next, ok := desc.(protoreflect.EnumDescriptor)
+	if !ok {
+		return []string{fmt.Sprintf("enum %s removed", prev.FullName())}
+	}
+
+	var problems []string
+	values := prev.Values()
+	for i := 0; i < values.Len(); i++ {
+		pv := values.Get(i)
+		nv := next.Values().ByNumber(pv.Number())
+		if nv == nil {
+			if !next.ReservedRanges().Has(pv.Number()) || !next.ReservedNames().Has(pv.Name()) {
+				problems = append(problems, fmt.Sprintf("enum value %s removed without reserving number and name", pv.FullName()))
+			}
+			continue
+		}
+
+		if nv.Name() != pv.Name() {
+			problems = append(problems, fmt.Sprintf("enum value %s renamed to %s", pv.FullName(), nv.Name()))
+		}
+	}
+
+	return problems
+}
+
+func oneofName(fd protoreflect.FieldDescriptor) protoreflect.Name {
+	if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		return oo.Name()
+	}
+
+	return ""
+}
+
+func findMessageDesc(fd protoreflect.FileDescriptor, name protoreflect.FullName) protoreflect.MessageDescriptor {
+	msg, _ := findDesc(fd, name).(protoreflect.MessageDescriptor)
+
+	return msg
+}
+
+// findDesc ищет сообщение или enum по полному имени среди объявлений файла
+func findDesc(fd protoreflect.FileDescriptor, name protoreflect.FullName) protoreflect.Descriptor {
+	var search func(msgs protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) protoreflect.Descriptor
+	search = func(msgs protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) protoreflect.Descriptor {
+		for i := 0; i < enums.Len(); i++ {
+			if enums.Get(i).FullName() == name {
+				return enums.Get(i)
+			}
+		}
+
+		for i := 0; i < msgs.Len(); i++ {
+			m := msgs.Get(i)
+			if m.FullName() == name {
+				return m
+			}
+			if d := search(m.Messages(), m.Enums()); d != nil {
+				return d
+			}
+		}
+
+		return nil
+	}
+
+	return search(fd.Messages(), fd.Enums())
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	schema "github.com/neracastle/auth/api/events_v1"
+	"github.com/neracastle/auth/pkg/events_v1"
+)
+
+// TestSchemaBackwardCompatible сравнивает текущую схему событий с выпущенной.
+// Падает, если изменение ломает чтение старых сообщений (в protobuf или json)
+func TestSchemaBackwardCompatible(t *testing.T) {
+	prev := loadPrevious(t)
+
+	require.Empty(t, checkCompatible(prev, events_v1.File_user_events_proto))
+}
+
+// TestSchemaCompatCheckDetectsBreaking проверяет, что сама проверка ловит несовместимые изменения
+func TestSchemaCompatCheckDetectsBreaking(t *testing.T) {
+	prev := loadPrevious(t)
+
+	tests := []struct {
+		name   string
+		mutate func(fd *descriptorpb.FileDescriptorProto)
+	}{
+		{
+			name: "changed field type",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				findMessage(fd, "User").Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+			},
+		},
+		{
+			name: "removed field without reserve",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				msg := findMessage(fd, "LoggedIn")
+				msg.Field = msg.Field[:1]
+			},
+		},
+		{
+			name: "renamed field",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				field := findMessage(fd, "Deleted").Field[0]
+				field.Name = proto.String("id")
+				field.JsonName = proto.String("id")
+			},
+		},
+		{
+			name: "moved field out of oneof",
+			mutate: func(fd *descriptorpb.FileDescriptorProto) {
+				//поля oneof должны идти подряд, поэтому вынесенное поле переставляем в конец
+				msg := findMessage(fd, "Envelope")
+				for i, f := range msg.Field {
+					if f.GetName() == "logged_in" {
+						f.OneofIndex = nil
+						msg.Field = append(append(msg.Field[:i:i], msg.Field[i+1:]...), f)
+						break
+					}
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fdp := protodesc.ToFileDescriptorProto(events_v1.File_user_events_proto)
+			tt.mutate(fdp)
+
+			next, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+			require.NoError(t, err)
+			require.NotEmpty(t, checkCompatible(prev, next))
+		})
+	}
+}
+
+// TestSchemaCompatAllowsReserved удаление поля допустимо, если его номер и имя зарезервированы
+func TestSchemaCompatAllowsReserved(t *testing.T) {
+	prev := loadPrevious(t)
+
+	fdp := protodesc.ToFileDescriptorProto(events_v1.File_user_events_proto)
+	msg := findMessage(fdp, "LoggedIn")
+	msg.Field = msg.Field[:1]
+	msg.ReservedRange = append(msg.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(2), End: proto.Int32(3)})
+	msg.ReservedName = append(msg.ReservedName, "method")
+	msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("ip"),
+		JsonName: proto.String("ip"),
+		Number:   proto.Int32(3),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+	})
+
+	next, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	require.Empty(t, checkCompatible(prev, next))
+}
+
+func loadPrevious(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+
+	var set descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(schema.DescriptorSet, &set))
+
+	files, err := protodesc.NewFiles(&set)
+	require.NoError(t, err)
+
+	fd, err := files.FindFileByPath(events_v1.File_user_events_proto.Path())
+	require.NoError(t, err)
+
+	return fd
+}
+
+func findMessage(fd *descriptorpb.FileDescriptorProto, name string) *descriptorpb.DescriptorProto {
+	for _, m := range fd.MessageType {
+		if m.GetName() == name {
+			return m
+		}
+	}
+
+	return nil
+}
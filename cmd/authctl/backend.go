@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// backend операции authctl над сервисом
+type backend interface {
+	// CreateAdmin создает администратора. setupToken нужен через api, пока администраторов нет
+	CreateAdmin(ctx context.Context, email, name, password, setupToken string) (int64, error)
+	ListUsers(ctx context.Context, q listQuery) (userRows, string, error)
+	SearchUsers(ctx context.Context, query string, limit uint32) (hitRows, error)
+	ResetPassword(ctx context.Context, id int64, password string) error
+	RevokeSessions(ctx context.Context, id int64) error
+	// AuditLog записи журнала, сначала последние
+	AuditLog(ctx context.Context, q auditQuery) (auditRows, error)
+	// VerifyAudit проверяет цепочку хэшей журнала
+	VerifyAudit(ctx context.Context) (auditCheck, error)
+	// ExportData json-архив персональных данных пользователя
+	ExportData(ctx context.Context, id int64) ([]byte, error)
+	// EraseUser стирает персональные данные пользователя, возвращает кол-во обезличенных записей журнала
+	EraseUser(ctx context.Context, id int64) (int64, error)
+	// BlockUser блокирует пользователя, а при ненулевом until приостанавливает до этого времени. Возвращает новый статус
+	BlockUser(ctx context.Context, id int64, reason string, until time.Time) (string, error)
+	// UnblockUser снимает блокировку, возвращает новый статус
+	UnblockUser(ctx context.Context, id int64, reason string) (string, error)
+	// ImportUsers создает пользователей из csv или jsonl
+	ImportUsers(ctx context.Context, format string, r io.Reader) (importReport, error)
+	// ExportUsers пишет всех пользователей в w в csv или jsonl
+	ExportUsers(ctx context.Context, format string, w io.Writer) error
+	Close()
+}
+
+// listQuery фильтр списка пользователей
+type listQuery struct {
+	Limit  uint32
+	Cursor string
+	// Role user, admin или пусто - все
+	Role  string
+	Query string
+}
+
+// auditQuery фильтр журнала действий
+type auditQuery struct {
+	UserID int64
+	// AfterID только записи новее этой
+	AfterID int64
+	// Limit сколько последних записей вернуть, 0 - все
+	Limit uint64
+}
+
+// auditCheck результат проверки цепочки журнала
+type auditCheck struct {
+	Checked     int64
+	Checkpoints int
+	// BrokenID первая запись, на которой цепочка не сходится, 0 - цепочка цела
+	BrokenID int64
+	Reason   string
+}
+
+// Форматы импорта и выгрузки пользователей
+const (
+	bulkCSV   = "csv"
+	bulkJSONL = "jsonl"
+)
+
+const (
+	roleUser  = "user"
+	roleAdmin = "admin"
+)
+
+func roleName(isAdmin bool) string {
+	if isAdmin {
+		return roleAdmin
+	}
+
+	return roleUser
+}
@@ -0,0 +1,657 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// newFlags набор флагов подкоманды, ошибки разбора печатает в stderr
+func newFlags(e *env, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+
+	return fs
+}
+
+func createAdmin(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "create-admin")
+	email := fs.String("email", "", "почта администратора")
+	name := fs.String("name", "", "имя")
+	password := fs.String("password", "", "пароль, если не задан - читается из stdin")
+	setupToken := fs.String("setup-token", os.Getenv("ADMIN_SETUP_TOKEN"), "токен настройки, если администраторов еще нет")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *email == "" {
+		return errUsage
+	}
+
+	pwd, err := passwordOrStdin(*password)
+	if err != nil {
+		return err
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	id, err := b.CreateAdmin(ctx, *email, *name, pwd, *setupToken)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(record{{"id", strconv.FormatInt(id, 10)}, {"email", *email}, {"role", roleAdmin}})
+}
+
+func listUsers(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "list-users")
+	role := fs.String("role", "", "фильтр по роли: user или admin")
+	query := fs.String("q", "", "подстрока почты или имени")
+	limit := fs.Uint("limit", 50, "размер страницы, не больше 100")
+	cursor := fs.String("cursor", "", "курсор следующей страницы")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *role != "" && *role != roleUser && *role != roleAdmin {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	users, next, err := b.ListUsers(ctx, listQuery{Limit: uint32(*limit), Cursor: *cursor, Role: *role, Query: *query})
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(usersPage{Users: users, NextCursor: next})
+}
+
+func searchUsers(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "search-users")
+	query := fs.String("q", "", "поисковый запрос")
+	limit := fs.Uint("limit", 20, "сколько результатов вернуть, не больше 50")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *query == "" {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	hits, err := b.SearchUsers(ctx, *query, uint32(*limit))
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(hits)
+}
+
+func resetPassword(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "reset-password")
+	id := fs.Int64("id", 0, "id пользователя")
+	password := fs.String("password", "", "новый пароль, если не задан - читается из stdin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errUsage
+	}
+
+	pwd, err := passwordOrStdin(*password)
+	if err != nil {
+		return err
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	if err = b.ResetPassword(ctx, *id, pwd); err != nil {
+		return err
+	}
+
+	return e.out.print(record{{"id", strconv.FormatInt(*id, 10)}, {"password", "reset"}, {"sessions", "revoked"}})
+}
+
+func revokeSessions(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "revoke-sessions")
+	id := fs.Int64("id", 0, "id пользователя")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	if err = b.RevokeSessions(ctx, *id); err != nil {
+		return err
+	}
+
+	return e.out.print(record{{"id", strconv.FormatInt(*id, 10)}, {"sessions", "revoked"}})
+}
+
+// blockUser блокирует пользователя или приостанавливает его на срок -for
+func blockUser(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "block-user")
+	id := fs.Int64("id", 0, "id пользователя")
+	reason := fs.String("reason", "", "причина блокировки")
+	period := fs.Duration("for", 0, "приостановить на этот срок, по умолчанию - бессрочная блокировка")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 || *reason == "" || *period < 0 {
+		return errUsage
+	}
+
+	var until time.Time
+	if *period > 0 {
+		until = time.Now().Add(*period)
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	status, err := b.BlockUser(ctx, *id, *reason, until)
+	if err != nil {
+		return err
+	}
+
+	rec := record{{"id", strconv.FormatInt(*id, 10)}, {"status", status}}
+	if !until.IsZero() {
+		rec = append(rec, [2]string{"suspended_until", formatTime(until)})
+	}
+
+	return e.out.print(rec)
+}
+
+// unblockUser снимает блокировку или приостановку пользователя
+func unblockUser(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "unblock-user")
+	id := fs.Int64("id", 0, "id пользователя")
+	reason := fs.String("reason", "", "причина разблокировки")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	status, err := b.UnblockUser(ctx, *id, *reason)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(record{{"id", strconv.FormatInt(*id, 10)}, {"status", status}})
+}
+
+// rotateKeys выдает новый ключ подписи и список прежних ключей для конфига сервиса.
+// Сами сервисы authctl не перенастраивает: значения нужно применить ко всем репликам
+func rotateKeys(_ context.Context, e *env, args []string) error {
+	fs := newFlags(e, "rotate-keys")
+	keep := fs.Int("keep", 1, "сколько прежних ключей продолжать принимать")
+	audit := fs.Bool("audit", false, "ключ подписи контрольных точек аудита вместо ключа jwt")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *keep < 0 {
+		return errUsage
+	}
+
+	newKey := make([]byte, 32)
+	if _, err := rand.Read(newKey); err != nil {
+		return err
+	}
+
+	if *audit {
+		return rotateAuditKey(e, newKey)
+	}
+
+	//текущий ключ становится первым из прежних: токены, подписанные им, принимаются до истечения срока
+	var previous []string
+	if current := os.Getenv("JWT_SECRET_KEY"); current != "" {
+		previous = append(previous, current)
+	}
+	for _, key := range strings.Split(os.Getenv("JWT_PREVIOUS_SECRET_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			previous = append(previous, key)
+		}
+	}
+	if len(previous) > *keep {
+		previous = previous[:*keep]
+	}
+
+	_, _ = fmt.Fprintln(e.stderr, "apply to every replica; tokens signed with keys no longer listed stop working")
+
+	return e.out.print(record{
+		{"JWT_SECRET_KEY", base64.RawURLEncoding.EncodeToString(newKey)},
+		{"JWT_PREVIOUS_SECRET_KEYS", strings.Join(previous, ",")},
+	})
+}
+
+// rotateAuditKey ключи аудита не отбрасываются: каждый нужен, пока в журнале есть подписанные им точки
+func rotateAuditKey(e *env, newKey []byte) error {
+	var verify []string
+	if current := os.Getenv("AUDIT_SIGNING_KEY"); current != "" {
+		verify = append(verify, current)
+	}
+	for _, key := range strings.Split(os.Getenv("AUDIT_VERIFY_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			verify = append(verify, key)
+		}
+	}
+
+	_, _ = fmt.Fprintln(e.stderr, "apply to every replica; never remove keys from AUDIT_VERIFY_KEYS, checkpoints signed with them stop verifying")
+
+	return e.out.print(record{
+		{"AUDIT_SIGNING_KEY", base64.RawURLEncoding.EncodeToString(newKey)},
+		{"AUDIT_VERIFY_KEYS", strings.Join(verify, ",")},
+	})
+}
+
+// mintToken выпускает токен локально, ключом из JWT_SECRET_KEY или -secret
+func mintToken(_ context.Context, e *env, args []string) error {
+	fs := newFlags(e, "mint-token")
+	id := fs.Int64("id", 0, "id пользователя")
+	isAdmin := fs.Bool("admin", false, "токен администратора")
+	ttl := fs.Duration("ttl", time.Hour, "срок жизни токена")
+	scope := fs.String("scope", "", "методы через запятую, по умолчанию все методы пользователя")
+	secret := fs.String("secret", os.Getenv("JWT_SECRET_KEY"), "ключ подписи")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 || *ttl <= 0 {
+		return errUsage
+	}
+	if *secret == "" {
+		return fmt.Errorf("signing key is not set: use -secret or JWT_SECRET_KEY")
+	}
+
+	user := models.FromDomainToJWT(&domain.User{ID: *id, IsAdmin: *isAdmin})
+	if *scope != "" {
+		user.Scope = strings.Split(*scope, ",")
+	}
+
+	token, err := auth.GenerateToken(user, []byte(*secret), *ttl)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(record{
+		{"token", token},
+		{"user_id", strconv.FormatInt(*id, 10)},
+		{"role", roleName(*isAdmin)},
+		{"expires_at", time.Now().Add(*ttl).Format(time.RFC3339)},
+	})
+}
+
+// auditTail выводит последние записи журнала, с -f - ждет и выводит новые
+func auditTail(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "audit-tail")
+	userID := fs.Int64("user", 0, "только действия пользователя")
+	n := fs.Uint64("n", 20, "сколько последних записей вывести")
+	follow := fs.Bool("f", false, "ждать новые записи")
+	interval := fs.Duration("interval", 2*time.Second, "как часто проверять новые записи с -f")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *n == 0 {
+		return errUsage
+	}
+
+	reqCtx, cancel := e.withTimeout(ctx)
+	rows, err := b.AuditLog(reqCtx, auditQuery{UserID: *userID, Limit: *n})
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	//журнал отдается с последних записей, а выводим в порядке совершения
+	slices.Reverse(rows)
+
+	if !*follow {
+		return e.out.print(rows)
+	}
+
+	if err = e.out.stream(rows, true); err != nil {
+		return err
+	}
+
+	var lastID int64
+	if len(rows) > 0 {
+		lastID = rows[len(rows)-1].ID
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		reqCtx, cancel = e.withTimeout(ctx)
+		rows, err = b.AuditLog(reqCtx, auditQuery{UserID: *userID, AfterID: lastID})
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		slices.Reverse(rows)
+		lastID = rows[len(rows)-1].ID
+		if err = e.out.stream(rows, false); err != nil {
+			return err
+		}
+	}
+}
+
+// auditVerify проверяет цепочку журнала, при разрыве завершается с ошибкой
+func auditVerify(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "audit-verify")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	res, err := b.VerifyAudit(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := "ok"
+	if res.BrokenID != 0 {
+		status = "broken"
+	}
+
+	err = e.out.print(record{
+		{"status", status},
+		{"checked", strconv.FormatInt(res.Checked, 10)},
+		{"checkpoints", strconv.Itoa(res.Checkpoints)},
+		{"broken_id", strconv.FormatInt(res.BrokenID, 10)},
+		{"reason", res.Reason},
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.BrokenID != 0 {
+		return fmt.Errorf("audit chain is broken at record %d", res.BrokenID)
+	}
+
+	return nil
+}
+
+// exportData пишет json-архив персональных данных пользователя в stdout или файл, формат вывода не влияет
+func exportData(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "export-data")
+	id := fs.Int64("id", 0, "id пользователя")
+	out := fs.String("out", "", "файл архива, по умолчанию stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	archive, err := b.ExportData(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = e.out.w.Write(append(archive, '\n'))
+		return err
+	}
+
+	//в архиве персональные данные: файл доступен только владельцу
+	return os.WriteFile(*out, archive, 0o600)
+}
+
+// eraseUser стирает персональные данные пользователя. Действие необратимо, поэтому нужен -confirm
+func eraseUser(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "erase-user")
+	id := fs.Int64("id", 0, "id пользователя")
+	confirm := fs.Bool("confirm", false, "подтвердить необратимое стирание")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 || !*confirm {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	redacted, err := b.EraseUser(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(record{
+		{"id", strconv.FormatInt(*id, 10)},
+		{"status", "erased"},
+		{"redacted_actions", strconv.FormatInt(redacted, 10)},
+	})
+}
+
+// importUsers создает пользователей из csv или jsonl. Формат по умолчанию берется из расширения файла.
+// Строки с ошибками пропускаются и печатаются, команда тогда завершается с ошибкой
+func importUsers(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "import-users")
+	file := fs.String("file", "", "файл импорта, - для stdin")
+	format := fs.String("format", "", "csv или jsonl, по умолчанию по расширению файла")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errUsage
+	}
+	if *format == "" {
+		*format = bulkFormatOf(*file)
+	}
+	if *format != bulkCSV && *format != bulkJSONL {
+		return errUsage
+	}
+
+	in := io.Reader(os.Stdin)
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	report, err := b.ImportUsers(ctx, *format, in)
+	if err != nil {
+		return err
+	}
+
+	if e.out.format == formatJSON {
+		err = e.out.print(report)
+	} else {
+		err = e.out.print(record{
+			{"total", strconv.FormatInt(report.Total, 10)},
+			{"imported", strconv.FormatInt(report.Imported, 10)},
+			{"failed", strconv.FormatInt(report.Failed, 10)},
+		})
+		if err == nil && len(report.Errors) > 0 {
+			_, _ = fmt.Fprintln(e.out.w)
+			err = e.out.print(report.Errors)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d rows were not imported", report.Failed, report.Total)
+	}
+
+	return nil
+}
+
+// exportUsers выгружает всех пользователей в stdout или файл, формат вывода не влияет
+func exportUsers(ctx context.Context, e *env, args []string) error {
+	fs := newFlags(e, "export-users")
+	format := fs.String("format", "", "csv или jsonl, по умолчанию по расширению файла, для stdout - csv")
+	out := fs.String("out", "", "файл выгрузки, по умолчанию stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format == "" {
+		*format = bulkFormatOf(*out)
+	}
+	if *format != bulkCSV && *format != bulkJSONL {
+		return errUsage
+	}
+
+	b, err := e.Backend(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	if *out == "" {
+		return b.ExportUsers(ctx, *format, e.out.w)
+	}
+
+	//в выгрузке персональные данные: файл доступен только владельцу
+	f, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if err = b.ExportUsers(ctx, *format, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// bulkFormatOf формат файла импорта или выгрузки по расширению, по умолчанию csv
+func bulkFormatOf(file string) string {
+	if ext := strings.ToLower(filepath.Ext(file)); ext == ".jsonl" || ext == ".ndjson" {
+		return bulkJSONL
+	}
+
+	return bulkCSV
+}
+
+// passwordOrStdin пароль из флага, а если он не задан - первая строка stdin, чтобы пароль не попадал в историю shell
+func passwordOrStdin(password string) (string, error) {
+	if password != "" {
+		return password, nil
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	password = strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("password is empty: pass -password or write it to stdin")
+	}
+
+	return password, nil
+}
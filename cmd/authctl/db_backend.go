@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/neracastle/auth/internal/app"
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// dbBackend break-glass: сценарии сервиса напрямую над его бд, от имени системного администратора
+type dbBackend struct {
+	bg *app.BreakGlass
+}
+
+func newDBBackend(ctx context.Context) *dbBackend {
+	tracer.Init(noop.NewTracerProvider().Tracer("authctl"))
+
+	return &dbBackend{bg: app.NewBreakGlass(ctx)}
+}
+
+// asAdmin контекст вызова usecase: логгер и администратор без id - оператор authctl
+func (b *dbBackend) asAdmin(ctx context.Context) context.Context {
+	ctx = logger.AssignLogger(ctx, logger.SetupLogger(logger.Disable))
+
+	return auth.AddUserToContext(ctx, auth.JWTUser{IsAdmin: true})
+}
+
+func (b *dbBackend) CreateAdmin(ctx context.Context, email, name, password, _ string) (int64, error) {
+	return b.bg.Users.Create(b.asAdmin(ctx), models.CreateDTO{
+		Email:           email,
+		Name:            name,
+		Password:        password,
+		PasswordConfirm: password,
+		IsAdmin:         true,
+	})
+}
+
+func (b *dbBackend) ListUsers(ctx context.Context, q listQuery) (userRows, string, error) {
+	req := models.ListDTO{Limit: q.Limit, Cursor: q.Cursor, Query: q.Query}
+	if q.Role != "" {
+		isAdmin := q.Role == roleAdmin
+		req.IsAdmin = &isAdmin
+	}
+
+	page, err := b.bg.Users.ListUsers(b.asAdmin(ctx), req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users := make(userRows, 0, len(page.Users))
+	for _, u := range page.Users {
+		users = append(users, userRow{
+			ID:        u.ID,
+			Email:     u.Email,
+			Name:      u.Name,
+			Role:      roleName(u.IsAdmin),
+			CreatedAt: u.CreatedAt,
+		})
+	}
+
+	return users, page.NextCursor, nil
+}
+
+func (b *dbBackend) SearchUsers(ctx context.Context, query string, limit uint32) (hitRows, error) {
+	found, err := b.bg.Users.SearchUsers(b.asAdmin(ctx), query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make(hitRows, 0, len(found))
+	for _, h := range found {
+		hits = append(hits, hitRow{ID: h.ID, Email: h.Email, Name: h.Name, Rank: h.Rank, Highlight: h.Highlight})
+	}
+
+	return hits, nil
+}
+
+func (b *dbBackend) ResetPassword(ctx context.Context, id int64, password string) error {
+	return b.bg.Users.ResetPassword(b.asAdmin(ctx), id, password, password)
+}
+
+func (b *dbBackend) RevokeSessions(ctx context.Context, id int64) error {
+	return b.bg.Users.RevokeSessions(b.asAdmin(ctx), id)
+}
+
+func (b *dbBackend) AuditLog(ctx context.Context, q auditQuery) (auditRows, error) {
+	actions, err := b.bg.Actions.List(b.asAdmin(ctx), action.Filter{
+		UserID:  q.UserID,
+		AfterID: q.AfterID,
+		Limit:   q.Limit,
+		Desc:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(auditRows, 0, len(actions))
+	for _, a := range actions {
+		rows = append(rows, auditRow{
+			ID:        a.ID,
+			UserID:    a.UserID,
+			ActorID:   a.ActorID,
+			Action:    a.Name,
+			OldValue:  a.OldValue,
+			NewValue:  a.NewValue,
+			IP:        a.IP,
+			Details:   a.Details,
+			CreatedAt: a.CreatedAt,
+		})
+	}
+
+	return rows, nil
+}
+
+func (b *dbBackend) VerifyAudit(ctx context.Context) (auditCheck, error) {
+	res, err := b.bg.Users.VerifyAuditLog(b.asAdmin(ctx))
+	if err != nil {
+		return auditCheck{}, err
+	}
+
+	return auditCheck{Checked: res.Checked, Checkpoints: res.Checkpoints, BrokenID: res.BrokenID, Reason: res.Reason}, nil
+}
+
+func (b *dbBackend) ExportData(ctx context.Context, id int64) ([]byte, error) {
+	return b.bg.Users.ExportMyData(b.asAdmin(ctx), id)
+}
+
+func (b *dbBackend) EraseUser(ctx context.Context, id int64) (int64, error) {
+	res, err := b.bg.Users.EraseUser(b.asAdmin(ctx), id)
+
+	return res.RedactedActions, err
+}
+
+func (b *dbBackend) BlockUser(ctx context.Context, id int64, reason string, until time.Time) (string, error) {
+	dto, err := b.bg.Users.BlockUser(b.asAdmin(ctx), id, reason, until)
+
+	return dto.Status, err
+}
+
+func (b *dbBackend) UnblockUser(ctx context.Context, id int64, reason string) (string, error) {
+	dto, err := b.bg.Users.UnblockUser(b.asAdmin(ctx), id, reason)
+
+	return dto.Status, err
+}
+
+func (b *dbBackend) ImportUsers(ctx context.Context, format string, r io.Reader) (importReport, error) {
+	res, err := b.bg.Users.ImportUsers(b.asAdmin(ctx), format, r)
+	if err != nil {
+		return importReport{}, err
+	}
+
+	report := importReport{
+		Total:    res.Total,
+		Imported: res.Imported,
+		Failed:   res.Failed,
+		Errors:   make(importErrorRows, 0, len(res.Errors)),
+	}
+	for _, e := range res.Errors {
+		report.Errors = append(report.Errors, importErrorRow{Line: e.Line, Email: e.Email, Error: e.Error})
+	}
+
+	return report, nil
+}
+
+func (b *dbBackend) ExportUsers(ctx context.Context, format string, w io.Writer) error {
+	_, err := b.bg.Users.ExportUsers(b.asAdmin(ctx), format, w)
+
+	return err
+}
+
+func (b *dbBackend) Close() {
+	b.bg.Close()
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// options общие флаги authctl
+type options struct {
+	addr    string
+	token   string
+	db      bool
+	output  string
+	timeout time.Duration
+}
+
+// env окружение выполнения подкоманды
+type env struct {
+	opts    options
+	out     *printer
+	stderr  io.Writer
+	backend backend
+}
+
+// Backend сервис, с которым работает подкоманда: api или бд напрямую. Создается при первом обращении
+func (e *env) Backend(ctx context.Context) (backend, error) {
+	if e.backend != nil {
+		return e.backend, nil
+	}
+
+	var err error
+	if e.opts.db {
+		e.backend = newDBBackend(ctx)
+	} else {
+		e.backend, err = newGRPCBackend(e.opts.addr, e.opts.token)
+	}
+
+	return e.backend, err
+}
+
+// withTimeout контекст одного запроса к сервису
+func (e *env) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, e.opts.timeout)
+}
+
+func (e *env) close() {
+	if e.backend != nil {
+		e.backend.Close()
+	}
+}
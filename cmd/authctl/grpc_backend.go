@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+// grpcBackend работает через UserV1 api с токеном администратора
+type grpcBackend struct {
+	conn   *grpc.ClientConn
+	client user_v1.UserV1Client
+	token  string
+}
+
+func newGRPCBackend(addr, token string) (*grpcBackend, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcBackend{conn: conn, client: user_v1.NewUserV1Client(conn), token: token}, nil
+}
+
+// auth добавляет токен администратора в запрос
+func (b *grpcBackend) auth(ctx context.Context) context.Context {
+	if b.token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+b.token)
+}
+
+func (b *grpcBackend) CreateAdmin(ctx context.Context, email, name, password, setupToken string) (int64, error) {
+	rsp, err := b.client.Create(b.auth(ctx), &user_v1.CreateRequest{
+		Name:            name,
+		Email:           email,
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            user_v1.Role_ADMIN,
+		SetupToken:      setupToken,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return rsp.GetId(), nil
+}
+
+func (b *grpcBackend) ListUsers(ctx context.Context, q listQuery) (userRows, string, error) {
+	req := &user_v1.ListUsersRequest{Limit: q.Limit, Cursor: q.Cursor, Query: q.Query}
+	switch q.Role {
+	case roleUser:
+		req.Role = user_v1.Role_USER
+	case roleAdmin:
+		req.Role = user_v1.Role_ADMIN
+	}
+
+	rsp, err := b.client.ListUsers(b.auth(ctx), req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users := make(userRows, 0, len(rsp.GetUsers()))
+	for _, u := range rsp.GetUsers() {
+		users = append(users, userRow{
+			ID:        u.GetId(),
+			Email:     u.GetEmail(),
+			Name:      u.GetName(),
+			Role:      roleName(u.GetRole() == user_v1.Role_ADMIN),
+			CreatedAt: u.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return users, rsp.GetNextCursor(), nil
+}
+
+func (b *grpcBackend) SearchUsers(ctx context.Context, query string, limit uint32) (hitRows, error) {
+	rsp, err := b.client.SearchUsers(b.auth(ctx), &user_v1.SearchUsersRequest{Query: query, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make(hitRows, 0, len(rsp.GetHits()))
+	for _, h := range rsp.GetHits() {
+		hits = append(hits, hitRow{
+			ID:        h.GetId(),
+			Email:     h.GetEmail(),
+			Name:      h.GetName(),
+			Rank:      h.GetRank(),
+			Highlight: h.GetHighlight(),
+		})
+	}
+
+	return hits, nil
+}
+
+func (b *grpcBackend) ResetPassword(ctx context.Context, id int64, password string) error {
+	_, err := b.client.ResetPassword(b.auth(ctx), &user_v1.ResetPasswordRequest{
+		Id:              id,
+		Password:        password,
+		PasswordConfirm: password,
+	})
+
+	return err
+}
+
+func (b *grpcBackend) RevokeSessions(ctx context.Context, id int64) error {
+	_, err := b.client.RevokeSessions(b.auth(ctx), &user_v1.RevokeSessionsRequest{Id: id})
+
+	return err
+}
+
+// auditPageSize размер страницы ListAuditEvents
+const auditPageSize = 100
+
+func (b *grpcBackend) AuditLog(ctx context.Context, q auditQuery) (auditRows, error) {
+	req := &user_v1.ListAuditEventsRequest{UserId: q.UserID, Limit: auditPageSize}
+
+	var rows auditRows
+	//страницы идут от последних записей: листаем, пока не наберем Limit или не дойдем до AfterID
+	for {
+		rsp, err := b.client.ListAuditEvents(b.auth(ctx), req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range rsp.GetEvents() {
+			if e.GetId() <= q.AfterID || (q.Limit > 0 && uint64(len(rows)) == q.Limit) {
+				return rows, nil
+			}
+
+			rows = append(rows, auditRow{
+				ID:        e.GetId(),
+				UserID:    e.GetUserId(),
+				ActorID:   e.GetActorId(),
+				Action:    e.GetAction(),
+				OldValue:  e.GetOldValue(),
+				NewValue:  e.GetNewValue(),
+				IP:        e.GetIp(),
+				Details:   e.GetDetails(),
+				CreatedAt: e.GetCreatedAt().AsTime(),
+			})
+		}
+
+		if rsp.GetNextCursor() == "" {
+			return rows, nil
+		}
+		req.Cursor = rsp.GetNextCursor()
+	}
+}
+
+func (b *grpcBackend) VerifyAudit(ctx context.Context) (auditCheck, error) {
+	rsp, err := b.client.VerifyAuditLog(b.auth(ctx), &user_v1.VerifyAuditLogRequest{})
+	if err != nil {
+		return auditCheck{}, err
+	}
+
+	return auditCheck{
+		Checked:     rsp.GetChecked(),
+		Checkpoints: int(rsp.GetCheckpoints()),
+		BrokenID:    rsp.GetBrokenId(),
+		Reason:      rsp.GetReason(),
+	}, nil
+}
+
+func (b *grpcBackend) ExportData(ctx context.Context, id int64) ([]byte, error) {
+	rsp, err := b.client.ExportMyData(b.auth(ctx), &user_v1.ExportMyDataRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.GetArchive(), nil
+}
+
+func (b *grpcBackend) EraseUser(ctx context.Context, id int64) (int64, error) {
+	rsp, err := b.client.EraseUser(b.auth(ctx), &user_v1.EraseUserRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+
+	return rsp.GetRedactedActions(), nil
+}
+
+func (b *grpcBackend) BlockUser(ctx context.Context, id int64, reason string, until time.Time) (string, error) {
+	req := &user_v1.BlockUserRequest{Id: id, Reason: reason}
+	if !until.IsZero() {
+		req.SuspendedUntil = timestamppb.New(until)
+	}
+
+	rsp, err := b.client.BlockUser(b.auth(ctx), req)
+	if err != nil {
+		return "", err
+	}
+
+	return statusName(rsp.GetStatus()), nil
+}
+
+func (b *grpcBackend) UnblockUser(ctx context.Context, id int64, reason string) (string, error) {
+	rsp, err := b.client.UnblockUser(b.auth(ctx), &user_v1.UnblockUserRequest{Id: id, Reason: reason})
+	if err != nil {
+		return "", err
+	}
+
+	return statusName(rsp.GetStatus()), nil
+}
+
+// statusName статус как в сервисе: active, blocked, suspended, pending
+func statusName(status user_v1.UserStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "USER_STATUS_"))
+}
+
+func (b *grpcBackend) ImportUsers(ctx context.Context, format string, r io.Reader) (importReport, error) {
+	stream, err := b.client.ImportUsers(b.auth(ctx))
+	if err != nil {
+		return importReport{}, err
+	}
+
+	//формат передается в первом сообщении, поэтому оно уходит, даже если файл пустой
+	req := &user_v1.ImportUsersRequest{Format: toGRPCBulkFormat(format)}
+	for first := true; ; first = false {
+		//новый буфер на каждое сообщение: grpc не разрешает менять сообщение после Send
+		buf := make([]byte, bulkChunk)
+		n, errRead := io.ReadFull(r, buf)
+		if n > 0 || first {
+			req.Chunk = buf[:n]
+			if err = stream.Send(req); err != nil {
+				//причину обрыва сервер вернет в CloseAndRecv
+				break
+			}
+			req = &user_v1.ImportUsersRequest{}
+		}
+
+		if errors.Is(errRead, io.EOF) || errors.Is(errRead, io.ErrUnexpectedEOF) {
+			break
+		}
+		if errRead != nil {
+			return importReport{}, errRead
+		}
+	}
+
+	rsp, err := stream.CloseAndRecv()
+	if err != nil {
+		return importReport{}, err
+	}
+
+	report := importReport{
+		Total:    rsp.GetTotal(),
+		Imported: rsp.GetImported(),
+		Failed:   rsp.GetFailed(),
+		Errors:   make(importErrorRows, 0, len(rsp.GetErrors())),
+	}
+	for _, e := range rsp.GetErrors() {
+		report.Errors = append(report.Errors, importErrorRow{Line: e.GetLine(), Email: e.GetEmail(), Error: e.GetError()})
+	}
+
+	return report, nil
+}
+
+func (b *grpcBackend) ExportUsers(ctx context.Context, format string, w io.Writer) error {
+	stream, err := b.client.ExportUsers(b.auth(ctx), &user_v1.ExportUsersRequest{Format: toGRPCBulkFormat(format)})
+	if err != nil {
+		return err
+	}
+
+	for {
+		rsp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = w.Write(rsp.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
+
+// bulkChunk размер куска файла импорта в одном сообщении
+const bulkChunk = 64 * 1024
+
+func toGRPCBulkFormat(format string) user_v1.BulkFormat {
+	if format == bulkJSONL {
+		return user_v1.BulkFormat_BULK_FORMAT_JSONL
+	}
+
+	return user_v1.BulkFormat_BULK_FORMAT_CSV
+}
+
+func (b *grpcBackend) Close() {
+	_ = b.conn.Close()
+}
@@ -0,0 +1,130 @@
+// authctl утилита эксплуатации сервиса auth.
+//
+// По умолчанию работает через UserV1 api с токеном администратора (-token или AUTHCTL_TOKEN).
+// С флагом -db работает напрямую с бд сервиса по его конфигу (break-glass), например когда api недоступно
+// или администраторов еще нет.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+)
+
+// command подкоманда authctl
+type command struct {
+	usage string
+	run   func(ctx context.Context, env *env, args []string) error
+}
+
+var commands = map[string]command{
+	"create-admin":    {"-email E [-name N] [-password P] [-setup-token T]   создать администратора", createAdmin},
+	"list-users":      {"[-role user|admin] [-q Q] [-limit N] [-cursor C]   список пользователей", listUsers},
+	"search-users":    {"-q Q [-limit N]   полнотекстовый поиск пользователей", searchUsers},
+	"reset-password":  {"-id ID [-password P]   задать новый пароль и отозвать сессии", resetPassword},
+	"revoke-sessions": {"-id ID   отозвать все refresh-токены пользователя", revokeSessions},
+	"rotate-keys":     {"[-keep N] [-audit]   сгенерировать новый ключ подписи jwt или контрольных точек аудита", rotateKeys},
+	"mint-token":      {"-id ID [-admin] [-ttl D] [-scope S,...]   выпустить токен для тестов", mintToken},
+	"audit-tail":      {"[-user ID] [-n N] [-f]   последние записи журнала действий", auditTail},
+	"audit-verify":    {"   проверить цепочку хэшей журнала действий", auditVerify},
+	"export-data":     {"-id ID [-out FILE]   выгрузить персональные данные пользователя в json", exportData},
+	"erase-user":      {"-id ID -confirm   стереть персональные данные пользователя", eraseUser},
+	"block-user":      {"-id ID -reason R [-for D]   заблокировать пользователя, с -for - приостановить на срок", blockUser},
+	"unblock-user":    {"-id ID [-reason R]   снять блокировку пользователя", unblockUser},
+	"import-users":    {"-file F|- [-format csv|jsonl]   создать пользователей из файла", importUsers},
+	"export-users":    {"[-format csv|jsonl] [-out FILE]   выгрузить всех пользователей без паролей", exportUsers},
+}
+
+// errUsage неверные аргументы, печатается справка
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("authctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var opts options
+	fs.StringVar(&opts.addr, "addr", envOr("AUTHCTL_ADDR", "localhost:50501"), "адрес grpc api сервиса")
+	fs.StringVar(&opts.token, "token", os.Getenv("AUTHCTL_TOKEN"), "access-токен администратора")
+	fs.BoolVar(&opts.db, "db", false, "break-glass: работать напрямую с бд сервиса по его конфигу")
+	fs.StringVar(&opts.output, "o", formatTable, "формат вывода: table или json")
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "таймаут одной команды")
+	fs.Usage = func() { printUsage(fs, stderr) }
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		_, _ = fmt.Fprintf(stderr, "unknown command %q\n\n", name)
+		fs.Usage()
+		return 2
+	}
+
+	if opts.output != formatTable && opts.output != formatJSON {
+		_, _ = fmt.Fprintf(stderr, "unknown output format %q\n", opts.output)
+		return 2
+	}
+
+	e := &env{opts: opts, out: &printer{w: stdout, format: opts.output}, stderr: stderr}
+	defer e.close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := cmd.run(ctx, e, fs.Args()[1:])
+	if err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			_, _ = fmt.Fprintf(stderr, "usage: authctl [flags] %s %s\n", name, cmd.usage)
+			return 2
+		}
+
+		_, _ = fmt.Fprintf(stderr, "authctl %s: %v\n", name, err)
+		return 1
+	}
+
+	return 0
+}
+
+func printUsage(fs *flag.FlagSet, w io.Writer) {
+	_, _ = fmt.Fprintln(w, "usage: authctl [flags] <command> [command flags]")
+	_, _ = fmt.Fprintln(w, "\ncommands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "  %s %s\n", name, commands[name].usage)
+	}
+
+	_, _ = fmt.Fprintln(w, "\nflags:")
+	fs.PrintDefaults()
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	return def
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Форматы вывода
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// tabular данные, которые можно вывести таблицей
+type tabular interface {
+	header() []string
+	rows() [][]string
+}
+
+// printer выводит результат подкоманды таблицей или json
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func (p *printer) print(v tabular) error {
+	if p.format == formatJSON {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	return p.table(v, true)
+}
+
+// streamable данные, которые выводятся порциями
+type streamable interface {
+	tabular
+	items() []any
+}
+
+// stream выводит очередную порцию потока: в json - по объекту в строке, в таблице - заголовок только у первой
+func (p *printer) stream(v streamable, first bool) error {
+	if p.format == formatJSON {
+		enc := json.NewEncoder(p.w)
+		for _, item := range v.items() {
+			if err := enc.Encode(item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return p.table(v, first)
+}
+
+func (p *printer) table(v tabular, withHeader bool) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	if withHeader {
+		_, _ = fmt.Fprintln(tw, strings.Join(v.header(), "\t"))
+	}
+	for _, row := range v.rows() {
+		_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// userRow пользователь в выводе
+type userRow struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type userRows []userRow
+
+func (userRows) header() []string { return []string{"ID", "EMAIL", "NAME", "ROLE", "CREATED AT"} }
+
+func (r userRows) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, u := range r {
+		rows = append(rows, []string{
+			strconv.FormatInt(u.ID, 10), u.Email, u.Name, u.Role, formatTime(u.CreatedAt),
+		})
+	}
+
+	return rows
+}
+
+// usersPage страница списка пользователей
+type usersPage struct {
+	Users      userRows `json:"users"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+func (p usersPage) header() []string { return p.Users.header() }
+
+func (p usersPage) rows() [][]string {
+	rows := p.Users.rows()
+	if p.NextCursor != "" {
+		rows = append(rows, []string{}, []string{"next cursor: " + p.NextCursor})
+	}
+
+	return rows
+}
+
+// hitRow результат поиска
+type hitRow struct {
+	ID        int64   `json:"id"`
+	Email     string  `json:"email"`
+	Name      string  `json:"name"`
+	Rank      float32 `json:"rank"`
+	Highlight string  `json:"highlight"`
+}
+
+type hitRows []hitRow
+
+func (hitRows) header() []string { return []string{"ID", "EMAIL", "NAME", "RANK", "MATCH"} }
+
+func (r hitRows) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, h := range r {
+		rows = append(rows, []string{
+			strconv.FormatInt(h.ID, 10), h.Email, h.Name, strconv.FormatFloat(float64(h.Rank), 'f', 3, 32), h.Highlight,
+		})
+	}
+
+	return rows
+}
+
+// auditRow запись журнала действий
+type auditRow struct {
+	ID        int64             `json:"id"`
+	UserID    int64             `json:"user_id"`
+	ActorID   int64             `json:"actor_id"`
+	Action    string            `json:"action"`
+	OldValue  string            `json:"old_value"`
+	NewValue  string            `json:"new_value"`
+	IP        string            `json:"ip"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type auditRows []auditRow
+
+func (auditRows) header() []string {
+	return []string{"ID", "AT", "USER", "ACTOR", "ACTION", "OLD", "NEW", "IP", "DETAILS"}
+}
+
+func (r auditRows) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, a := range r {
+		details := make([]string, 0, len(a.Details))
+		for k, v := range a.Details {
+			details = append(details, k+"="+v)
+		}
+		sort.Strings(details)
+
+		rows = append(rows, []string{
+			strconv.FormatInt(a.ID, 10), formatTime(a.CreatedAt), strconv.FormatInt(a.UserID, 10),
+			strconv.FormatInt(a.ActorID, 10), a.Action, a.OldValue, a.NewValue, a.IP, strings.Join(details, " "),
+		})
+	}
+
+	return rows
+}
+
+func (r auditRows) items() []any {
+	items := make([]any, 0, len(r))
+	for _, a := range r {
+		items = append(items, a)
+	}
+
+	return items
+}
+
+// importReport итог импорта пользователей, в таблице - только ошибки строк
+type importReport struct {
+	Total    int64           `json:"total"`
+	Imported int64           `json:"imported"`
+	Failed   int64           `json:"failed"`
+	Errors   importErrorRows `json:"errors"`
+}
+
+func (r importReport) header() []string { return r.Errors.header() }
+
+func (r importReport) rows() [][]string { return r.Errors.rows() }
+
+// importErrorRow ошибка строки импорта
+type importErrorRow struct {
+	Line  int64  `json:"line"`
+	Email string `json:"email"`
+	Error string `json:"error"`
+}
+
+type importErrorRows []importErrorRow
+
+func (importErrorRows) header() []string { return []string{"LINE", "EMAIL", "ERROR"} }
+
+func (r importErrorRows) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, e := range r {
+		rows = append(rows, []string{strconv.FormatInt(e.Line, 10), e.Email, e.Error})
+	}
+
+	return rows
+}
+
+// record пары ключ-значение в заданном порядке, например выпущенный токен
+type record [][2]string
+
+func (record) header() []string { return []string{"KEY", "VALUE"} }
+
+func (r record) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, kv := range r {
+		rows = append(rows, []string{kv[0], kv[1]})
+	}
+
+	return rows
+}
+
+// MarshalJSON объект с ключами в порядке записи
+func (r record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, kv := range r {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(kv[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(kv[1])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.Local().Format(time.DateTime)
+}
@@ -13,6 +13,20 @@ import (
 
 func main() {
 	ctx := context.Background()
+
+	//auth migrate up|down|status - управление миграциями без запуска сервиса
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		command := app.MigrateUp
+		if len(os.Args) > 2 {
+			command = os.Args[2]
+		}
+
+		if err := app.Migrate(ctx, command, os.Stdout); err != nil {
+			log.Fatalf("migrate %s: %v", command, err)
+		}
+		return
+	}
+
 	ap := app.NewApp(ctx)
 
 	go func() {
@@ -43,7 +57,11 @@ func main() {
 		}
 	}()
 
-	go ap.RunTopicLogger(ctx)
+	go ap.RunConsumers(ctx)
+	go ap.RunPurger(ctx)
+	go ap.RunOutboxRelay(ctx)
+	go ap.RunCacheInvalidation(ctx)
+	go ap.RunAuditCheckpoints(ctx)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt)
@@ -6,10 +6,10 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
+	"time"
 
-	"github.com/IBM/sarama"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/neracastle/go-libs/pkg/kafka"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"github.com/neracastle/go-libs/pkg/sys/tracer"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -24,12 +24,15 @@ import (
 	"golang.org/x/exp/slog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
 
 	embed "github.com/neracastle/auth/api/user_v1"
 	grpc_server "github.com/neracastle/auth/internal/grpc-server"
 	"github.com/neracastle/auth/internal/grpc-server/interceptors"
 	"github.com/neracastle/auth/pkg/user_v1"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
 	sharedinters "github.com/neracastle/auth/pkg/user_v1/auth/grpc-interceptors"
 )
 
@@ -52,28 +55,82 @@ func NewApp(ctx context.Context) *App {
 
 func (a *App) init(ctx context.Context) {
 	a.initTracing(ctx, a.srvProvider.Config().Trace.ServiceName)
+	a.migrateOnStart(ctx)
+
+	//общие перехватчики, потоковые методы получают их через StreamFromUnary
+	common := []grpc.UnaryServerInterceptor{
+		interceptors.NewRateLimitInterceptor(a.srvProvider.RateLimiter()),
+		interceptors.MetricsInterceptor,
+		interceptors.RequestIDInterceptor,
+		interceptors.AuditMetaInterceptor,
+		interceptors.NewLoggerInterceptor(a.srvProvider.Logger()),
+		interceptors.ErrorCodesInterceptor,
+		sharedinters.NewAccessInterceptor([]string{
+			user_v1.UserV1_Get_FullMethodName,
+			user_v1.UserV1_BatchGet_FullMethodName,
+			user_v1.UserV1_Update_FullMethodName,
+			user_v1.UserV1_Delete_FullMethodName,
+			user_v1.UserV1_Restore_FullMethodName,
+			user_v1.UserV1_ListUsers_FullMethodName,
+			user_v1.UserV1_SearchUsers_FullMethodName,
+			user_v1.UserV1_ResetPassword_FullMethodName,
+			user_v1.UserV1_RevokeSessions_FullMethodName,
+			user_v1.UserV1_ListAuditEvents_FullMethodName,
+			user_v1.UserV1_VerifyAuditLog_FullMethodName,
+			user_v1.UserV1_ExportMyData_FullMethodName,
+			user_v1.UserV1_EraseUser_FullMethodName,
+			user_v1.UserV1_ImportUsers_FullMethodName,
+			user_v1.UserV1_ExportUsers_FullMethodName,
+			user_v1.UserV1_BlockUser_FullMethodName,
+			user_v1.UserV1_UnblockUser_FullMethodName,
+		}, a.srvProvider.Config().JWT.SecretKey, a.srvProvider.Config().JWT.PreviousSecretKeys...),
+	}
+
+	streamInters := make([]grpc.StreamServerInterceptor, 0, len(common))
+	for _, inter := range common {
+		streamInters = append(streamInters, interceptors.StreamFromUnary(inter))
+	}
 
 	a.grpc = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.ChainUnaryInterceptor(
-			interceptors.NewRateLimitInterceptor(a.srvProvider.RateLimiter()),
-			interceptors.MetricsInterceptor,
-			interceptors.RequestIDInterceptor,
-			interceptors.NewLoggerInterceptor(a.srvProvider.Logger()),
-			interceptors.ErrorCodesInterceptor,
-			sharedinters.NewAccessInterceptor([]string{
-				user_v1.UserV1_Get_FullMethodName,
+		grpc.ChainUnaryInterceptor(append(common,
+			interceptors.NewIdempotencyInterceptor(a.srvProvider.IdempotencyRepository(), a.srvProvider.Config().Idempotency.TTL, []string{
+				user_v1.UserV1_Create_FullMethodName,
 				user_v1.UserV1_Update_FullMethodName,
 				user_v1.UserV1_Delete_FullMethodName,
-			}, a.srvProvider.Config().JWT.SecretKey)),
+				user_v1.UserV1_Restore_FullMethodName,
+				user_v1.UserV1_ResetPassword_FullMethodName,
+				user_v1.UserV1_RevokeSessions_FullMethodName,
+				user_v1.UserV1_EraseUser_FullMethodName,
+				user_v1.UserV1_BlockUser_FullMethodName,
+				user_v1.UserV1_UnblockUser_FullMethodName,
+				user_v1.UserV1_RequestLoginLink_FullMethodName,
+			}))...),
+		grpc.ChainStreamInterceptor(streamInters...),
 	)
 
 	reflection.Register(a.grpc)
-	user_v1.RegisterUserV1Server(a.grpc, grpc_server.NewServer(a.srvProvider.UsersService(ctx)))
+	usersService := a.srvProvider.UsersService(ctx)
+	user_v1.RegisterUserV1Server(a.grpc, grpc_server.NewServer(usersService))
+
+	//заблокированный пользователь теряет доступ сразу, а не когда истечет его access-токен
+	sharedinters.SetStatusCheck(func(ctx context.Context, user auth.JWTUser) error {
+		return usersService.CheckStatus(ctx, user.ID)
+	})
+
+	a.bootstrapAdmin(ctx)
 }
 
 func (a *App) initTracing(ctx context.Context, serviceName string) {
+	//без коллектора трейсы собираются, но никуда не отправляются
+	if a.srvProvider.InMemory() {
+		traceProvider := sdktrace.NewTracerProvider()
+		tracer.Init(traceProvider.Tracer(serviceName))
+		otel.SetTracerProvider(traceProvider)
+		return
+	}
+
 	//экспортер
 	exporter, err := otlptracegrpc.New(ctx,
 		otlptracegrpc.WithInsecure(),
@@ -113,11 +170,12 @@ func (a *App) Start() error {
 
 	log.Printf("UserAPI service started on %s\n", a.srvProvider.Config().GRPC.Address())
 
-	if err = a.grpc.Serve(conn); err != nil {
-		return err
-	}
+	return a.Serve(conn)
+}
 
-	return nil
+// Serve принимает grpc-запросы на lis до остановки сервера
+func (a *App) Serve(lis net.Listener) error {
+	return a.grpc.Serve(lis)
 }
 
 // StartHTTP запускает http сервис на прием запросов
@@ -125,15 +183,15 @@ func (a *App) StartHTTP() error {
 	log.Printf("UserAPI HTTP started on %s\n", a.srvProvider.Config().HTTP.Address())
 
 	if a.httpServer == nil {
-		mux := runtime.NewServeMux()
-		opts := []grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		handler, err := a.NewGatewayHandler(context.Background(), a.srvProvider.Config().GRPC.Address(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return err
 		}
-		_ = user_v1.RegisterUserV1HandlerFromEndpoint(context.Background(), mux, a.srvProvider.Config().GRPC.Address(), opts)
 
 		a.httpServer = &http.Server{
 			Addr:    a.srvProvider.Config().HTTP.Address(),
-			Handler: NewCORSMux(mux),
+			Handler: handler,
 		}
 	}
 
@@ -144,32 +202,149 @@ func (a *App) StartHTTP() error {
 	return nil
 }
 
-// RunTopicLogger запускает прослушку сообщений кафки и просто их логирует
-func (a *App) RunTopicLogger(ctx context.Context) {
-	topic := a.srvProvider.Config().NewUsersTopic
-	lg := logger.SetupLogger(logger.Env(a.srvProvider.Config().Env))
-	lg = lg.With(slog.String("topic", topic))
+// RunPurger периодически окончательно удаляет пользователей, у которых истек срок хранения после удаления,
+// старые отметки обработанных входящих сообщений и отправленные сообщения outbox
+func (a *App) RunPurger(ctx context.Context) {
+	lg := a.srvProvider.Logger().With(slog.String("worker", "purger"))
+	ctx = logger.AssignLogger(ctx, lg)
 
-	cons := a.srvProvider.KafkaConsumer()
-	cons.GroupHandler().SetMessageHandler(func(ctx context.Context, msg *sarama.ConsumerMessage) error {
-		lg.Info("received message", slog.String("value", string(msg.Value)))
+	ticker := time.NewTicker(a.srvProvider.Config().Retention.PurgeInterval)
+	defer ticker.Stop()
 
-		return nil
-	})
+	for {
+		_, err := a.srvProvider.UsersService(ctx).PurgeDeleted(ctx)
+		if err != nil {
+			lg.Error("purge failed", slog.String("error", err.Error()))
+		}
+
+		_, err = a.srvProvider.InboxRepository(ctx).Purge(ctx, a.srvProvider.Config().Consumer.InboxTTL)
+		if err != nil {
+			lg.Error("inbox purge failed", slog.String("error", err.Error()))
+		}
+
+		_, err = a.srvProvider.OutboxRepository(ctx).Purge(ctx, a.srvProvider.Config().Outbox.SentTTL)
+		if err != nil {
+			lg.Error("outbox purge failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunAuditCheckpoints периодически подписывает ключом аудита контрольную точку цепочки журнала.
+// Без ключа точки не создаются, цепочка проверяется только хэшами
+func (a *App) RunAuditCheckpoints(ctx context.Context) {
+	lg := a.srvProvider.Logger().With(slog.String("worker", "audit_checkpoints"))
+	ctx = logger.AssignLogger(ctx, lg)
+
+	if a.srvProvider.Config().Audit.SigningKey == "" {
+		lg.Warn("AUDIT_SIGNING_KEY is not set, audit checkpoints are not signed")
+		return
+	}
+
+	ticker := time.NewTicker(a.srvProvider.Config().Audit.CheckpointInterval)
+	defer ticker.Stop()
 
 	for {
-		err := cons.RunConsume(ctx, topic)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := a.srvProvider.UsersService(ctx).CheckpointAudit(ctx)
 		if err != nil {
-			if errors.Is(kafka.ErrHandlerError, err) {
-				lg.Info("consumer handler error", slog.String("error", err.Error()))
-				continue
-			}
+			lg.Error("audit checkpoint failed", slog.String("error", err.Error()))
+		}
+	}
+}
 
-			lg.Info("consumer error", slog.String("error", err.Error()))
-			break
+// RunCacheInvalidation удаляет из памяти процесса пользователей, измененных другими экземплярами сервиса.
+// Redis общий для всех экземпляров и обновляется при изменении, поэтому подписка нужна только локальному кэшу.
+// При обрыве подписки переподключается, пока не отменен контекст
+func (a *App) RunCacheInvalidation(ctx context.Context) {
+	local := a.srvProvider.LocalUsersCache()
+	if local == nil {
+		return
+	}
+
+	lg := a.srvProvider.Logger().With(slog.String("worker", "cache_invalidation"))
+	ctx = logger.AssignLogger(ctx, lg)
+
+	for {
+		err := a.srvProvider.CacheInvalidator().Subscribe(ctx, local.Evict)
+		if ctx.Err() != nil {
+			return
 		}
+
+		if err != nil {
+			lg.Error("cache invalidation subscription failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// NewGatewayHandler http-шлюз, проксирующий запросы в grpc-сервис по адресу endpoint.
+// Соединение закрывается при отмене ctx
+func (a *App) NewGatewayHandler(ctx context.Context, endpoint string, opts ...grpc.DialOption) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMetadata(clientIPMetadata),
+		runtime.WithForwardResponseOption(setETagHeader),
+	)
+
+	err := user_v1.RegisterUserV1HandlerFromEndpoint(ctx, mux, endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCORSMux(mux), nil
+}
+
+// headerMatcher пробрасывает в grpc заголовки Idempotency-Key и If-Match, остальные по правилам gateway по умолчанию.
+// Адрес клиента ставит только сам gateway, подделать его заголовком Grpc-Metadata-* нельзя
+func headerMatcher(key string) (string, bool) {
+	switch http.CanonicalHeaderKey(key) {
+	case "Idempotency-Key":
+		return interceptors.IdempotencyKeyHeader, true
+	case "If-Match":
+		return grpc_server.IfMatchHeader, true
+	}
+
+	h, ok := runtime.DefaultHeaderMatcher(key)
+	if strings.EqualFold(h, interceptors.GatewayClientIPHeader) {
+		return "", false
 	}
 
+	return h, ok
+}
+
+// clientIPMetadata передает в grpc адрес, с которого пришел http-запрос
+func clientIPMetadata(_ context.Context, req *http.Request) metadata.MD {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+
+	return metadata.Pairs(interceptors.GatewayClientIPHeader, host)
+}
+
+// setETagHeader дублирует версию пользователя из ответа Get в заголовок ETag
+func setETagHeader(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if rsp, ok := msg.(*user_v1.GetResponse); ok && rsp.GetEtag() != "" {
+		w.Header().Set("ETag", rsp.GetEtag())
+	}
+
+	return nil
 }
 
 // StartSwaggerServer запускает сервер со swagger-документацией
@@ -219,13 +394,26 @@ func (a *App) Shutdown(ctx context.Context) {
 
 	allClosed := make(chan struct{})
 	go func() {
-		_ = a.srvProvider.consumer.Close()
-		_ = a.srvProvider.producer.Close()
-		_ = a.srvProvider.dbc.Close()
-		_ = a.httpServer.Close()
-		_ = a.swaggerServer.Close()
+		//часть зависимостей может быть не создана, например kafka в режиме хранения в памяти
+		if a.srvProvider.consumer != nil {
+			_ = a.srvProvider.consumer.Close()
+		}
+		if a.srvProvider.producer != nil {
+			_ = a.srvProvider.producer.Close()
+		}
+		if a.srvProvider.dbc != nil {
+			_ = a.srvProvider.dbc.Close()
+		}
+		if a.httpServer != nil {
+			_ = a.httpServer.Close()
+		}
+		if a.swaggerServer != nil {
+			_ = a.swaggerServer.Close()
+		}
 		a.grpc.GracefulStop()
-		_ = a.traceExporter.Shutdown(ctx)
+		if a.traceExporter != nil {
+			_ = a.traceExporter.Shutdown(ctx)
+		}
 		close(allClosed)
 	}()
 
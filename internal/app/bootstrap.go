@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"log"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+
+	"github.com/neracastle/auth/internal/usecases/models"
+)
+
+// bootstrapAdmin создает администратора из конфига, а если он не задан - включает токен настройки.
+// Пока администраторов нет, создать администратора через api можно только с этим токеном
+func (a *App) bootstrapAdmin(ctx context.Context) {
+	cfg := a.srvProvider.Config().Bootstrap
+	srv := a.srvProvider.UsersService(ctx)
+	ctx = logger.AssignLogger(ctx, a.srvProvider.Logger())
+
+	if cfg.AdminEmail != "" {
+		id, err := srv.BootstrapAdmin(ctx, models.CreateDTO{
+			Email:    cfg.AdminEmail,
+			Password: cfg.AdminPassword,
+			Name:     cfg.AdminName,
+		})
+		if err != nil {
+			log.Fatalf("failed to create initial admin: %v", err)
+		}
+
+		if id > 0 {
+			log.Printf("initial admin %s created with id %d\n", cfg.AdminEmail, id)
+		}
+		return
+	}
+
+	token, err := srv.ArmSetupToken(ctx, cfg.SetupToken)
+	if err != nil {
+		log.Fatalf("failed to check admins: %v", err)
+	}
+
+	//сгенерированный токен больше нигде не узнать, а заданный в конфиге в лог не пишем
+	if token != "" && cfg.SetupToken == "" {
+		log.Printf("no admin exists, create one with setup token: %s\n", token)
+	}
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/usecases"
+)
+
+// BreakGlass доступ к данным сервиса в обход grpc для authctl, когда api недоступно.
+// Работает с бд из конфига сервиса; kafka не нужна - события уходят в outbox и их отправит relay сервиса
+type BreakGlass struct {
+	Users   usecases.UserService
+	Actions action.Repository
+	sp      *serviceProvider
+}
+
+// NewBreakGlass подключается к хранилищам сервиса по его конфигу
+func NewBreakGlass(ctx context.Context) *BreakGlass {
+	sp := newServiceProvider()
+
+	users := usecases.NewService(
+		sp.UsersRepository(ctx),
+		sp.UsersCache(),
+		sp.CacheInvalidator(),
+		sp.ActionsRepository(ctx),
+		sp.LoginLinksRepository(ctx),
+		nil,
+		sp.DbClient(ctx).DB(),
+		sp.OutboxRepository(ctx),
+		nil,
+		sp.UsecasesConfig())
+
+	return &BreakGlass{Users: users, Actions: sp.ActionsRepository(ctx), sp: sp}
+}
+
+// Close закрывает соединения с хранилищами
+func (b *BreakGlass) Close() {
+	if b.sp.dbc != nil {
+		_ = b.sp.dbc.Close()
+	}
+}
@@ -0,0 +1,250 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/repository/inbox"
+)
+
+// Заголовки, которые добавляются к сообщению при отправке в dead-letter топик
+const (
+	HeaderDLQError     = "dlq-error"
+	HeaderDLQTopic     = "dlq-original-topic"
+	HeaderDLQPartition = "dlq-original-partition"
+	HeaderDLQOffset    = "dlq-original-offset"
+	HeaderDLQAttempts  = "dlq-attempts"
+	HeaderDLQFailedAt  = "dlq-failed-at"
+)
+
+// eventIDHeader заголовок с идентификатором события (его проставляет outbox relay)
+const eventIDHeader = "event_id"
+
+// Handler обработчик сообщений одного топика
+type Handler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// Transactor выполняет обработчик в транзакции
+type Transactor interface {
+	ReadCommitted(ctx context.Context, f db.Handler) error
+}
+
+// Producer отправка сообщений в dead-letter топик
+type Producer interface {
+	SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error)
+}
+
+// Config параметры обработки
+type Config struct {
+	// Group имя получателя, в его рамках сообщение обрабатывается один раз
+	Group string
+	// MaxAttempts сколько раз пытаться обработать сообщение до отправки в DeadLetterTopic
+	MaxAttempts int
+	// Backoff задержка перед второй попыткой, дальше удваивается
+	Backoff time.Duration
+	// DeadLetterTopic топик для сообщений, которые так и не удалось обработать
+	DeadLetterTopic string
+}
+
+// Router направляет сообщения в обработчики по топику.
+// Обработка идемпотентна: id сообщения сохраняется в inbox в одной транзакции с работой обработчика
+type Router struct {
+	handlers map[string]Handler
+	inbox    inbox.Repository
+	tx       Transactor
+	producer Producer
+	config   Config
+}
+
+// NewRouter новый экземпляр
+func NewRouter(inboxRepo inbox.Repository, tx Transactor, producer Producer, config Config) *Router {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+
+	return &Router{
+		handlers: make(map[string]Handler),
+		inbox:    inboxRepo,
+		tx:       tx,
+		producer: producer,
+		config:   config,
+	}
+}
+
+// Handle регистрирует обработчик топика
+func (r *Router) Handle(topic string, h Handler) {
+	r.handlers[topic] = h
+}
+
+// Topics зарегистрированные топики
+func (r *Router) Topics() []string {
+	topics := make([]string, 0, len(r.handlers))
+	for topic := range r.handlers {
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+// Process обрабатывает сообщение с повторами. Если все попытки исчерпаны или ошибка постоянная,
+// сообщение уходит в dead-letter топик. Ошибка возвращается, только если не удалось и это,
+// тогда сообщение не подтверждается и будет прочитано снова
+func (r *Router) Process(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	log := logger.GetLogger(ctx).With(
+		slog.String("topic", msg.Topic),
+		slog.Int("partition", int(msg.Partition)),
+		slog.Int64("offset", msg.Offset))
+
+	h, ok := r.handlers[msg.Topic]
+	if !ok {
+		log.Warn("no handler for topic")
+		return nil
+	}
+
+	msgID := messageID(msg)
+	backoff := r.config.Backoff
+
+	var err error
+	attempt := 1
+	for ; ; attempt++ {
+		err = r.processOnce(ctx, msgID, msg, h)
+		if err == nil {
+			return nil
+		}
+
+		log.Warn("failed to handle message", slog.Int("attempt", attempt), slog.String("error", err.Error()))
+		if IsPermanent(err) || attempt >= r.config.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return r.deadLetter(msg, err, attempt)
+}
+
+func (r *Router) processOnce(ctx context.Context, msgID string, msg *sarama.ConsumerMessage, h Handler) error {
+	return r.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		isNew, err := r.inbox.Save(ctx, r.config.Group, msgID)
+		if err != nil {
+			return err
+		}
+
+		if !isNew {
+			logger.GetLogger(ctx).Debug("message already processed", slog.String("message_id", msgID))
+			return nil
+		}
+
+		return h(ctx, msg)
+	})
+}
+
+func (r *Router) deadLetter(msg *sarama.ConsumerMessage, cause error, attempts int) error {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+6)
+	for _, h := range msg.Headers {
+		if h != nil {
+			headers = append(headers, *h)
+		}
+	}
+
+	headers = append(headers,
+		header(HeaderDLQError, cause.Error()),
+		header(HeaderDLQTopic, msg.Topic),
+		header(HeaderDLQPartition, strconv.Itoa(int(msg.Partition))),
+		header(HeaderDLQOffset, strconv.FormatInt(msg.Offset, 10)),
+		header(HeaderDLQAttempts, strconv.Itoa(attempts)),
+		header(HeaderDLQFailedAt, time.Now().UTC().Format(time.RFC3339)),
+	)
+
+	_, _, err := r.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   r.config.DeadLetterTopic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send message to dead-letter topic: %w", err)
+	}
+
+	return nil
+}
+
+// messageID идентификатор для дедупликации: id события, если отправитель его передал, иначе позиция в топике
+func messageID(msg *sarama.ConsumerMessage) string {
+	if id := headerValue(msg, eventIDHeader); id != "" {
+		return id
+	}
+
+	return fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+}
+
+func headerValue(msg *sarama.ConsumerMessage, key string) string {
+	for _, h := range msg.Headers {
+		if h != nil && string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
+func header(key, value string) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(key), Value: []byte(value)}
+}
+
+// permanentError ошибка, которую нет смысла повторять (например, не разбирается тело сообщения)
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent помечает ошибку как постоянную: сообщение сразу уходит в dead-letter топик
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent true, если ошибка помечена Permanent
+func IsPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
+
+// JSON типизированный обработчик для сообщений в json
+func JSON[T any](fn func(ctx context.Context, v T) error) Handler {
+	return func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		var v T
+		if err := json.Unmarshal(msg.Value, &v); err != nil {
+			return Permanent(err)
+		}
+
+		return fn(ctx, v)
+	}
+}
+
+// Events обработчик событий пользователя, формат определяется по заголовку content-type
+func Events(fn func(ctx context.Context, env events.Envelope) error) Handler {
+	return func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		env, err := events.Unmarshal(msg.Value, headerValue(msg, events.ContentTypeHeader))
+		if err != nil {
+			return Permanent(err)
+		}
+
+		return fn(ctx, env)
+	}
+}
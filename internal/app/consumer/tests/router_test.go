@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/app/consumer"
+)
+
+type memInbox struct {
+	seen map[string]bool
+}
+
+func (m *memInbox) Save(_ context.Context, group string, id string) (bool, error) {
+	key := group + "/" + id
+	if m.seen[key] {
+		return false, nil
+	}
+	m.seen[key] = true
+
+	return true, nil
+}
+
+func (m *memInbox) Purge(context.Context, time.Duration) (int64, error) {
+	return 0, nil
+}
+
+// tx имитирует откат: отметка в inbox сохраняется только при успехе обработчика
+type tx struct {
+	inbox *memInbox
+}
+
+func (t tx) ReadCommitted(ctx context.Context, f db.Handler) error {
+	snapshot := make(map[string]bool, len(t.inbox.seen))
+	for k, v := range t.inbox.seen {
+		snapshot[k] = v
+	}
+
+	err := f(ctx)
+	if err != nil {
+		t.inbox.seen = snapshot
+	}
+
+	return err
+}
+
+type producer struct {
+	sent []*sarama.ProducerMessage
+	err  error
+}
+
+func (p *producer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	if p.err != nil {
+		return 0, 0, p.err
+	}
+	p.sent = append(p.sent, msg)
+
+	return 0, 0, nil
+}
+
+const (
+	topic    = "chat.events"
+	dlqTopic = "auth.dead_letter"
+)
+
+func newRouter(prod *producer) *consumer.Router {
+	inbox := &memInbox{seen: map[string]bool{}}
+
+	return consumer.NewRouter(inbox, tx{inbox: inbox}, prod, consumer.Config{
+		Group:           "auth",
+		MaxAttempts:     3,
+		Backoff:         time.Millisecond,
+		DeadLetterTopic: dlqTopic,
+	})
+}
+
+func message(offset int64, value string) *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Topic:     topic,
+		Partition: 1,
+		Offset:    offset,
+		Value:     []byte(value),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("trace"), Value: []byte("abc")}},
+	}
+}
+
+func headers(msg *sarama.ProducerMessage) map[string]string {
+	res := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		res[string(h.Key)] = string(h.Value)
+	}
+
+	return res
+}
+
+type chatEvent struct {
+	UserID int64 `json:"user_id"`
+}
+
+func TestRouterIdempotent(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	prod := &producer{}
+	router := newRouter(prod)
+
+	var handled []int64
+	router.Handle(topic, consumer.JSON(func(_ context.Context, ev chatEvent) error {
+		handled = append(handled, ev.UserID)
+		return nil
+	}))
+
+	msg := message(10, `{"user_id": 7}`)
+	require.NoError(t, router.Process(ctx, msg))
+	//повторная доставка того же сообщения не обрабатывается
+	require.NoError(t, router.Process(ctx, msg))
+	require.NoError(t, router.Process(ctx, message(11, `{"user_id": 8}`)))
+
+	require.Equal(t, []int64{7, 8}, handled)
+	require.Empty(t, prod.sent)
+}
+
+func TestRouterRetriesThenDeadLetter(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	prod := &producer{}
+	router := newRouter(prod)
+
+	attempts := 0
+	router.Handle(topic, consumer.JSON(func(context.Context, chatEvent) error {
+		attempts++
+		return errors.New("db is down")
+	}))
+
+	require.NoError(t, router.Process(ctx, message(5, `{"user_id": 7}`)))
+	require.Equal(t, 3, attempts)
+	require.Len(t, prod.sent, 1)
+
+	dlq := prod.sent[0]
+	require.Equal(t, dlqTopic, dlq.Topic)
+	h := headers(dlq)
+	require.Contains(t, h[consumer.HeaderDLQError], "db is down")
+	require.Equal(t, topic, h[consumer.HeaderDLQTopic])
+	require.Equal(t, "1", h[consumer.HeaderDLQPartition])
+	require.Equal(t, "5", h[consumer.HeaderDLQOffset])
+	require.Equal(t, "3", h[consumer.HeaderDLQAttempts])
+	require.NotEmpty(t, h[consumer.HeaderDLQFailedAt])
+	require.Equal(t, "abc", h["trace"])
+}
+
+func TestRouterRetrySucceeds(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	prod := &producer{}
+	router := newRouter(prod)
+
+	attempts := 0
+	router.Handle(topic, consumer.JSON(func(context.Context, chatEvent) error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("temporary")
+		}
+		return nil
+	}))
+
+	require.NoError(t, router.Process(ctx, message(1, `{"user_id": 7}`)))
+	require.Equal(t, 2, attempts)
+	require.Empty(t, prod.sent)
+}
+
+func TestRouterPermanentErrorSkipsRetries(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	prod := &producer{}
+	router := newRouter(prod)
+
+	attempts := 0
+	router.Handle(topic, consumer.JSON(func(context.Context, chatEvent) error {
+		attempts++
+		return nil
+	}))
+
+	//тело не разбирается - повторять бессмысленно
+	require.NoError(t, router.Process(ctx, message(1, `not json`)))
+	require.Equal(t, 0, attempts)
+	require.Len(t, prod.sent, 1)
+	require.Equal(t, "1", headers(prod.sent[0])[consumer.HeaderDLQAttempts])
+}
+
+func TestRouterDeadLetterFailure(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	prod := &producer{err: errors.New("kafka is down")}
+	router := newRouter(prod)
+
+	router.Handle(topic, consumer.JSON(func(context.Context, chatEvent) error {
+		return consumer.Permanent(errors.New("bad event"))
+	}))
+
+	//сообщение не подтверждается и будет прочитано снова
+	require.Error(t, router.Process(ctx, message(1, `{"user_id": 7}`)))
+}
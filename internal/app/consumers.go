@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/app/consumer"
+	"github.com/neracastle/auth/internal/events"
+)
+
+// chatEvent событие чат-сервера (создание чата, отправка сообщения и т.п.)
+type chatEvent struct {
+	Type       string    `json:"type"`
+	UserID     int64     `json:"user_id"`
+	ChatID     int64     `json:"chat_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// RunConsumers запускает обработку входящих kafka-сообщений. При ошибке получатель перезапускается,
+// работа завершается только при закрытии получателя или отмене контекста
+func (a *App) RunConsumers(ctx context.Context) {
+	lg := a.srvProvider.Logger().With(slog.String("worker", "consumers"))
+	if a.srvProvider.InMemory() {
+		lg.Info("kafka consumers are disabled in memory storage mode")
+		return
+	}
+
+	ctx = logger.AssignLogger(ctx, lg)
+	cfg := a.srvProvider.Config()
+
+	router := consumer.NewRouter(
+		a.srvProvider.InboxRepository(ctx),
+		a.srvProvider.DbClient(ctx).DB(),
+		a.srvProvider.KafkaProducer(),
+		consumer.Config{
+			Group:           cfg.Kafka.GroupID,
+			MaxAttempts:     cfg.Consumer.MaxAttempts,
+			Backoff:         cfg.Consumer.RetryBackoff,
+			DeadLetterTopic: cfg.Consumer.DeadLetterTopic,
+		})
+	router.Handle(cfg.Consumer.ChatEventsTopic, consumer.JSON(a.handleChatEvent))
+	router.Handle(cfg.NewUsersTopic, consumer.Events(a.handleUserCreated))
+
+	cons := a.srvProvider.KafkaConsumer()
+	cons.GroupHandler().SetMessageHandler(func(msgCtx context.Context, msg *sarama.ConsumerMessage) error {
+		return router.Process(logger.AssignLogger(msgCtx, lg), msg)
+	})
+
+	topics := strings.Join(router.Topics(), ",")
+	for {
+		err := cons.RunConsume(ctx, topics)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		lg.Error("consumer error, restarting", slog.String("error", err.Error()))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.Consumer.RetryBackoff):
+		}
+	}
+}
+
+// handleChatEvent обновляет время последней активности автора события
+func (a *App) handleChatEvent(ctx context.Context, ev chatEvent) error {
+	if ev.UserID <= 0 {
+		return consumer.Permanent(errors.New("chat event without user_id"))
+	}
+
+	at := ev.OccurredAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return a.srvProvider.UsersService(ctx).TrackActivity(ctx, ev.UserID, at)
+}
+
+// handleUserCreated логирует регистрацию пользователя. В лог попадает только id: почта и имя - персональные данные
+func (a *App) handleUserCreated(ctx context.Context, env events.Envelope) error {
+	var created events.User
+	if err := json.Unmarshal(env.Payload, &created); err != nil {
+		return consumer.Permanent(fmt.Errorf("bad %s payload: %w", env.Type, err))
+	}
+
+	logger.GetLogger(ctx).Info("user created",
+		slog.String("event_id", env.ID),
+		slog.String("event_type", env.Type),
+		slog.Int64("user_id", created.ID))
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/neracastle/auth/internal/migrator"
+	"github.com/neracastle/auth/migrations"
+)
+
+// Команды миграций
+const (
+	MigrateUp     = "up"
+	MigrateDown   = "down"
+	MigrateStatus = "status"
+)
+
+// Migrate выполняет команду миграций над бд из конфига, результат пишет в w
+func Migrate(ctx context.Context, command string, w io.Writer) error {
+	sp := newServiceProvider()
+	if sp.InMemory() {
+		return fmt.Errorf("migrations are not supported with storage %q", sp.Config().Storage.Mode)
+	}
+
+	m, closeConn, err := newMigrator(ctx, sp.Config().Postgres.DSN())
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	switch command {
+	case MigrateUp:
+		applied, err := m.Up(ctx)
+		for _, mig := range applied {
+			_, _ = fmt.Fprintf(w, "OK   %s\n", mig.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			_, _ = fmt.Fprintln(w, "no migrations to apply")
+		}
+	case MigrateDown:
+		mig, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(w, "OK   %s\n", mig.Name)
+	case MigrateStatus:
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "APPLIED AT\tMIGRATION")
+		for _, s := range statuses {
+			appliedAt := "Pending"
+			if !s.AppliedAt.IsZero() {
+				appliedAt = s.AppliedAt.Format(time.DateTime)
+			}
+			_, _ = fmt.Fprintf(tw, "%s\t%s\n", appliedAt, s.Name)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected %s, %s or %s", command, MigrateUp, MigrateDown, MigrateStatus)
+	}
+
+	return nil
+}
+
+// migrateOnStart накатывает миграции до того, как сервис начнет работать с бд
+func (a *App) migrateOnStart(ctx context.Context) {
+	if !a.srvProvider.Config().Migrations.OnStart || a.srvProvider.InMemory() {
+		return
+	}
+
+	m, closeConn, err := newMigrator(ctx, a.srvProvider.Config().Postgres.DSN())
+	if err != nil {
+		log.Fatalf("failed to init migrations: %v", err)
+	}
+	defer closeConn()
+
+	applied, err := m.Up(ctx)
+	if err != nil {
+		log.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	for _, mig := range applied {
+		log.Printf("migration applied: %s\n", mig.Name)
+	}
+}
+
+// newMigrator мигратор на отдельном соединении: advisory lock держится на уровне сессии
+func newMigrator(ctx context.Context, dsn string) (*migrator.Migrator, func(), error) {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to pg: %w", err)
+	}
+
+	m, err := migrator.New(conn, migrations.FS)
+	if err != nil {
+		_ = conn.Close(ctx)
+		return nil, nil, err
+	}
+
+	return m, func() { _ = conn.Close(context.WithoutCancel(ctx)) }, nil
+}
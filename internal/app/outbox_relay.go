@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/config"
+	"github.com/neracastle/auth/internal/repository/outbox"
+	"github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+)
+
+// eventIDHeader заголовок с идентификатором события, по нему потребители отсеивают дубли
+const eventIDHeader = "event_id"
+
+// RunOutboxRelay периодически отправляет в kafka сообщения, сохраненные в outbox.
+// Доставка at-least-once: сообщение помечается отправленным только после подтверждения от kafka
+func (a *App) RunOutboxRelay(ctx context.Context) {
+	lg := a.srvProvider.Logger().With(slog.String("worker", "outbox_relay"))
+	ctx = logger.AssignLogger(ctx, lg)
+
+	cfg := a.srvProvider.Config().Outbox
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		err := a.relayOutboxBatch(ctx)
+		if err != nil {
+			lg.Error("outbox relay failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) relayOutboxBatch(ctx context.Context) error {
+	return RelayOutbox(ctx, a.srvProvider.DbClient(ctx).DB(), a.srvProvider.OutboxRepository(ctx),
+		a.srvProvider.KafkaProducer(), a.srvProvider.Config().Outbox)
+}
+
+// RelayOutbox отправляет в kafka одну пачку готовых к отправке сообщений outbox.
+// Неотправленное сообщение откладывается с растущей задержкой и остается в outbox
+func RelayOutbox(ctx context.Context, txManager db.DB, repo outbox.Repository, producer sarama.SyncProducer, cfg config.Outbox) error {
+	lg := logger.GetLogger(ctx)
+
+	//блокировки строк держатся до конца транзакции, поэтому параллельные экземпляры не отправят те же сообщения
+	return txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		msgs, err := repo.FetchPending(ctx, cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			_, _, err = producer.SendMessage(toProducerMessage(msg))
+			if err != nil {
+				lg.Warn("failed to send outbox message",
+					slog.String("id", msg.ID),
+					slog.Int("attempts", msg.Attempts+1),
+					slog.String("error", err.Error()))
+
+				err = repo.MarkFailed(ctx, msg.ID, err.Error(), outboxBackoff(cfg.PollInterval, cfg.MaxBackoff, msg.Attempts))
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			err = repo.MarkSent(ctx, msg.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func toProducerMessage(msg model.MessageDTO) *sarama.ProducerMessage {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+1)
+	headers = append(headers, sarama.RecordHeader{Key: []byte(eventIDHeader), Value: []byte(msg.ID)})
+	for k, v := range msg.Headers {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   msg.Topic,
+		Key:     sarama.StringEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Payload),
+		Headers: headers,
+	}
+}
+
+// outboxBackoff экспоненциальная задержка перед следующей попыткой, не больше maxDelay
+func outboxBackoff(base, maxDelay time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 0; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
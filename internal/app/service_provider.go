@@ -20,11 +20,33 @@ import (
 	"golang.org/x/exp/slog"
 
 	"github.com/neracastle/auth/internal/config"
+	"github.com/neracastle/auth/internal/events"
+	eventsMemory "github.com/neracastle/auth/internal/events/memory"
+	"github.com/neracastle/auth/internal/mailer"
+	mailerLog "github.com/neracastle/auth/internal/mailer/logger"
+	mailerSmtp "github.com/neracastle/auth/internal/mailer/smtp"
 	"github.com/neracastle/auth/internal/repository/action"
+	actionsMemory "github.com/neracastle/auth/internal/repository/action/memory"
 	actionsPg "github.com/neracastle/auth/internal/repository/action/postgres"
+	"github.com/neracastle/auth/internal/repository/idempotency"
+	idempotencyMemory "github.com/neracastle/auth/internal/repository/idempotency/memory"
+	idempotencyRedis "github.com/neracastle/auth/internal/repository/idempotency/redis"
+	"github.com/neracastle/auth/internal/repository/inbox"
+	inboxMemory "github.com/neracastle/auth/internal/repository/inbox/memory"
+	inboxPg "github.com/neracastle/auth/internal/repository/inbox/postgres"
+	"github.com/neracastle/auth/internal/repository/loginlink"
+	loginLinksMemory "github.com/neracastle/auth/internal/repository/loginlink/memory"
+	loginLinksPg "github.com/neracastle/auth/internal/repository/loginlink/postgres"
+	"github.com/neracastle/auth/internal/repository/memory"
+	"github.com/neracastle/auth/internal/repository/outbox"
+	outboxMemory "github.com/neracastle/auth/internal/repository/outbox/memory"
+	outboxPg "github.com/neracastle/auth/internal/repository/outbox/postgres"
 	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/lru"
+	usersMemory "github.com/neracastle/auth/internal/repository/user/memory"
 	usersPg "github.com/neracastle/auth/internal/repository/user/postgres"
 	usersRedis "github.com/neracastle/auth/internal/repository/user/redis"
+	"github.com/neracastle/auth/internal/sealer"
 	"github.com/neracastle/auth/internal/usecases"
 )
 
@@ -34,9 +56,17 @@ type serviceProvider struct {
 	usecaseService usecases.UserService
 	usersRepo      user.Repository
 	usersCache     user.Cache
+	localCache     *lru.Cache
+	invalidator    user.Invalidator
 	actionsRepo    action.Repository
+	loginLinksRepo loginlink.Repository
+	outboxRepo     outbox.Repository
+	inboxRepo      inbox.Repository
+	idempotency    idempotency.Repository
+	mailer         mailer.Mailer
 	dbc            db.Client
 	redis          redis.Client
+	redisPool      *redigo.Pool
 	consumer       kafka.Consumer
 	producer       sarama.SyncProducer
 	rateLimiter    *rate_limiter.RateLimiter
@@ -56,7 +86,24 @@ func (sp *serviceProvider) Config() config.Config {
 	return *sp.conf
 }
 
+// InMemory работает ли сервис без postgres, redis и kafka
+func (sp *serviceProvider) InMemory() bool {
+	switch mode := sp.Config().Storage.Mode; mode {
+	case config.StorageMemory:
+		return true
+	case config.StoragePostgres:
+		return false
+	default:
+		log.Fatalf("unknown storage mode: %s", mode)
+		return false
+	}
+}
+
 func (sp *serviceProvider) DbClient(ctx context.Context) db.Client {
+	if sp.dbc == nil && sp.InMemory() {
+		sp.dbc = memory.NewClient()
+	}
+
 	if sp.dbc == nil {
 		client, err := pg.NewClient(ctx, sp.Config().Postgres.DSN())
 		if err != nil {
@@ -75,69 +122,238 @@ func (sp *serviceProvider) DbClient(ctx context.Context) db.Client {
 	return sp.dbc
 }
 
-func (sp *serviceProvider) RedisClient() redis.Client {
-	if sp.redis == nil {
-		pool := &redigo.Pool{
+func (sp *serviceProvider) RedisPool() *redigo.Pool {
+	if sp.redisPool == nil {
+		sp.redisPool = &redigo.Pool{
 			MaxIdle:     sp.Config().Redis.MaxIdle,
 			IdleTimeout: time.Duration(sp.Config().Redis.IdleTimeout),
 			DialContext: func(ctx context.Context) (redigo.Conn, error) {
 				return redigo.DialContext(ctx, "tcp", sp.Config().Redis.Address())
 			},
 		}
+	}
+
+	return sp.redisPool
+}
 
-		sp.redis = redislib.NewClient(pool)
+func (sp *serviceProvider) RedisClient() redis.Client {
+	if sp.redis == nil {
+		sp.redis = redislib.NewClient(sp.RedisPool())
 	}
 
 	return sp.redis
 }
 
+func (sp *serviceProvider) IdempotencyRepository() idempotency.Repository {
+	if sp.idempotency == nil {
+		if sp.InMemory() {
+			sp.idempotency = idempotencyMemory.New()
+		} else {
+			sp.idempotency = idempotencyRedis.New(sp.RedisPool())
+		}
+	}
+
+	return sp.idempotency
+}
+
 func (sp *serviceProvider) UsersRepository(ctx context.Context) user.Repository {
 	if sp.usersRepo == nil {
-		sp.usersRepo = usersPg.New(sp.DbClient(ctx))
+		if sp.InMemory() {
+			sp.usersRepo = usersMemory.New()
+		} else {
+			sp.usersRepo = usersPg.New(sp.DbClient(ctx))
+		}
 	}
 
 	return sp.usersRepo
 }
 
 func (sp *serviceProvider) UsersCache() user.Cache {
+	if sp.usersCache == nil && sp.InMemory() {
+		sp.usersCache = usersMemory.NewCache()
+	}
+
 	if sp.usersCache == nil {
-		sp.usersCache = usersRedis.New(sp.RedisClient())
+		sp.usersCache = lru.Instrument(lru.TierRedis, usersRedis.New(sp.RedisClient(), sp.RedisPool(), sp.UsersCacheSealer()))
+
+		cfg := sp.Config().LocalCache
+		if cfg.Size > 0 {
+			sp.localCache = lru.New(sp.usersCache, cfg.Size, cfg.TTL)
+			sp.usersCache = sp.localCache
+		}
 	}
 
 	return sp.usersCache
 }
 
+// UsersCacheSealer шифратор персональных данных в кэше, nil если ключ не задан
+func (sp *serviceProvider) UsersCacheSealer() *sealer.Sealer {
+	encoded := sp.Config().UsersCacheKey
+	if encoded == "" {
+		sp.Logger().Warn("USERS_CACHE_KEY is not set, user emails are cached in plain text")
+		return nil
+	}
+
+	key, err := sealer.ParseKey(encoded)
+	if err != nil {
+		log.Fatalf("invalid users cache key: %v", err)
+	}
+
+	s, err := sealer.New(key)
+	if err != nil {
+		log.Fatalf("failed to init users cache sealer: %v", err)
+	}
+
+	return s
+}
+
+// LocalUsersCache кэш в памяти процесса, nil если выключен
+func (sp *serviceProvider) LocalUsersCache() *lru.Cache {
+	sp.UsersCache()
+
+	return sp.localCache
+}
+
+func (sp *serviceProvider) CacheInvalidator() user.Invalidator {
+	if sp.invalidator == nil {
+		if sp.InMemory() {
+			sp.invalidator = usersMemory.NewInvalidator()
+		} else {
+			sp.invalidator = usersRedis.NewInvalidator(sp.RedisPool(), sp.Config().UsersCacheChannel)
+		}
+	}
+
+	return sp.invalidator
+}
+
 func (sp *serviceProvider) ActionsRepository(ctx context.Context) action.Repository {
 	if sp.actionsRepo == nil {
-		sp.actionsRepo = actionsPg.New(sp.DbClient(ctx))
+		if sp.InMemory() {
+			sp.actionsRepo = actionsMemory.New()
+		} else {
+			sp.actionsRepo = actionsPg.New(sp.DbClient(ctx))
+		}
 	}
 
 	return sp.actionsRepo
 }
 
+func (sp *serviceProvider) LoginLinksRepository(ctx context.Context) loginlink.Repository {
+	if sp.loginLinksRepo == nil {
+		if sp.InMemory() {
+			sp.loginLinksRepo = loginLinksMemory.New()
+		} else {
+			sp.loginLinksRepo = loginLinksPg.New(sp.DbClient(ctx))
+		}
+	}
+
+	return sp.loginLinksRepo
+}
+
+func (sp *serviceProvider) OutboxRepository(ctx context.Context) outbox.Repository {
+	if sp.outboxRepo == nil {
+		if sp.InMemory() {
+			sp.outboxRepo = outboxMemory.New()
+		} else {
+			sp.outboxRepo = outboxPg.New(sp.DbClient(ctx))
+		}
+	}
+
+	return sp.outboxRepo
+}
+
+func (sp *serviceProvider) InboxRepository(ctx context.Context) inbox.Repository {
+	if sp.inboxRepo == nil {
+		if sp.InMemory() {
+			sp.inboxRepo = inboxMemory.New()
+		} else {
+			sp.inboxRepo = inboxPg.New(sp.DbClient(ctx))
+		}
+	}
+
+	return sp.inboxRepo
+}
+
+func (sp *serviceProvider) Mailer() mailer.Mailer {
+	if sp.mailer == nil {
+		if sp.Config().Mailer.Host == "" {
+			sp.mailer = mailerLog.New(sp.Logger())
+		} else {
+			cfg := sp.Config().Mailer
+			sp.mailer = mailerSmtp.New(cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.From)
+		}
+	}
+
+	return sp.mailer
+}
+
 func (sp *serviceProvider) UsersService(ctx context.Context) usecases.UserService {
 	if sp.usecaseService == nil {
 		sp.usecaseService = usecases.NewService(
 			sp.UsersRepository(ctx),
 			sp.UsersCache(),
+			sp.CacheInvalidator(),
 			sp.ActionsRepository(ctx),
+			sp.LoginLinksRepository(ctx),
+			sp.Mailer(),
 			sp.DbClient(ctx).DB(),
-			sp.KafkaProducer(),
+			sp.OutboxRepository(ctx),
 			sp.KafkaConsumer(),
-			usecases.Config{
-				CacheTTL:        sp.Config().UsersCacheTTL,
-				NewUserTopic:    sp.Config().NewUsersTopic,
-				SecretKey:       sp.Config().JWT.SecretKey,
-				AccessDuration:  sp.Config().JWT.AccessDuration,
-				RefreshDuration: sp.Config().JWT.RefreshDuration,
-			})
+			sp.UsecasesConfig())
 	}
 
 	return sp.usecaseService
 }
 
+// UsecasesConfig параметры usecase-сервиса из конфига
+func (sp *serviceProvider) UsecasesConfig() usecases.Config {
+	return usecases.Config{
+		CacheTTL:            sp.Config().UsersCacheTTL,
+		EventTopics:         sp.EventTopics(),
+		EventsFormat:        sp.EventsFormat(),
+		SecretKey:           sp.Config().JWT.SecretKey,
+		PreviousSecretKeys:  sp.Config().JWT.PreviousSecretKeys,
+		AccessDuration:      sp.Config().JWT.AccessDuration,
+		RefreshDuration:     sp.Config().JWT.RefreshDuration,
+		LoginLinkURL:        sp.Config().LoginLink.URL,
+		LoginLinkTTL:        sp.Config().LoginLink.TTL,
+		LoginLinkRateLimit:  sp.Config().LoginLink.RateLimit,
+		LoginLinkRatePeriod: sp.Config().LoginLink.RatePeriod,
+		LoginLinkSubject:    sp.Config().LoginLink.MailSubject,
+		DeletedUsersTTL:     sp.Config().Retention.DeletedUsersTTL,
+		AuditSigningKey:     sp.Config().Audit.SigningKey,
+		AuditVerifyKeys:     sp.Config().Audit.VerifyKeys,
+	}
+}
+
+func (sp *serviceProvider) EventsFormat() string {
+	format := sp.Config().Events.Format
+	if _, err := events.ContentType(format); err != nil {
+		log.Fatalf("invalid events format: %v", err)
+	}
+
+	return format
+}
+
+// EventTopics топики событий пользователя по их типу
+func (sp *serviceProvider) EventTopics() map[string]string {
+	cfg := sp.Config()
+
+	return map[string]string{
+		events.UserCreated:       cfg.NewUsersTopic,
+		events.UserUpdated:       cfg.Events.UserUpdatedTopic,
+		events.UserEmailChanged:  cfg.Events.UserEmailChangedTopic,
+		events.UserDeleted:       cfg.Events.UserDeletedTopic,
+		events.UserRoleChanged:   cfg.Events.UserRoleChangedTopic,
+		events.UserLoggedIn:      cfg.Events.UserLoggedInTopic,
+		events.UserErased:        cfg.Events.UserErasedTopic,
+		events.UserStatusChanged: cfg.Events.UserStatusTopic,
+	}
+}
+
+// KafkaConsumer получатель сообщений kafka, nil в режиме хранения в памяти
 func (sp *serviceProvider) KafkaConsumer() kafka.Consumer {
-	if sp.consumer == nil {
+	if sp.consumer == nil && !sp.InMemory() {
 		cl, err := kafka.NewConsumer(sp.Config().Kafka.Brokers, sp.Config().Kafka.GroupID, sp.Config().Kafka.SaramaConfig())
 		if err != nil {
 			log.Fatalf("failed to create kafka consumer: %v", err)
@@ -150,6 +366,10 @@ func (sp *serviceProvider) KafkaConsumer() kafka.Consumer {
 }
 
 func (sp *serviceProvider) KafkaProducer() sarama.SyncProducer {
+	if sp.producer == nil && sp.InMemory() {
+		sp.producer = eventsMemory.NewProducer(sp.Logger())
+	}
+
 	if sp.producer == nil {
 		producer, err := sarama.NewSyncProducer(sp.Config().Kafka.Brokers, sp.Config().Kafka.SaramaConfig())
 		if err != nil {
@@ -0,0 +1,649 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+func TestUserLifecycle(t *testing.T) {
+	h := newHarness(t)
+
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	tokens := h.login(t, "alice@example.com", "secret123")
+	ctx := withToken(tokens.GetAccessToken())
+
+	got, err := h.client.Get(ctx, &user_v1.GetRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", got.GetEmail())
+	require.NotEmpty(t, got.GetEtag())
+
+	_, err = h.client.Update(ctx, &user_v1.UpdateRequest{
+		Id:         id,
+		Name:       wrapperspb.String("Alice"),
+		Etag:       got.GetEtag(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+
+	updated, err := h.client.Get(ctx, &user_v1.GetRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", updated.GetName())
+	require.NotEqual(t, got.GetEtag(), updated.GetEtag())
+
+	//повторное обновление по старой версии
+	_, err = h.client.Update(ctx, &user_v1.UpdateRequest{
+		Id:         id,
+		Name:       wrapperspb.String("Bob"),
+		Etag:       got.GetEtag(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	access, err := h.client.GetAccessToken(ctx, &user_v1.AccessRequest{RefreshToken: tokens.GetRefreshToken()})
+	require.NoError(t, err)
+	require.NotEmpty(t, access.GetAccessToken())
+
+	refresh, err := h.client.GetRefreshToken(ctx, &user_v1.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+	require.NoError(t, err)
+	require.NotEmpty(t, refresh.GetRefreshToken())
+
+	//новый access-токен рабочий
+	_, err = h.client.Get(withToken(access.GetAccessToken()), &user_v1.GetRequest{Id: id})
+	require.NoError(t, err)
+
+	_, err = h.client.Delete(ctx, &user_v1.DeleteRequest{Id: id})
+	require.NoError(t, err)
+
+	//токен удаленного пользователя больше не принимается
+	_, err = h.client.Get(ctx, &user_v1.GetRequest{Id: id})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestEmailTaken(t *testing.T) {
+	h := newHarness(t)
+
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	h.register(t, "bob@example.com", "secret123", user_v1.Role_USER)
+	alice := h.login(t, "alice@example.com", "secret123")
+
+	_, err := h.client.Create(context.Background(), &user_v1.CreateRequest{
+		Name:            "e2e",
+		Email:           "bob@example.com",
+		Password:        "secret123",
+		PasswordConfirm: "secret123",
+		Role:            user_v1.Role_USER,
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = h.client.Update(withToken(alice.GetAccessToken()), &user_v1.UpdateRequest{
+		Id:         id,
+		Email:      wrapperspb.String("bob@example.com"),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestAccessDenied(t *testing.T) {
+	h := newHarness(t)
+
+	alice := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	bob := h.register(t, "bob@example.com", "secret123", user_v1.Role_USER)
+	tokens := h.login(t, "alice@example.com", "secret123")
+
+	_, err := h.client.Get(context.Background(), &user_v1.GetRequest{Id: alice})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = h.client.Get(withToken("not-a-token"), &user_v1.GetRequest{Id: alice})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = h.client.Get(withToken(tokens.GetAccessToken()), &user_v1.GetRequest{Id: bob})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.client.Delete(withToken(tokens.GetAccessToken()), &user_v1.DeleteRequest{Id: bob})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "wrong"})
+	require.Error(t, err)
+
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: "not-a-token"})
+	require.Error(t, err)
+}
+
+func TestRevokeSessions(t *testing.T) {
+	h := newHarness(t)
+
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	tokens := h.login(t, "alice@example.com", "secret123")
+
+	_, err := h.client.RevokeSessions(withToken(tokens.GetAccessToken()), &user_v1.RevokeSessionsRequest{Id: id})
+	require.NoError(t, err)
+
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: tokens.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	//после повторного входа сессия снова рабочая
+	tokens = h.login(t, "alice@example.com", "secret123")
+	_, err = h.client.GetRefreshToken(context.Background(), &user_v1.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+	require.NoError(t, err)
+}
+
+func TestRestoreDoesNotReviveSessions(t *testing.T) {
+	h := newHarness(t)
+
+	h.registerAdmin(t, "admin@example.com", "admin123")
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	admin := h.login(t, "admin@example.com", "admin123")
+	alice := h.login(t, "alice@example.com", "secret123")
+
+	_, err := h.client.Delete(withToken(alice.GetAccessToken()), &user_v1.DeleteRequest{Id: id})
+	require.NoError(t, err)
+	_, err = h.client.Restore(withToken(admin.GetAccessToken()), &user_v1.RestoreRequest{Id: id})
+	require.NoError(t, err)
+
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: alice.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	h.login(t, "alice@example.com", "secret123")
+}
+
+func TestDemotedAdminLosesRights(t *testing.T) {
+	h := newHarness(t)
+
+	h.registerAdmin(t, "admin@example.com", "admin123")
+	admin := h.login(t, "admin@example.com", "admin123")
+	created, err := h.client.Create(withToken(admin.GetAccessToken()), &user_v1.CreateRequest{
+		Name:            "e2e",
+		Email:           "bob@example.com",
+		Password:        "secret123",
+		PasswordConfirm: "secret123",
+		Role:            user_v1.Role_ADMIN,
+	})
+	require.NoError(t, err)
+	bob := h.login(t, "bob@example.com", "secret123")
+
+	_, err = h.client.Update(withToken(admin.GetAccessToken()), &user_v1.UpdateRequest{
+		Id:         created.GetId(),
+		Role:       user_v1.Role_USER,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	require.NoError(t, err)
+
+	//токены с ролью администратора больше не продлеваются
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: bob.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	bob = h.login(t, "bob@example.com", "secret123")
+	renewed, err := h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: bob.GetRefreshToken()})
+	require.NoError(t, err)
+	_, err = h.client.BlockUser(withToken(renewed.GetAccessToken()), &user_v1.BlockUserRequest{Id: 1, Reason: "spam"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestResetPassword(t *testing.T) {
+	h := newHarness(t)
+
+	h.registerAdmin(t, "admin@example.com", "admin123")
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	admin := h.login(t, "admin@example.com", "admin123")
+	alice := h.login(t, "alice@example.com", "secret123")
+
+	req := &user_v1.ResetPasswordRequest{Id: id, Password: "changed123", PasswordConfirm: "changed123"}
+	_, err := h.client.ResetPassword(withToken(alice.GetAccessToken()), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.client.ResetPassword(withToken(admin.GetAccessToken()), req)
+	require.NoError(t, err)
+
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "secret123"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	h.login(t, "alice@example.com", "changed123")
+
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: alice.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAdminBootstrap(t *testing.T) {
+	h := newHarness(t)
+
+	req := func(email, token string) *user_v1.CreateRequest {
+		return &user_v1.CreateRequest{
+			Name:            "e2e",
+			Email:           email,
+			Password:        "admin123",
+			PasswordConfirm: "admin123",
+			Role:            user_v1.Role_ADMIN,
+			SetupToken:      token,
+		}
+	}
+
+	_, err := h.client.Create(context.Background(), req("anon@example.com", ""))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.client.Create(context.Background(), req("anon@example.com", "wrong"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.client.Create(context.Background(), req("first@example.com", setupToken))
+	require.NoError(t, err)
+
+	//токен одноразовый
+	_, err = h.client.Create(context.Background(), req("second@example.com", setupToken))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	//обычный пользователь администратора не создаст
+	h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	alice := h.login(t, "alice@example.com", "secret123")
+	_, err = h.client.Create(withToken(alice.GetAccessToken()), req("second@example.com", ""))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	admin := h.login(t, "first@example.com", "admin123")
+	_, err = h.client.Create(withToken(admin.GetAccessToken()), req("second@example.com", ""))
+	require.NoError(t, err)
+}
+
+func TestInitialAdmin(t *testing.T) {
+	t.Setenv("INITIAL_ADMIN_EMAIL", "root@example.com")
+	t.Setenv("INITIAL_ADMIN_PASSWORD", "root1234")
+	h := newHarness(t)
+
+	admin := h.login(t, "root@example.com", "root1234")
+	page, err := h.client.ListUsers(withToken(admin.GetAccessToken()), &user_v1.ListUsersRequest{Role: user_v1.Role_ADMIN})
+	require.NoError(t, err)
+	require.Len(t, page.GetUsers(), 1)
+
+	//администратор уже есть, токен настройки не действует
+	_, err = h.client.Create(context.Background(), &user_v1.CreateRequest{
+		Email:           "anon@example.com",
+		Password:        "admin123",
+		PasswordConfirm: "admin123",
+		Role:            user_v1.Role_ADMIN,
+		SetupToken:      setupToken,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuditLog(t *testing.T) {
+	h := newHarness(t)
+
+	adminID := h.registerAdmin(t, "admin@example.com", "admin123")
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	alice := h.login(t, "alice@example.com", "secret123")
+	admin := h.login(t, "admin@example.com", "admin123")
+
+	_, err := h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = h.client.Update(withToken(admin.GetAccessToken()), &user_v1.UpdateRequest{
+		Id:         id,
+		Name:       wrapperspb.String("Alice"),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+
+	_, err = h.client.ListAuditEvents(withToken(alice.GetAccessToken()), &user_v1.ListAuditEventsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	page, err := h.client.ListAuditEvents(withToken(admin.GetAccessToken()), &user_v1.ListAuditEventsRequest{UserId: id})
+	require.NoError(t, err)
+
+	var actions []string
+	for _, e := range page.GetEvents() {
+		actions = append(actions, e.GetAction())
+		require.Equal(t, id, e.GetUserId())
+		require.NotEmpty(t, e.GetRequestId())
+	}
+	require.Equal(t, []string{"ChangeName", "LoginFailed", "Login", "Create"}, actions)
+
+	changed := page.GetEvents()[0]
+	require.Equal(t, adminID, changed.GetActorId())
+	require.Equal(t, "e2e", changed.GetOldValue())
+	require.Equal(t, "Alice", changed.GetNewValue())
+
+	logins, err := h.client.ListAuditEvents(withToken(admin.GetAccessToken()), &user_v1.ListAuditEventsRequest{
+		Actions: []string{"Login"},
+	})
+	require.NoError(t, err)
+	require.Len(t, logins.GetEvents(), 2)
+	require.Equal(t, "password", logins.GetEvents()[0].GetDetails()["method"])
+
+	//постранично те же записи, что и одним запросом
+	var paged []int64
+	req := &user_v1.ListAuditEventsRequest{UserId: id, Limit: 1}
+	for {
+		rsp, err := h.client.ListAuditEvents(withToken(admin.GetAccessToken()), req)
+		require.NoError(t, err)
+		for _, e := range rsp.GetEvents() {
+			paged = append(paged, e.GetId())
+		}
+		if rsp.GetNextCursor() == "" {
+			break
+		}
+		req.Cursor = rsp.GetNextCursor()
+	}
+	require.Len(t, paged, len(page.GetEvents()))
+	require.Equal(t, page.GetEvents()[len(paged)-1].GetId(), paged[len(paged)-1])
+
+	verified, err := h.client.VerifyAuditLog(withToken(admin.GetAccessToken()), &user_v1.VerifyAuditLogRequest{})
+	require.NoError(t, err)
+	require.True(t, verified.GetOk(), verified.GetReason())
+	require.Positive(t, verified.GetChecked())
+}
+
+func TestExportAndErase(t *testing.T) {
+	h := newHarness(t)
+
+	h.registerAdmin(t, "admin@example.com", "admin123")
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	h.register(t, "bob@example.com", "secret123", user_v1.Role_USER)
+	alice := h.login(t, "alice@example.com", "secret123")
+	bob := h.login(t, "bob@example.com", "secret123")
+	admin := h.login(t, "admin@example.com", "admin123")
+
+	_, err := h.client.ExportMyData(withToken(bob.GetAccessToken()), &user_v1.ExportMyDataRequest{Id: id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	rsp, err := h.client.ExportMyData(withToken(alice.GetAccessToken()), &user_v1.ExportMyDataRequest{})
+	require.NoError(t, err)
+
+	var archive models.DataExport
+	require.NoError(t, json.Unmarshal(rsp.GetArchive(), &archive))
+	require.Equal(t, id, archive.Profile.ID)
+	require.Equal(t, "alice@example.com", archive.Profile.Email)
+	require.Len(t, archive.Sessions.Logins, 1)
+	require.Equal(t, "password", archive.Sessions.Logins[0].Method)
+	require.Equal(t, "Create", archive.AuditEvents[0].Action)
+	require.Equal(t, "alice@example.com", archive.LinkedIdentities[0].Subject)
+
+	_, err = h.client.EraseUser(withToken(bob.GetAccessToken()), &user_v1.EraseUserRequest{Id: id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	erased, err := h.client.EraseUser(withToken(alice.GetAccessToken()), &user_v1.EraseUserRequest{Id: id})
+	require.NoError(t, err)
+	require.Positive(t, erased.GetRedactedActions())
+
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "secret123"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: alice.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = h.client.EraseUser(withToken(admin.GetAccessToken()), &user_v1.EraseUserRequest{Id: id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.client.Restore(withToken(admin.GetAccessToken()), &user_v1.RestoreRequest{Id: id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	//почта освободилась, а в журнале от нее ничего не осталось
+	h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+
+	page, err := h.client.ListAuditEvents(withToken(admin.GetAccessToken()), &user_v1.ListAuditEventsRequest{UserId: id})
+	require.NoError(t, err)
+	require.Equal(t, "Erase", page.GetEvents()[0].GetAction())
+	for _, e := range page.GetEvents() {
+		require.NotContains(t, e.String(), "alice@example.com")
+		require.Empty(t, e.GetIp())
+	}
+
+	verified, err := h.client.VerifyAuditLog(withToken(admin.GetAccessToken()), &user_v1.VerifyAuditLogRequest{})
+	require.NoError(t, err)
+	require.True(t, verified.GetOk(), verified.GetReason())
+}
+
+func TestBulkImportExport(t *testing.T) {
+	h := newHarness(t)
+
+	h.registerAdmin(t, "admin@example.com", "admin123")
+	h.register(t, "taken@example.com", "secret123", user_v1.Role_USER)
+	user := h.login(t, "taken@example.com", "secret123")
+	admin := h.login(t, "admin@example.com", "admin123")
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("hashed123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	csvFile := "email,name,role,password,password_hash\n" +
+		"plain@example.com,Plain,user,secret123,\n" +
+		"hashed@example.com,Hashed,admin,," + string(hash) + "\n" +
+		"role@example.com,Role,root,secret123,\n" +
+		"plain@example.com,Again,user,secret123,\n" +
+		"taken@example.com,Taken,user,secret123,\n" +
+		"nopass@example.com,NoPass,user,,\n"
+
+	importFile := func(token string, format user_v1.BulkFormat, file string) (*user_v1.ImportUsersResponse, error) {
+		stream, err := h.client.ImportUsers(withToken(token))
+		require.NoError(t, err)
+
+		//куски режут строки посередине
+		for i := 0; i < len(file); i += 7 {
+			chunk := []byte(file[i:min(i+7, len(file))])
+			require.NoError(t, stream.Send(&user_v1.ImportUsersRequest{Format: format, Chunk: chunk}))
+		}
+
+		return stream.CloseAndRecv()
+	}
+
+	_, err = importFile(user.GetAccessToken(), user_v1.BulkFormat_BULK_FORMAT_CSV, csvFile)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	res, err := importFile(admin.GetAccessToken(), user_v1.BulkFormat_BULK_FORMAT_CSV, csvFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), res.GetTotal())
+	require.Equal(t, int64(2), res.GetImported())
+	require.Equal(t, int64(4), res.GetFailed())
+
+	lines := make([]int64, 0, len(res.GetErrors()))
+	for _, e := range res.GetErrors() {
+		lines = append(lines, e.GetLine())
+	}
+	require.ElementsMatch(t, []int64{4, 5, 6, 7}, lines)
+
+	hashed := h.login(t, "hashed@example.com", "hashed123")
+	require.NotEmpty(t, hashed.GetAccessToken())
+	h.login(t, "plain@example.com", "secret123")
+
+	res, err = importFile(admin.GetAccessToken(), user_v1.BulkFormat_BULK_FORMAT_JSONL,
+		`{"email":"json@example.com","name":"Json","password":"secret123"}`+"\n\n{broken\n")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.GetImported())
+	require.Equal(t, int64(3), res.GetErrors()[0].GetLine())
+
+	//импортированный без активации пользователь входит только после UnblockUser
+	res, err = importFile(admin.GetAccessToken(), user_v1.BulkFormat_BULK_FORMAT_JSONL,
+		`{"email":"pending@example.com","password":"secret123","status":"pending"}`+"\n"+
+			`{"email":"weird@example.com","password":"secret123","status":"blocked"}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.GetImported())
+	require.Equal(t, int64(2), res.GetErrors()[0].GetLine())
+
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "pending@example.com", Password: "secret123"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	//дубли почты в одном файле ищутся без учета регистра, а вход принимает почту в любом регистре
+	res, err = importFile(admin.GetAccessToken(), user_v1.BulkFormat_BULK_FORMAT_JSONL,
+		`{"email":"Case@Example.com","password":"secret123"}`+"\n"+
+			`{"email":"case@example.com","password":"secret123"}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.GetImported())
+	require.Equal(t, int64(2), res.GetErrors()[0].GetLine())
+	h.login(t, "CASE@example.com", "secret123")
+
+	stream, err := h.client.ExportUsers(withToken(admin.GetAccessToken()), &user_v1.ExportUsersRequest{Format: user_v1.BulkFormat_BULK_FORMAT_JSONL})
+	require.NoError(t, err)
+
+	var export bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		export.Write(chunk.GetChunk())
+	}
+
+	emails := make([]string, 0)
+	ids := make(map[string]int64)
+	for _, line := range strings.Split(strings.TrimSpace(export.String()), "\n") {
+		var row models.ExportRow
+		require.NoError(t, json.Unmarshal([]byte(line), &row))
+		emails = append(emails, row.Email)
+		ids[row.Email] = row.ID
+	}
+	require.Equal(t, []string{"admin@example.com", "taken@example.com", "plain@example.com", "hashed@example.com", "json@example.com", "pending@example.com", "case@example.com"}, emails)
+	require.NotContains(t, export.String(), "$2a$")
+
+	activated, err := h.client.UnblockUser(withToken(admin.GetAccessToken()), &user_v1.UnblockUserRequest{Id: ids["pending@example.com"]})
+	require.NoError(t, err)
+	require.Equal(t, user_v1.UserStatus_USER_STATUS_ACTIVE, activated.GetStatus())
+	h.login(t, "pending@example.com", "secret123")
+}
+
+func TestBlockUser(t *testing.T) {
+	h := newHarness(t)
+
+	adminID := h.registerAdmin(t, "admin@example.com", "admin123")
+	id := h.register(t, "alice@example.com", "secret123", user_v1.Role_USER)
+	alice := h.login(t, "alice@example.com", "secret123")
+	admin := h.login(t, "admin@example.com", "admin123")
+	adminCtx := withToken(admin.GetAccessToken())
+
+	_, err := h.client.BlockUser(withToken(alice.GetAccessToken()), &user_v1.BlockUserRequest{Id: adminID, Reason: "spam"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = h.client.BlockUser(adminCtx, &user_v1.BlockUserRequest{Id: adminID, Reason: "spam"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	blocked, err := h.client.BlockUser(adminCtx, &user_v1.BlockUserRequest{Id: id, Reason: "spam"})
+	require.NoError(t, err)
+	require.Equal(t, user_v1.UserStatus_USER_STATUS_BLOCKED, blocked.GetStatus())
+
+	//выданный до блокировки access-токен больше не принимается, войти и продлить сессию нельзя
+	_, err = h.client.Get(withToken(alice.GetAccessToken()), &user_v1.GetRequest{Id: id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "secret123"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = h.client.GetAccessToken(context.Background(), &user_v1.AccessRequest{RefreshToken: alice.GetRefreshToken()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	got, err := h.client.Get(adminCtx, &user_v1.GetRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, user_v1.UserStatus_USER_STATUS_BLOCKED, got.GetStatus())
+	require.Equal(t, "spam", got.GetStatusReason())
+
+	_, err = h.client.BlockUser(adminCtx, &user_v1.BlockUserRequest{Id: id, Reason: "spam", SuspendedUntil: timestamppb.New(time.Now().Add(time.Hour))})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	unblocked, err := h.client.UnblockUser(adminCtx, &user_v1.UnblockUserRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, user_v1.UserStatus_USER_STATUS_ACTIVE, unblocked.GetStatus())
+	_, err = h.client.UnblockUser(adminCtx, &user_v1.UnblockUserRequest{Id: id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	alice = h.login(t, "alice@example.com", "secret123")
+	_, err = h.client.Get(withToken(alice.GetAccessToken()), &user_v1.GetRequest{Id: id})
+	require.NoError(t, err)
+
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+	suspended, err := h.client.BlockUser(adminCtx, &user_v1.BlockUserRequest{Id: id, Reason: "cooldown", SuspendedUntil: timestamppb.New(until)})
+	require.NoError(t, err)
+	require.Equal(t, user_v1.UserStatus_USER_STATUS_SUSPENDED, suspended.GetStatus())
+	require.True(t, until.Equal(suspended.GetSuspendedUntil().AsTime()))
+
+	_, err = h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: "alice@example.com", Password: "secret123"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	page, err := h.client.ListAuditEvents(adminCtx, &user_v1.ListAuditEventsRequest{UserId: id})
+	require.NoError(t, err)
+	actions := make([]string, 0, len(page.GetEvents()))
+	for _, e := range page.GetEvents() {
+		actions = append(actions, e.GetAction())
+	}
+	require.Subset(t, actions, []string{"Block", "Unblock", "Suspend", "LoginFailed"})
+}
+
+func TestHTTPGateway(t *testing.T) {
+	h := newHarness(t)
+
+	rec := h.do(t, http.MethodPost, "/user/v1/create",
+		`{"name":"carol","email":"carol@example.com","password":"secret123","passwordConfirm":"secret123","role":"USER"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var created user_v1.CreateResponse
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), &created))
+	path := "/user/v1/" + strconv.FormatInt(created.GetId(), 10)
+
+	//клиент пытается подставить свой адрес в журнал аудита
+	query := url.Values{"login": {"carol@example.com"}, "password": {"secret123"}}
+	forged := http.Header{
+		"X-Forwarded-For":                   {"203.0.113.7"},
+		"Grpc-Metadata-X-Gateway-Client-Ip": {"203.0.113.8"},
+	}
+	rec = h.do(t, http.MethodPost, "/user/v1/auth?"+query.Encode(), "", forged)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var tokens user_v1.AuthResponse
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), &tokens))
+
+	rsp, err := h.client.ExportMyData(withToken(tokens.GetAccessToken()), &user_v1.ExportMyDataRequest{})
+	require.NoError(t, err)
+	var archive models.DataExport
+	require.NoError(t, json.Unmarshal(rsp.GetArchive(), &archive))
+	require.Len(t, archive.Sessions.Logins, 1)
+	//адрес, с которого пришел запрос в httptest.NewRequest
+	require.Equal(t, "192.0.2.1", archive.Sessions.Logins[0].IP)
+	auth := http.Header{"Authorization": {"Bearer " + tokens.GetAccessToken()}}
+
+	rec = h.do(t, http.MethodGet, path, "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = h.do(t, http.MethodGet, path, "", auth)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	withETag := auth.Clone()
+	withETag.Set("If-Match", etag)
+	rec = h.do(t, http.MethodPatch, path, `{"name":"Carol","update_mask":"name"}`, withETag)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	//ETag устарел после обновления
+	rec = h.do(t, http.MethodPatch, path, `{"name":"Caroline","update_mask":"name"}`, withETag)
+	require.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+
+	rec = h.do(t, http.MethodDelete, path, "", auth)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = h.do(t, http.MethodGet, path, "", auth)
+	require.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+}
+
+// do выполняет http-запрос через шлюз
+func (h *harness) do(t *testing.T, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	h.gateway.ServeHTTP(rec, req)
+
+	return rec
+}
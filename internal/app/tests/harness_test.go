@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/neracastle/auth/internal/app"
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+// setupToken токен создания первого администратора в поднятом приложении
+const setupToken = "e2e-setup"
+
+// harness поднятое целиком приложение без сети: хранилища в памяти, grpc через bufconn
+type harness struct {
+	client  user_v1.UserV1Client
+	gateway http.Handler
+}
+
+// newHarness собирает app.App так же, как main, но с хранилищами в памяти
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+
+	t.Setenv("STORAGE", "memory")
+	t.Setenv("ENV", "disable")
+	t.Setenv("GRPC_PORT", "0")
+	t.Setenv("PG_USER", "e2e")
+	t.Setenv("PG_PWD", "e2e")
+	t.Setenv("JWT_SECRET_KEY", "e2e-secret")
+	t.Setenv("NEW_USERS_TOPIC", "users")
+	t.Setenv("RL_LIMIT", "100000")
+	t.Setenv("ADMIN_SETUP_TOKEN", setupToken)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a := app.NewApp(ctx)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = a.Serve(lis)
+	}()
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", dialer, creds)
+	require.NoError(t, err)
+
+	gateway, err := a.NewGatewayHandler(ctx, "passthrough:///bufnet", dialer, creds)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cancel()
+		_ = conn.Close()
+
+		shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+		defer done()
+		a.Shutdown(shutdownCtx)
+	})
+
+	return &harness{client: user_v1.NewUserV1Client(conn), gateway: gateway}
+}
+
+// register создает пользователя и возвращает его id
+func (h *harness) register(t *testing.T, email, password string, role user_v1.Role) int64 {
+	t.Helper()
+
+	rsp, err := h.client.Create(context.Background(), &user_v1.CreateRequest{
+		Name:            "e2e",
+		Email:           email,
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            role,
+	})
+	require.NoError(t, err)
+
+	return rsp.GetId()
+}
+
+// registerAdmin создает первого администратора по токену настройки и возвращает его id
+func (h *harness) registerAdmin(t *testing.T, email, password string) int64 {
+	t.Helper()
+
+	rsp, err := h.client.Create(context.Background(), &user_v1.CreateRequest{
+		Name:            "e2e",
+		Email:           email,
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            user_v1.Role_ADMIN,
+		SetupToken:      setupToken,
+	})
+	require.NoError(t, err)
+
+	return rsp.GetId()
+}
+
+// login возвращает пару токенов пользователя
+func (h *harness) login(t *testing.T, email, password string) *user_v1.AuthResponse {
+	t.Helper()
+
+	rsp, err := h.client.Auth(context.Background(), &user_v1.AuthRequest{Login: email, Password: password})
+	require.NoError(t, err)
+
+	return rsp
+}
+
+// withToken контекст с access-токеном, как его передает клиент
+func withToken(accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "Authorization", "Bearer "+accessToken)
+}
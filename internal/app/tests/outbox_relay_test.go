@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/app"
+	"github.com/neracastle/auth/internal/config"
+	"github.com/neracastle/auth/internal/repository/memory"
+	outboxMemory "github.com/neracastle/auth/internal/repository/outbox/memory"
+	"github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+)
+
+func TestRelayOutbox(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	txManager := memory.NewClient().DB()
+	repo := outboxMemory.New()
+	cfg := config.Outbox{PollInterval: time.Millisecond, BatchSize: 10, MaxBackoff: 10 * time.Millisecond}
+
+	require.NoError(t, repo.Save(ctx, model.MessageDTO{ID: "sent", Topic: "user.created", Key: "1", Payload: []byte("{}")}))
+	require.NoError(t, repo.Save(ctx, model.MessageDTO{ID: "failed", Topic: "user.created", Key: "2", Payload: []byte("{}")}))
+
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		require.Equal(t, "user.created", msg.Topic)
+		require.Equal(t, "event_id", string(msg.Headers[0].Key))
+		require.Equal(t, "sent", string(msg.Headers[0].Value))
+		return nil
+	})
+	producer.ExpectSendMessageAndFail(errors.New("broker is down"))
+
+	require.NoError(t, app.RelayOutbox(ctx, txManager, repo, producer, cfg))
+
+	//отправленное сообщение больше не выбирается, неотправленное отложено
+	pending, err := repo.FetchPending(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	require.Eventually(t, func() bool {
+		pending, err = repo.FetchPending(ctx, 10)
+		return err == nil && len(pending) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, "failed", pending[0].ID)
+	require.Equal(t, 1, pending[0].Attempts)
+
+	producer.ExpectSendMessageAndSucceed()
+	require.NoError(t, app.RelayOutbox(ctx, txManager, repo, producer, cfg))
+	require.NoError(t, producer.Close())
+
+	pending, err = repo.FetchPending(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestRelayOutboxKeepsKeyOrder(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	txManager := memory.NewClient().DB()
+	repo := outboxMemory.New()
+	cfg := config.Outbox{PollInterval: time.Millisecond, BatchSize: 10, MaxBackoff: 10 * time.Millisecond}
+
+	require.NoError(t, repo.Save(ctx, model.MessageDTO{ID: "created", Topic: "user.created", Key: "1", Payload: []byte("{}")}))
+	require.NoError(t, repo.Save(ctx, model.MessageDTO{ID: "deleted", Topic: "user.deleted", Key: "1", Payload: []byte("{}")}))
+	require.NoError(t, repo.Save(ctx, model.MessageDTO{ID: "other", Topic: "user.created", Key: "2", Payload: []byte("{}")}))
+
+	sent := func(id string) func(*sarama.ProducerMessage) error {
+		return func(msg *sarama.ProducerMessage) error {
+			require.Equal(t, id, string(msg.Headers[0].Value))
+			return nil
+		}
+	}
+
+	//первое сообщение пользователя 1 не ушло: второе ждет его, другой ключ не ждет
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndFail(sent("created"), errors.New("broker is down"))
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(sent("other"))
+	require.NoError(t, app.RelayOutbox(ctx, txManager, repo, producer, cfg))
+
+	pending, err := repo.FetchPending(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	require.Eventually(t, func() bool {
+		pending, err = repo.FetchPending(ctx, 10)
+		return err == nil && len(pending) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, "created", pending[0].ID)
+
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(sent("created"))
+	require.NoError(t, app.RelayOutbox(ctx, txManager, repo, producer, cfg))
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(sent("deleted"))
+	require.NoError(t, app.RelayOutbox(ctx, txManager, repo, producer, cfg))
+	require.NoError(t, producer.Close())
+}
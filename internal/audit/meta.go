@@ -0,0 +1,28 @@
+package audit
+
+import "context"
+
+// Meta обстоятельства запроса, которые попадают в журнал аудита вместе с действием
+type Meta struct {
+	RequestID string
+	TraceID   string
+	IP        string
+}
+
+type metaKey struct{}
+
+// WithMeta кладет обстоятельства запроса в контекст
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// MetaFromContext обстоятельства запроса из контекста, пустые для фоновых задач
+func MetaFromContext(ctx context.Context) Meta {
+	if ctx == nil {
+		return Meta{}
+	}
+
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+
+	return meta
+}
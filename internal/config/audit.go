@@ -0,0 +1,14 @@
+package config
+
+import "time"
+
+// Audit настройки журнала аудита
+type Audit struct {
+	// CheckpointInterval как часто подписывать контрольную точку цепочки журнала
+	CheckpointInterval time.Duration `yaml:"audit_checkpoint_interval" env:"AUDIT_CHECKPOINT_INTERVAL" env-default:"1h"`
+	// SigningKey ключ подписи контрольных точек. Отдельный от ключа jwt: выпуск токенов не дает подделать журнал
+	SigningKey string `yaml:"audit_signing_key" env:"AUDIT_SIGNING_KEY"`
+	// VerifyKeys прежние ключи подписи через запятую. Список только пополняется: точки, подписанные
+	// удаленным ключом, перестанут проверяться
+	VerifyKeys []string `yaml:"audit_verify_keys" env:"AUDIT_VERIFY_KEYS" env-separator:","`
+}
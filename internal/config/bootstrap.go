@@ -0,0 +1,12 @@
+package config
+
+// Bootstrap создание первого администратора. Применяется при старте, только пока администраторов нет
+type Bootstrap struct {
+	// AdminEmail если задан, при старте создается администратор с этой почтой и паролем AdminPassword
+	AdminEmail    string `yaml:"initial_admin_email" env:"INITIAL_ADMIN_EMAIL"`
+	AdminPassword string `yaml:"initial_admin_password" env:"INITIAL_ADMIN_PASSWORD"`
+	AdminName     string `yaml:"initial_admin_name" env:"INITIAL_ADMIN_NAME" env-default:"admin"`
+	// SetupToken одноразовый токен, с которым Create создает первого администратора.
+	// Если не задан и администратор из конфига не указан, генерируется при старте и пишется в лог
+	SetupToken string `yaml:"admin_setup_token" env:"ADMIN_SETUP_TOKEN"`
+}
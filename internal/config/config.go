@@ -14,6 +14,11 @@ const localConfigPath = "./.env"
 type Config struct {
 	Env           string        `yaml:"env" env:"ENV" env-required:"true"`
 	UsersCacheTTL time.Duration `yaml:"users_cache_ttl" env:"USERS_CACHE_TTL" env-default:"60s"`
+	// UsersCacheKey ключ AES-GCM в base64 (16, 24 или 32 байта) для шифрования почты в кэше. Пустой - без шифрования
+	UsersCacheKey string `yaml:"users_cache_key" env:"USERS_CACHE_KEY"`
+	// UsersCacheChannel канал redis, через который экземпляры оповещают друг друга об устаревших записях кэша
+	UsersCacheChannel string `yaml:"users_cache_channel" env:"USERS_CACHE_CHANNEL" env-default:"users:invalidate"`
+	Storage
 	GRPC
 	Postgres
 	Redis
@@ -24,6 +29,17 @@ type Config struct {
 	Prometheus
 	Trace
 	RateLimiter
+	LoginLink
+	Mailer
+	Retention
+	Outbox
+	Events
+	Consumer
+	Idempotency
+	LocalCache
+	Migrations
+	Bootstrap
+	Audit
 	NewUsersTopic string `yaml:"new_users_topic" env:"NEW_USERS_TOPIC" env-required:"true"`
 }
 
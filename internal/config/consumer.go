@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+// Consumer настройки обработки входящих kafka-сообщений
+type Consumer struct {
+	// ChatEventsTopic события чат-сервера, по ним отслеживается последняя активность пользователей
+	ChatEventsTopic string `yaml:"chat_events_topic" env:"CHAT_EVENTS_TOPIC" env-default:"chat.events"`
+	// DeadLetterTopic куда уходят сообщения, которые не удалось обработать
+	DeadLetterTopic string `yaml:"dead_letter_topic" env:"DEAD_LETTER_TOPIC" env-default:"auth.dead_letter"`
+	// MaxAttempts кол-во попыток обработки сообщения
+	MaxAttempts int `yaml:"consumer_max_attempts" env:"CONSUMER_MAX_ATTEMPTS" env-default:"3"`
+	// RetryBackoff задержка перед повторной попыткой, удваивается с каждой попыткой
+	RetryBackoff time.Duration `yaml:"consumer_retry_backoff" env:"CONSUMER_RETRY_BACKOFF" env-default:"500ms"`
+	// InboxTTL сколько хранить отметки об обработанных сообщениях
+	InboxTTL time.Duration `yaml:"consumer_inbox_ttl" env:"CONSUMER_INBOX_TTL" env-default:"168h"`
+}
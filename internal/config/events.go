@@ -0,0 +1,14 @@
+package config
+
+// Events формат и топики событий жизненного цикла пользователя. Топик user.created задается через NewUsersTopic
+type Events struct {
+	// Format json или protobuf (схема в api/events_v1)
+	Format                string `yaml:"events_format" env:"EVENTS_FORMAT" env-default:"json"`
+	UserUpdatedTopic      string `yaml:"user_updated_topic" env:"USER_UPDATED_TOPIC" env-default:"user.updated"`
+	UserEmailChangedTopic string `yaml:"user_email_changed_topic" env:"USER_EMAIL_CHANGED_TOPIC" env-default:"user.email_changed"`
+	UserDeletedTopic      string `yaml:"user_deleted_topic" env:"USER_DELETED_TOPIC" env-default:"user.deleted"`
+	UserRoleChangedTopic  string `yaml:"user_role_changed_topic" env:"USER_ROLE_CHANGED_TOPIC" env-default:"user.role_changed"`
+	UserLoggedInTopic     string `yaml:"user_logged_in_topic" env:"USER_LOGGED_IN_TOPIC" env-default:"user.logged_in"`
+	UserErasedTopic       string `yaml:"user_erased_topic" env:"USER_ERASED_TOPIC" env-default:"user.erased"`
+	UserStatusTopic       string `yaml:"user_status_topic" env:"USER_STATUS_TOPIC" env-default:"user.status_changed"`
+}
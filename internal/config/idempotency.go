@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+// Idempotency настройки ключей идемпотентности
+type Idempotency struct {
+	// TTL сколько хранить ответ для повторов запроса с тем же ключом
+	TTL time.Duration `yaml:"idempotency_ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+}
@@ -4,7 +4,9 @@ import "time"
 
 // JWT настройки jwt-токенов
 type JWT struct {
-	SecretKey       string        `yaml:"secret_key" env:"JWT_SECRET_KEY" env-required:"true"`
-	AccessDuration  time.Duration `yaml:"access_duration" env:"JWT_ACCESS_DURATION" env-default:"5m"`
-	RefreshDuration time.Duration `yaml:"refresh_duration" env:"JWT_REFRESH_DURATION" env-default:"24h"`
+	SecretKey string `yaml:"secret_key" env:"JWT_SECRET_KEY" env-required:"true"`
+	// PreviousSecretKeys прежние ключи через запятую: токены, подписанные ими, принимаются до истечения срока после ротации
+	PreviousSecretKeys []string      `yaml:"previous_secret_keys" env:"JWT_PREVIOUS_SECRET_KEYS" env-separator:","`
+	AccessDuration     time.Duration `yaml:"access_duration" env:"JWT_ACCESS_DURATION" env-default:"5m"`
+	RefreshDuration    time.Duration `yaml:"refresh_duration" env:"JWT_REFRESH_DURATION" env-default:"24h"`
 }
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+// LocalCache кэш пользователей в памяти процесса перед redis
+type LocalCache struct {
+	// Size сколько пользователей держать в памяти, 0 - локальный кэш выключен
+	Size int `yaml:"users_local_cache_size" env:"USERS_LOCAL_CACHE_SIZE" env-default:"0"`
+	// TTL сколько хранить запись. Изменения с других экземпляров приходят через pub/sub,
+	// короткий ttl ограничивает устаревание, если оповещение потерялось
+	TTL time.Duration `yaml:"users_local_cache_ttl" env:"USERS_LOCAL_CACHE_TTL" env-default:"5s"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+// LoginLink настройки входа по одноразовой ссылке из письма
+type LoginLink struct {
+	URL         string        `yaml:"login_link_url" env:"LOGIN_LINK_URL" env-default:"http://localhost:8080/login"`
+	TTL         time.Duration `yaml:"login_link_ttl" env:"LOGIN_LINK_TTL" env-default:"15m"`
+	RateLimit   int           `yaml:"login_link_rate_limit" env:"LOGIN_LINK_RATE_LIMIT" env-default:"3"`
+	RatePeriod  time.Duration `yaml:"login_link_rate_period" env:"LOGIN_LINK_RATE_PERIOD" env-default:"1h"`
+	MailSubject string        `yaml:"login_link_subject" env:"LOGIN_LINK_SUBJECT" env-default:"Вход в аккаунт"`
+}
@@ -0,0 +1,17 @@
+package config
+
+import "net"
+
+// Mailer параметры подключения к smtp-серверу. Если хост не задан, письма только логируются
+type Mailer struct {
+	Host     string `yaml:"smtp_host" env:"SMTP_HOST" env-default:""`
+	Port     string `yaml:"smtp_port" env:"SMTP_PORT" env-default:"587"`
+	User     string `yaml:"smtp_user" env:"SMTP_USER" env-default:""`
+	Password string `yaml:"smtp_password" env:"SMTP_PASSWORD" env-default:""`
+	From     string `yaml:"smtp_from" env:"SMTP_FROM" env-default:"noreply@localhost"`
+}
+
+// Address адрес подключения
+func (m Mailer) Address() string {
+	return net.JoinHostPort(m.Host, m.Port)
+}
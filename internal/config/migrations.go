@@ -0,0 +1,7 @@
+package config
+
+// Migrations настройки миграций схемы бд
+type Migrations struct {
+	// OnStart накатывать встроенные миграции при старте сервиса. Реплики ждут друг друга через advisory lock
+	OnStart bool `yaml:"on_start" env:"MIGRATE_ON_START" env-default:"false"`
+}
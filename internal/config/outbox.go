@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// Outbox настройки отправки сообщений из outbox в kafka
+type Outbox struct {
+	// PollInterval как часто проверять неотправленные сообщения
+	PollInterval time.Duration `yaml:"outbox_poll_interval" env:"OUTBOX_POLL_INTERVAL" env-default:"1s"`
+	// BatchSize сколько сообщений отправлять за один проход
+	BatchSize uint64 `yaml:"outbox_batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	// MaxBackoff максимальная задержка между повторными попытками отправки
+	MaxBackoff time.Duration `yaml:"outbox_max_backoff" env:"OUTBOX_MAX_BACKOFF" env-default:"5m"`
+	// SentTTL сколько хранить отправленные сообщения
+	SentTTL time.Duration `yaml:"outbox_sent_ttl" env:"OUTBOX_SENT_TTL" env-default:"168h"`
+}
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+// Retention настройки хранения мягко удаленных пользователей
+type Retention struct {
+	// DeletedUsersTTL сколько хранить удаленного пользователя до окончательного удаления
+	DeletedUsersTTL time.Duration `yaml:"deleted_users_ttl" env:"DELETED_USERS_TTL" env-default:"720h"`
+	// PurgeInterval как часто запускать окончательное удаление
+	PurgeInterval time.Duration `yaml:"purge_interval" env:"PURGE_INTERVAL" env-default:"1h"`
+}
@@ -0,0 +1,19 @@
+package config
+
+// Режимы хранения данных
+const (
+	// StoragePostgres данные в postgres, кэш в redis, события в kafka
+	StoragePostgres = "postgres"
+	// StorageMemory все в памяти процесса, внешние сервисы не нужны. Для демо и интеграционных тестов
+	StorageMemory = "memory"
+)
+
+// Storage где хранить данные
+type Storage struct {
+	Mode string `yaml:"storage" env:"STORAGE" env-default:"postgres"`
+}
+
+// InMemory включен ли режим хранения в памяти
+func (s Storage) InMemory() bool {
+	return s.Mode == StorageMemory
+}
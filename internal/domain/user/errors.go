@@ -7,3 +7,21 @@ var ErrEmptyEmail = errors.New("email не может быть пустым")
 
 // ErrEmptyPwd если задан пустой пароль
 var ErrEmptyPwd = errors.New("пароль не может быть пустым")
+
+// ErrStatusTransition недопустимая смена статуса
+var ErrStatusTransition = errors.New("недопустимая смена статуса")
+
+// ErrEmptyStatusReason блокировка или приостановка без причины
+var ErrEmptyStatusReason = errors.New("укажите причину блокировки")
+
+// ErrSuspendedUntil срок задан не для приостановки или уже прошел
+var ErrSuspendedUntil = errors.New("срок задается только для приостановки и должен быть в будущем")
+
+// ErrUserBlocked пользователь заблокирован
+var ErrUserBlocked = errors.New("пользователь заблокирован")
+
+// ErrUserSuspended пользователь приостановлен
+var ErrUserSuspended = errors.New("пользователь приостановлен")
+
+// ErrUserPending учетная запись еще не активирована
+var ErrUserPending = errors.New("учетная запись не активирована")
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status статус учетной записи
+type Status string
+
+const (
+	// StatusActive обычный пользователь, вход разрешен
+	StatusActive Status = "active"
+	// StatusBlocked заблокирован администратором до разблокировки
+	StatusBlocked Status = "blocked"
+	// StatusSuspended приостановлен до SuspendedUntil или, если срок не задан, до разблокировки
+	StatusSuspended Status = "suspended"
+	// StatusPending учетная запись еще не активирована, так импортируются пользователи без права входа
+	StatusPending Status = "pending"
+)
+
+// transitions допустимые смены статуса. В pending вернуться нельзя, приостановку можно продлить
+var transitions = map[Status][]Status{
+	StatusPending:   {StatusActive, StatusBlocked},
+	StatusActive:    {StatusBlocked, StatusSuspended},
+	StatusSuspended: {StatusActive, StatusBlocked, StatusSuspended},
+	StatusBlocked:   {StatusActive},
+}
+
+// Valid статус известен
+func (s Status) Valid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// CanTransit можно ли сменить статус s на to
+func (s Status) CanTransit(to Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusAt статус на момент now: истекшая приостановка считается снятой
+func (u *User) StatusAt(now time.Time) Status {
+	if u.Status == StatusSuspended && !u.SuspendedUntil.IsZero() && !now.Before(u.SuspendedUntil) {
+		return StatusActive
+	}
+
+	return u.Status
+}
+
+// CanSignIn проверяет, что пользователю можно входить и продлевать сессии
+func (u *User) CanSignIn(now time.Time) error {
+	switch u.StatusAt(now) {
+	case StatusActive:
+		return nil
+	case StatusSuspended:
+		if u.SuspendedUntil.IsZero() {
+			return ErrUserSuspended
+		}
+
+		return fmt.Errorf("%w до %s", ErrUserSuspended, u.SuspendedUntil.UTC().Format(time.RFC3339))
+	case StatusPending:
+		return ErrUserPending
+	default:
+		return ErrUserBlocked
+	}
+}
+
+// ChangeStatus переводит пользователя в статус to. Блокировка и приостановка требуют причину и отзывают сессии,
+// срок until (нулевой - бессрочно) задается только для приостановки и должен быть в будущем
+func (u *User) ChangeStatus(to Status, reason string, until time.Time, now time.Time) error {
+	from := u.StatusAt(now)
+	if !from.CanTransit(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrStatusTransition, from, to)
+	}
+
+	if to != StatusActive && reason == "" {
+		return ErrEmptyStatusReason
+	}
+
+	if !until.IsZero() && (to != StatusSuspended || !until.After(now)) {
+		return ErrSuspendedUntil
+	}
+
+	u.Status = to
+	u.StatusReason = reason
+	u.SuspendedUntil = until
+	if to != StatusActive {
+		u.RevokeSessions()
+	}
+
+	return nil
+}
@@ -40,6 +40,7 @@ func TestNewUser(t *testing.T) {
 				Password: userData.Password,
 				IsAdmin:  false,
 				RegDate:  time.Now(),
+				Status:   user.StatusActive,
 			},
 			err: nil,
 		},
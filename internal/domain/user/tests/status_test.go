@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/domain/user"
+)
+
+func TestChangeStatus(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		from   user.Status
+		to     user.Status
+		reason string
+		until  time.Time
+		err    error
+	}{
+		{name: "block active", from: user.StatusActive, to: user.StatusBlocked, reason: "spam"},
+		{name: "suspend for a day", from: user.StatusActive, to: user.StatusSuspended, reason: "spam", until: now.Add(24 * time.Hour)},
+		{name: "unblock", from: user.StatusBlocked, to: user.StatusActive},
+		{name: "activate pending", from: user.StatusPending, to: user.StatusActive},
+		{name: "block without reason", from: user.StatusActive, to: user.StatusBlocked, err: user.ErrEmptyStatusReason},
+		{name: "suspend in the past", from: user.StatusActive, to: user.StatusSuspended, reason: "spam", until: now.Add(-time.Hour), err: user.ErrSuspendedUntil},
+		{name: "block with date", from: user.StatusActive, to: user.StatusBlocked, reason: "spam", until: now.Add(time.Hour), err: user.ErrSuspendedUntil},
+		{name: "unblock active", from: user.StatusActive, to: user.StatusActive, err: user.ErrStatusTransition},
+		{name: "suspend blocked", from: user.StatusBlocked, to: user.StatusSuspended, reason: "spam", err: user.ErrStatusTransition},
+		{name: "back to pending", from: user.StatusActive, to: user.StatusPending, err: user.ErrStatusTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &user.User{Status: tt.from}
+
+			err := u.ChangeStatus(tt.to, tt.reason, tt.until, now)
+			require.ErrorIs(t, err, tt.err)
+			if tt.err != nil {
+				require.Equal(t, tt.from, u.Status)
+				return
+			}
+
+			require.Equal(t, tt.to, u.Status)
+			require.Equal(t, tt.to != user.StatusActive, u.SessionVersion > 0)
+		})
+	}
+}
+
+func TestSuspensionExpires(t *testing.T) {
+	now := time.Now()
+	u := &user.User{Status: user.StatusActive}
+	require.NoError(t, u.ChangeStatus(user.StatusSuspended, "spam", now.Add(time.Hour), now))
+
+	require.ErrorIs(t, u.CanSignIn(now), user.ErrUserSuspended)
+	require.NoError(t, u.CanSignIn(now.Add(time.Hour)))
+
+	//истекшая приостановка снята, поэтому ее можно только заменить новой блокировкой
+	require.ErrorIs(t, u.ChangeStatus(user.StatusActive, "", time.Time{}, now.Add(2*time.Hour)), user.ErrStatusTransition)
+	require.NoError(t, u.ChangeStatus(user.StatusBlocked, "again", time.Time{}, now.Add(2*time.Hour)))
+}
@@ -1,6 +1,8 @@
 package user
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -12,10 +14,20 @@ type User struct {
 	Password string
 	IsAdmin  bool
 	RegDate  time.Time
+	// Version увеличивается при каждом изменении, нужна для оптимистичной блокировки
+	Version int64
+	// SessionVersion поколение сессий, увеличивается при отзыве всех выданных токенов
+	SessionVersion int64
+	Status         Status
+	// StatusReason причина блокировки или приостановки
+	StatusReason string
+	// SuspendedUntil когда истекает приостановка, нулевое - бессрочно
+	SuspendedUntil time.Time
 }
 
 // ChangeEmail меняет почту юзера
 func (u *User) ChangeEmail(email string) error {
+	email = NormalizeEmail(email)
 	if email == "" {
 		return ErrEmptyEmail
 	}
@@ -34,8 +46,39 @@ func (u *User) ChangePassword(password string) error {
 	return nil
 }
 
+// RevokeSessions отзывает все выданные ранее токены
+func (u *User) RevokeSessions() {
+	u.SessionVersion++
+}
+
+// SessionValid токен поколения sessionVersion не отозван
+func (u *User) SessionValid(sessionVersion int64) bool {
+	return sessionVersion == u.SessionVersion
+}
+
+// Erase стирает персональные данные: почта заменяется заглушкой, имя и пароль удаляются, сессии отзываются.
+// Войти в стертый аккаунт больше нельзя
+func (u *User) Erase() {
+	u.Email = ErasedEmail(u.ID)
+	u.Name = ""
+	u.Password = ""
+	u.RevokeSessions()
+}
+
+// ErasedEmail почта-заглушка стертого пользователя, уникальная по id.
+// Домен .invalid зарезервирован и никогда не принимает почту
+func ErasedEmail(id int64) string {
+	return fmt.Sprintf("erased-%d@erased.invalid", id)
+}
+
+// NormalizeEmail почта в том виде, в каком она хранится и ищется: без пробелов по краям и в нижнем регистре
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // NewUser создает нового пользователя
 func NewUser(email string, password string, name string) (*User, error) {
+	email = NormalizeEmail(email)
 	if email == "" {
 		return nil, ErrEmptyEmail
 	}
@@ -49,6 +92,7 @@ func NewUser(email string, password string, name string) (*User, error) {
 		Password: password,
 		Email:    email,
 		RegDate:  time.Now(),
+		Status:   StatusActive,
 	}, nil
 }
 
@@ -0,0 +1,9 @@
+package errcode
+
+import syserr "github.com/neracastle/go-libs/pkg/sys/error"
+
+// Коды ошибок сервиса, которых нет в syserr. Нумеруются после последнего кода syserr
+const (
+	// Aborted операцию прервало параллельное изменение тех же данных, клиенту нужно перечитать их и повторить
+	Aborted = syserr.Unknown + 1
+)
@@ -0,0 +1,217 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/neracastle/auth/pkg/events_v1"
+)
+
+// Форматы сериализации событий
+const (
+	FormatJSON     = "json"
+	FormatProtobuf = "protobuf"
+)
+
+// ContentTypeHeader заголовок kafka-сообщения с форматом тела
+const ContentTypeHeader = "content-type"
+
+// Значения заголовка content-type
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// ErrUnknownFormat неизвестный формат сериализации
+var ErrUnknownFormat = errors.New("unknown events format")
+
+// ContentType значение заголовка content-type для формата
+func ContentType(format string) (string, error) {
+	switch format {
+	case FormatJSON:
+		return ContentTypeJSON, nil
+	case FormatProtobuf:
+		return ContentTypeProtobuf, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+}
+
+// Marshal сериализует конверт в выбранном формате. Схема protobuf описана в api/events_v1
+func Marshal(env Envelope, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.Marshal(env)
+	case FormatProtobuf:
+		pb, err := toProto(env)
+		if err != nil {
+			return nil, err
+		}
+
+		return proto.Marshal(pb)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+}
+
+// Unmarshal разбирает конверт по значению content-type. Без заголовка сообщение считается json
+func Unmarshal(data []byte, contentType string) (Envelope, error) {
+	switch contentType {
+	case ContentTypeJSON, "":
+		var env Envelope
+		err := json.Unmarshal(data, &env)
+
+		return env, err
+	case ContentTypeProtobuf:
+		var pb events_v1.Envelope
+		err := proto.Unmarshal(data, &pb)
+		if err != nil {
+			return Envelope{}, err
+		}
+
+		return fromProto(&pb)
+	}
+
+	return Envelope{}, fmt.Errorf("%w: %s", ErrUnknownFormat, contentType)
+}
+
+func toProto(env Envelope) (*events_v1.Envelope, error) {
+	pb := &events_v1.Envelope{
+		Id:         env.ID,
+		Type:       env.Type,
+		Version:    int32(env.Version),
+		OccurredAt: timestamppb.New(env.OccurredAt),
+	}
+
+	if env.Actor != nil {
+		pb.Actor = &events_v1.Actor{UserId: env.Actor.UserID, IsAdmin: env.Actor.IsAdmin}
+	}
+
+	var err error
+	switch env.Type {
+	case UserCreated, UserUpdated:
+		var p User
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_User{User: &events_v1.User{
+			Id:      p.ID,
+			Name:    p.Name,
+			Email:   p.Email,
+			IsAdmin: p.IsAdmin,
+			RegDate: timestamppb.New(p.RegDate),
+		}}
+	case UserEmailChanged:
+		var p EmailChanged
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_EmailChanged{EmailChanged: &events_v1.EmailChanged{
+			UserId:   p.UserID,
+			OldEmail: p.OldEmail,
+			NewEmail: p.NewEmail,
+		}}
+	case UserRoleChanged:
+		var p RoleChanged
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_RoleChanged{RoleChanged: &events_v1.RoleChanged{
+			UserId:   p.UserID,
+			WasAdmin: p.WasAdmin,
+			IsAdmin:  p.IsAdmin,
+		}}
+	case UserDeleted:
+		var p Deleted
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_Deleted{Deleted: &events_v1.Deleted{UserId: p.UserID}}
+	case UserErased:
+		var p Erased
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_Erased{Erased: &events_v1.Erased{
+			UserId:   p.UserID,
+			ErasedAt: timestamppb.New(p.ErasedAt),
+		}}
+	case UserStatusChanged:
+		var p StatusChanged
+		err = json.Unmarshal(env.Payload, &p)
+		msg := &events_v1.StatusChanged{UserId: p.UserID, From: p.From, To: p.To, Reason: p.Reason}
+		if p.SuspendedUntil != nil {
+			msg.SuspendedUntil = timestamppb.New(*p.SuspendedUntil)
+		}
+		pb.Payload = &events_v1.Envelope_StatusChanged{StatusChanged: msg}
+	case UserLoggedIn:
+		var p LoggedIn
+		err = json.Unmarshal(env.Payload, &p)
+		pb.Payload = &events_v1.Envelope_LoggedIn{LoggedIn: &events_v1.LoggedIn{UserId: p.UserID, Method: p.Method}}
+	default:
+		return nil, fmt.Errorf("no protobuf schema for event %s", env.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func fromProto(pb *events_v1.Envelope) (Envelope, error) {
+	var payload any
+	switch p := pb.GetPayload().(type) {
+	case *events_v1.Envelope_User:
+		payload = User{
+			ID:      p.User.GetId(),
+			Name:    p.User.GetName(),
+			Email:   p.User.GetEmail(),
+			IsAdmin: p.User.GetIsAdmin(),
+			RegDate: p.User.GetRegDate().AsTime(),
+		}
+	case *events_v1.Envelope_EmailChanged:
+		payload = EmailChanged{
+			UserID:   p.EmailChanged.GetUserId(),
+			OldEmail: p.EmailChanged.GetOldEmail(),
+			NewEmail: p.EmailChanged.GetNewEmail(),
+		}
+	case *events_v1.Envelope_RoleChanged:
+		payload = RoleChanged{
+			UserID:   p.RoleChanged.GetUserId(),
+			WasAdmin: p.RoleChanged.GetWasAdmin(),
+			IsAdmin:  p.RoleChanged.GetIsAdmin(),
+		}
+	case *events_v1.Envelope_Deleted:
+		payload = Deleted{UserID: p.Deleted.GetUserId()}
+	case *events_v1.Envelope_LoggedIn:
+		payload = LoggedIn{UserID: p.LoggedIn.GetUserId(), Method: p.LoggedIn.GetMethod()}
+	case *events_v1.Envelope_Erased:
+		payload = Erased{UserID: p.Erased.GetUserId(), ErasedAt: p.Erased.GetErasedAt().AsTime()}
+	case *events_v1.Envelope_StatusChanged:
+		changed := StatusChanged{
+			UserID: p.StatusChanged.GetUserId(),
+			From:   p.StatusChanged.GetFrom(),
+			To:     p.StatusChanged.GetTo(),
+			Reason: p.StatusChanged.GetReason(),
+		}
+		if p.StatusChanged.GetSuspendedUntil() != nil {
+			until := p.StatusChanged.GetSuspendedUntil().AsTime()
+			changed.SuspendedUntil = &until
+		}
+		payload = changed
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	env := Envelope{
+		ID:         pb.GetId(),
+		Type:       pb.GetType(),
+		Version:    int(pb.GetVersion()),
+		OccurredAt: pb.GetOccurredAt().AsTime(),
+		Payload:    raw,
+	}
+
+	if pb.GetActor() != nil {
+		env.Actor = &Actor{UserID: pb.GetActor().GetUserId(), IsAdmin: pb.GetActor().GetIsAdmin()}
+	}
+
+	return env, nil
+}
@@ -0,0 +1,56 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchemaVersion версия формата конверта событий. Увеличивается при несовместимых изменениях
+const SchemaVersion = 1
+
+// Типы событий жизненного цикла пользователя
+const (
+	UserCreated       = "user.created"
+	UserUpdated       = "user.updated"
+	UserEmailChanged  = "user.email_changed"
+	UserDeleted       = "user.deleted"
+	UserRoleChanged   = "user.role_changed"
+	UserLoggedIn      = "user.logged_in"
+	UserErased        = "user.erased"
+	UserStatusChanged = "user.status_changed"
+)
+
+// Actor инициатор события. Отсутствует, если действие выполнено без авторизации (регистрация, вход)
+type Actor struct {
+	UserID  int64 `json:"user_id"`
+	IsAdmin bool  `json:"is_admin"`
+}
+
+// Envelope конверт события, отправляемый в kafka
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Actor      *Actor          `json:"actor,omitempty"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// New создает конверт события с новым идентификатором
+func New(eventType string, actor *Actor, payload any) (Envelope, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Version:    SchemaVersion,
+		OccurredAt: time.Now().UTC(),
+		Actor:      actor,
+		Payload:    raw,
+	}, nil
+}
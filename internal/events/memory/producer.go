@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/IBM/sarama"
+	"golang.org/x/exp/slog"
+)
+
+var _ sarama.SyncProducer = (*Producer)(nil)
+
+// keepMessages сколько последних сообщений хранить, чтобы долгий запуск не съел память
+const keepMessages = 1000
+
+// Producer отправитель сообщений без kafka: складывает сообщения в память и пишет их в лог
+type Producer struct {
+	log *slog.Logger
+
+	mu      sync.Mutex
+	offsets map[string]int64
+	sent    []*sarama.ProducerMessage
+}
+
+// NewProducer новый отправитель
+func NewProducer(log *slog.Logger) *Producer {
+	return &Producer{log: log, offsets: make(map[string]int64)}
+}
+
+func (p *Producer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	msg.Offset = p.offsets[msg.Topic]
+	p.offsets[msg.Topic]++
+	p.sent = append(p.sent, msg)
+	if len(p.sent) > keepMessages {
+		p.sent = p.sent[len(p.sent)-keepMessages:]
+	}
+
+	p.log.Debug("message produced", slog.String("topic", msg.Topic), slog.Int64("offset", msg.Offset))
+
+	return 0, msg.Offset, nil
+}
+
+func (p *Producer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		_, _, _ = p.SendMessage(msg)
+	}
+
+	return nil
+}
+
+// Messages последние отправленные в topic сообщения по порядку
+func (p *Producer) Messages(topic string) []*sarama.ProducerMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var res []*sarama.ProducerMessage
+	for _, msg := range p.sent {
+		if msg.Topic == topic {
+			res = append(res, msg)
+		}
+	}
+
+	return res
+}
+
+func (p *Producer) Close() error {
+	return nil
+}
+
+func (p *Producer) TxnStatus() sarama.ProducerTxnStatusFlag {
+	return sarama.ProducerTxnFlagReady
+}
+
+func (p *Producer) IsTransactional() bool {
+	return false
+}
+
+func (p *Producer) BeginTxn() error {
+	return nil
+}
+
+func (p *Producer) CommitTxn() error {
+	return nil
+}
+
+func (p *Producer) AbortTxn() error {
+	return nil
+}
+
+func (p *Producer) AddOffsetsToTxn(map[string][]*sarama.PartitionOffsetMetadata, string) error {
+	return nil
+}
+
+func (p *Producer) AddMessageToTxn(*sarama.ConsumerMessage, string, *string) error {
+	return nil
+}
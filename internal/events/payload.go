@@ -0,0 +1,76 @@
+package events
+
+import (
+	"time"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+)
+
+// User публичные данные пользователя для событий user.created и user.updated.
+// Пароль сюда не попадает
+type User struct {
+	ID      int64     `json:"id"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	IsAdmin bool      `json:"is_admin"`
+	RegDate time.Time `json:"reg_date"`
+}
+
+// EmailChanged данные события user.email_changed
+type EmailChanged struct {
+	UserID   int64  `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
+
+// RoleChanged данные события user.role_changed
+type RoleChanged struct {
+	UserID   int64 `json:"user_id"`
+	WasAdmin bool  `json:"was_admin"`
+	IsAdmin  bool  `json:"is_admin"`
+}
+
+// Deleted данные события user.deleted
+type Deleted struct {
+	UserID int64 `json:"user_id"`
+}
+
+// Erased данные события user.erased: персональные данные пользователя стерты,
+// получатели должны удалить или обезличить свои копии
+type Erased struct {
+	UserID   int64     `json:"user_id"`
+	ErasedAt time.Time `json:"erased_at"`
+}
+
+// StatusChanged данные события user.status_changed: пользователя заблокировали, приостановили или вернули.
+// SuspendedUntil задан только для приостановки на срок
+type StatusChanged struct {
+	UserID         int64      `json:"user_id"`
+	From           string     `json:"from"`
+	To             string     `json:"to"`
+	Reason         string     `json:"reason,omitempty"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+}
+
+// Способы входа для события user.logged_in
+const (
+	LoginPassword  = "password"
+	LoginMagicLink = "login_link"
+)
+
+// LoggedIn данные события user.logged_in
+type LoggedIn struct {
+	UserID int64  `json:"user_id"`
+	Method string `json:"method"`
+}
+
+// FromDomainUser публичная проекция доменного пользователя
+func FromDomainUser(u *domain.User) User {
+	return User{
+		ID:      u.ID,
+		Name:    u.Name,
+		Email:   u.Email,
+		IsAdmin: u.IsAdmin,
+		RegDate: u.RegDate,
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/events"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	userID := gofakeit.Int64()
+	until := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name      string
+		eventType string
+		payload   any
+	}{
+		{
+			name:      "user created",
+			eventType: events.UserCreated,
+			payload: events.User{
+				ID:      userID,
+				Name:    gofakeit.Name(),
+				Email:   gofakeit.Email(),
+				RegDate: time.Now().UTC().Truncate(time.Second),
+			},
+		},
+		{
+			name:      "email changed",
+			eventType: events.UserEmailChanged,
+			payload:   events.EmailChanged{UserID: userID, OldEmail: gofakeit.Email(), NewEmail: gofakeit.Email()},
+		},
+		{
+			name:      "role changed",
+			eventType: events.UserRoleChanged,
+			payload:   events.RoleChanged{UserID: userID, IsAdmin: true},
+		},
+		{
+			name:      "deleted",
+			eventType: events.UserDeleted,
+			payload:   events.Deleted{UserID: userID},
+		},
+		{
+			name:      "logged in",
+			eventType: events.UserLoggedIn,
+			payload:   events.LoggedIn{UserID: userID, Method: events.LoginPassword},
+		},
+		{
+			name:      "erased",
+			eventType: events.UserErased,
+			payload:   events.Erased{UserID: userID, ErasedAt: time.Now().UTC().Truncate(time.Second)},
+		},
+		{
+			name:      "suspended",
+			eventType: events.UserStatusChanged,
+			payload:   events.StatusChanged{UserID: userID, From: "active", To: "suspended", Reason: "spam", SuspendedUntil: &until},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, format := range []string{events.FormatJSON, events.FormatProtobuf} {
+			t.Run(tt.name+" "+format, func(t *testing.T) {
+				env, err := events.New(tt.eventType, &events.Actor{UserID: userID, IsAdmin: true}, tt.payload)
+				require.NoError(t, err)
+
+				raw, err := events.Marshal(env, format)
+				require.NoError(t, err)
+
+				contentType, err := events.ContentType(format)
+				require.NoError(t, err)
+
+				decoded, err := events.Unmarshal(raw, contentType)
+				require.NoError(t, err)
+				require.Equal(t, env.ID, decoded.ID)
+				require.Equal(t, env.Type, decoded.Type)
+				require.Equal(t, env.Version, decoded.Version)
+				require.True(t, env.OccurredAt.Equal(decoded.OccurredAt))
+				require.Equal(t, env.Actor, decoded.Actor)
+				require.JSONEq(t, string(env.Payload), string(decoded.Payload))
+			})
+		}
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	env, err := events.New(events.UserDeleted, nil, events.Deleted{UserID: 1})
+	require.NoError(t, err)
+
+	_, err = events.Marshal(env, "xml")
+	require.ErrorIs(t, err, events.ErrUnknownFormat)
+
+	_, err = events.ContentType("xml")
+	require.ErrorIs(t, err, events.ErrUnknownFormat)
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+)
+
+func TestNewEnvelope(t *testing.T) {
+	pwd := gofakeit.Password(true, true, true, false, false, 12)
+	usr, err := domain.NewUser(gofakeit.Email(), pwd, gofakeit.Name())
+	require.NoError(t, err)
+	usr.ID = gofakeit.Int64()
+
+	actor := &events.Actor{UserID: usr.ID}
+	env, err := events.New(events.UserCreated, actor, events.FromDomainUser(usr))
+	require.NoError(t, err)
+	require.NotEmpty(t, env.ID)
+	require.Equal(t, events.UserCreated, env.Type)
+	require.Equal(t, events.SchemaVersion, env.Version)
+	require.False(t, env.OccurredAt.IsZero())
+
+	raw, err := json.Marshal(env)
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(raw), pwd), "пароль не должен попадать в событие")
+	require.NotContains(t, string(raw), "password")
+
+	var decoded events.Envelope
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Equal(t, env.ID, decoded.ID)
+	require.Equal(t, actor, decoded.Actor)
+
+	var payload events.User
+	require.NoError(t, json.Unmarshal(decoded.Payload, &payload))
+	require.Equal(t, usr.ID, payload.ID)
+	require.Equal(t, usr.Email, payload.Email)
+}
@@ -0,0 +1,33 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// ListAuditEvents возвращает страницу журнала аудита
+func (s *Server) ListAuditEvents(ctx context.Context, req *userdesc.ListAuditEventsRequest) (*userdesc.ListAuditEventsResponse, error) {
+	page, err := s.srv.ListAuditEvents(ctx, FromGrpcToAuditListUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return FromUsecaseToAuditResponse(page), nil
+}
+
+// VerifyAuditLog проверяет цепочку журнала аудита
+func (s *Server) VerifyAuditLog(ctx context.Context, _ *userdesc.VerifyAuditLogRequest) (*userdesc.VerifyAuditLogResponse, error) {
+	res, err := s.srv.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.VerifyAuditLogResponse{
+		Ok:          res.BrokenID == 0,
+		Checked:     res.Checked,
+		Checkpoints: int32(res.Checkpoints),
+		BrokenId:    res.BrokenID,
+		Reason:      res.Reason,
+	}, nil
+}
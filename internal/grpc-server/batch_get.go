@@ -0,0 +1,26 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// BatchGet возвращает данные нескольких клиентов за один запрос
+func (s *Server) BatchGet(ctx context.Context, req *userdesc.BatchGetRequest) (*userdesc.BatchGetResponse, error) {
+	users, notFound, err := s.srv.BatchGet(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &userdesc.BatchGetResponse{
+		Users:       make([]*userdesc.GetResponse, 0, len(users)),
+		NotFoundIds: notFound,
+	}
+
+	for _, u := range users {
+		rsp.Users = append(rsp.Users, FromUsecaseToGetResponse(u))
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,95 @@
+package grpc_server
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	def "github.com/neracastle/auth/internal/usecases/models"
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// exportChunk размер куска выгрузки в одном сообщении потока
+const exportChunk = 32 * 1024
+
+// ImportUsers собирает файл импорта из кусков потока и создает пользователей
+func (s *Server) ImportUsers(stream userdesc.UserV1_ImportUsersServer) error {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	r := &importReader{stream: stream, buf: first.GetChunk()}
+	res, err := s.srv.ImportUsers(stream.Context(), FromGrpcToBulkFormat(first.GetFormat()), r)
+	if err != nil {
+		return err
+	}
+
+	resp := &userdesc.ImportUsersResponse{
+		Total:    res.Total,
+		Imported: res.Imported,
+		Failed:   res.Failed,
+		Errors:   make([]*userdesc.ImportRowError, 0, len(res.Errors)),
+	}
+	for _, e := range res.Errors {
+		resp.Errors = append(resp.Errors, &userdesc.ImportRowError{Line: e.Line, Email: e.Email, Error: e.Error})
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// ExportUsers выгружает пользователей кусками в поток
+func (s *Server) ExportUsers(req *userdesc.ExportUsersRequest, stream userdesc.UserV1_ExportUsersServer) error {
+	w := bufio.NewWriterSize(exportWriter{stream: stream}, exportChunk)
+	if _, err := s.srv.ExportUsers(stream.Context(), FromGrpcToBulkFormat(req.GetFormat()), w); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// FromGrpcToBulkFormat формат импорта и выгрузки сервисного слоя
+func FromGrpcToBulkFormat(format userdesc.BulkFormat) string {
+	if format == userdesc.BulkFormat_BULK_FORMAT_JSONL {
+		return def.BulkJSONL
+	}
+
+	return def.BulkCSV
+}
+
+// importReader читает куски из потока импорта подряд, как один файл
+type importReader struct {
+	stream userdesc.UserV1_ImportUsersServer
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// exportWriter отправляет каждую запись отдельным сообщением, куски собирает bufio.Writer
+type exportWriter struct {
+	stream userdesc.UserV1_ExportUsersServer
+}
+
+func (w exportWriter) Write(p []byte) (int, error) {
+	//grpc не разрешает менять сообщение после Send, а bufio.Writer переиспользует p, поэтому копия
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	if err := w.stream.Send(&userdesc.ExportUsersResponse{Chunk: chunk}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
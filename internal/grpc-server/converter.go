@@ -1,8 +1,13 @@
 package grpc_server
 
 import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	domain "github.com/neracastle/auth/internal/domain/user"
 	usecases "github.com/neracastle/auth/internal/usecases/models"
 	"github.com/neracastle/auth/pkg/user_v1"
 )
@@ -15,6 +20,7 @@ func FromGrpcToCreateUsecase(req *user_v1.CreateRequest) usecases.CreateDTO {
 		PasswordConfirm: req.PasswordConfirm,
 		Name:            req.Name,
 		IsAdmin:         false,
+		SetupToken:      req.GetSetupToken(),
 	}
 
 	if req.Role == user_v1.Role_ADMIN {
@@ -24,23 +30,55 @@ func FromGrpcToCreateUsecase(req *user_v1.CreateRequest) usecases.CreateDTO {
 	return dto
 }
 
-// FromGrpcToUpdateUsecase преобразует grpc-запрос в дто сервисного слоя
-func FromGrpcToUpdateUsecase(req *user_v1.UpdateRequest) usecases.UpdateDTO {
-	dto := usecases.UpdateDTO{
-		ID:    req.Id,
-		Email: req.GetEmail().String(),
-		Name:  req.GetName().String(),
-		Role:  0,
+// FromGrpcToUpdateUsecase преобразует grpc-запрос в дто сервисного слоя.
+// Меняются только поля из update_mask, без маски - поля, переданные в запросе
+func FromGrpcToUpdateUsecase(req *user_v1.UpdateRequest) (usecases.UpdateDTO, error) {
+	dto := usecases.UpdateDTO{ID: req.GetId()}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.GetName() != nil {
+			paths = append(paths, usecases.UpdateFieldName)
+		}
+		if req.GetEmail() != nil {
+			paths = append(paths, usecases.UpdateFieldEmail)
+		}
+		if req.GetRole() != user_v1.Role_UNKNOWN {
+			paths = append(paths, usecases.UpdateFieldRole)
+		}
 	}
 
-	switch req.GetRole() {
-	case user_v1.Role_ADMIN:
-		dto.Role = 1
-	case user_v1.Role_USER:
-		dto.Role = 2
+	if len(paths) == 0 {
+		return dto, status.Error(codes.InvalidArgument, "Не указаны поля для изменения")
 	}
 
-	return dto
+	for _, path := range paths {
+		if slices.Contains(dto.Fields, path) {
+			continue
+		}
+
+		switch path {
+		case usecases.UpdateFieldName:
+			dto.Name = req.GetName().GetValue()
+		case usecases.UpdateFieldEmail:
+			dto.Email = req.GetEmail().GetValue()
+		case usecases.UpdateFieldRole:
+			switch req.GetRole() {
+			case user_v1.Role_ADMIN:
+				dto.IsAdmin = true
+			case user_v1.Role_USER:
+				dto.IsAdmin = false
+			default:
+				return dto, status.Error(codes.InvalidArgument, "Роль не задана")
+			}
+		default:
+			return dto, status.Errorf(codes.InvalidArgument, "Неизвестное поле в update_mask: %s", path)
+		}
+
+		dto.Fields = append(dto.Fields, path)
+	}
+
+	return dto, nil
 }
 
 // FromUsecaseToGetResponse преобразует дто сервисного слоя в grpc-ответ
@@ -51,11 +89,136 @@ func FromUsecaseToGetResponse(dto usecases.UserDTO) *user_v1.GetResponse {
 		Email:     dto.Email,
 		Role:      user_v1.Role_USER,
 		CreatedAt: timestamppb.New(dto.CreatedAt),
+		Etag:      FormatETag(dto.Version),
+		Status:    FromUsecaseToStatus(dto.Status),
+
+		StatusReason: dto.StatusReason,
 	}
 
 	if dto.IsAdmin {
 		rsp.Role = user_v1.Role_ADMIN
 	}
 
+	if !dto.SuspendedUntil.IsZero() {
+		rsp.SuspendedUntil = timestamppb.New(dto.SuspendedUntil)
+	}
+
+	return rsp
+}
+
+// FromUsecaseToStatus статус пользователя в grpc
+func FromUsecaseToStatus(status string) user_v1.UserStatus {
+	switch domain.Status(status) {
+	case domain.StatusActive:
+		return user_v1.UserStatus_USER_STATUS_ACTIVE
+	case domain.StatusBlocked:
+		return user_v1.UserStatus_USER_STATUS_BLOCKED
+	case domain.StatusSuspended:
+		return user_v1.UserStatus_USER_STATUS_SUSPENDED
+	case domain.StatusPending:
+		return user_v1.UserStatus_USER_STATUS_PENDING
+	}
+
+	return user_v1.UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// FromGrpcToListUsecase преобразует grpc-запрос списка в дто сервисного слоя
+func FromGrpcToListUsecase(req *user_v1.ListUsersRequest) usecases.ListDTO {
+	dto := usecases.ListDTO{
+		Limit:  req.GetLimit(),
+		Cursor: req.GetCursor(),
+		Query:  req.GetQuery(),
+		Desc:   req.GetDesc(),
+	}
+
+	switch req.GetRole() {
+	case user_v1.Role_ADMIN:
+		isAdmin := true
+		dto.IsAdmin = &isAdmin
+	case user_v1.Role_USER:
+		isAdmin := false
+		dto.IsAdmin = &isAdmin
+	}
+
+	if req.GetCreatedFrom() != nil {
+		dto.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+
+	if req.GetCreatedTo() != nil {
+		dto.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+
+	switch req.GetSortBy() {
+	case user_v1.SortField_SORT_FIELD_EMAIL:
+		dto.SortBy = "email"
+	case user_v1.SortField_SORT_FIELD_NAME:
+		dto.SortBy = "name"
+	case user_v1.SortField_SORT_FIELD_CREATED_AT:
+		dto.SortBy = "created_at"
+	default:
+		dto.SortBy = "id"
+	}
+
+	return dto
+}
+
+// FromUsecaseToListResponse преобразует страницу пользователей в grpc-ответ
+func FromUsecaseToListResponse(page usecases.UsersPage) *user_v1.ListUsersResponse {
+	rsp := &user_v1.ListUsersResponse{
+		Users:      make([]*user_v1.GetResponse, 0, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, u := range page.Users {
+		rsp.Users = append(rsp.Users, FromUsecaseToGetResponse(u))
+	}
+
+	return rsp
+}
+
+// FromGrpcToAuditListUsecase преобразует grpc-запрос журнала аудита в дто сервисного слоя
+func FromGrpcToAuditListUsecase(req *user_v1.ListAuditEventsRequest) usecases.AuditListDTO {
+	dto := usecases.AuditListDTO{
+		UserID:  req.GetUserId(),
+		ActorID: req.GetActorId(),
+		Actions: req.GetActions(),
+		Limit:   req.GetLimit(),
+		Cursor:  req.GetCursor(),
+	}
+
+	if req.GetFrom() != nil {
+		dto.From = req.GetFrom().AsTime()
+	}
+
+	if req.GetTo() != nil {
+		dto.To = req.GetTo().AsTime()
+	}
+
+	return dto
+}
+
+// FromUsecaseToAuditResponse преобразует страницу журнала аудита в grpc-ответ
+func FromUsecaseToAuditResponse(page usecases.AuditPage) *user_v1.ListAuditEventsResponse {
+	rsp := &user_v1.ListAuditEventsResponse{
+		Events:     make([]*user_v1.AuditEvent, 0, len(page.Events)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, e := range page.Events {
+		rsp.Events = append(rsp.Events, &user_v1.AuditEvent{
+			Id:        e.ID,
+			UserId:    e.UserID,
+			ActorId:   e.ActorID,
+			Action:    e.Action,
+			OldValue:  e.OldValue,
+			NewValue:  e.NewValue,
+			RequestId: e.RequestID,
+			TraceId:   e.TraceID,
+			Ip:        e.IP,
+			Details:   e.Details,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+
 	return rsp
 }
@@ -0,0 +1,53 @@
+package grpc_server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// IfMatchHeader версия в метаданных grpc (в http - заголовок If-Match)
+const IfMatchHeader = "if-match"
+
+// FormatETag ETag по версии пользователя, в кавычках как в http
+func FormatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseETag разбирает ETag в версию. Пустой ETag или "*" означают обновление без проверки версии
+func ParseETag(etag string) (int64, error) {
+	etag = strings.TrimSpace(etag)
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version < 1 {
+		return 0, status.Error(codes.InvalidArgument, "Некорректный ETag")
+	}
+
+	return version, nil
+}
+
+// etagFromRequest ETag из тела запроса, а если его нет - из заголовка If-Match
+func etagFromRequest(ctx context.Context, etag string) string {
+	if etag != "" {
+		return etag
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(IfMatchHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
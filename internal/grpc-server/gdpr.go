@@ -0,0 +1,32 @@
+package grpc_server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// ExportMyData выгружает персональные данные пользователя
+func (s *Server) ExportMyData(ctx context.Context, req *userdesc.ExportMyDataRequest) (*userdesc.ExportMyDataResponse, error) {
+	archive, err := s.srv.ExportMyData(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.ExportMyDataResponse{Archive: archive}, nil
+}
+
+// EraseUser стирает персональные данные пользователя
+func (s *Server) EraseUser(ctx context.Context, req *userdesc.EraseUserRequest) (*userdesc.EraseUserResponse, error) {
+	res, err := s.srv.EraseUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.EraseUserResponse{
+		RedactedActions: res.RedactedActions,
+		ErasedAt:        timestamppb.New(res.ErasedAt),
+	}, nil
+}
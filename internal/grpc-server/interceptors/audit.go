@@ -0,0 +1,55 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/neracastle/auth/internal/audit"
+)
+
+// GatewayClientIPHeader адрес http-клиента, который передает gateway. X-Forwarded-For не используется:
+// gateway оставляет в нем значения, присланные клиентом
+const GatewayClientIPHeader = "x-gateway-client-ip"
+
+// AuditMetaInterceptor кладет в контекст id запроса, trace id и ip клиента для журнала аудита.
+// Ставится после RequestIDInterceptor
+func AuditMetaInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	meta := audit.Meta{RequestID: RequestIDFromContext(ctx), IP: clientIP(ctx)}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		meta.TraceID = sc.TraceID().String()
+	}
+
+	return handler(audit.WithMeta(ctx, meta), req)
+}
+
+// clientIP адрес клиента. Адрес от gateway учитывается только с локального соединения (loopback, unix-сокет),
+// по которому ходит gateway того же процесса: остальные клиенты могли бы подставить любой адрес
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !ip.IsLoopback() {
+		return host
+	}
+
+	//значение ставит gateway последним, присланное клиентом заголовком он не пропускает
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if fwd := md.Get(GatewayClientIPHeader); len(fwd) > 0 && fwd[len(fwd)-1] != "" {
+			return fwd[len(fwd)-1]
+		}
+	}
+
+	return host
+}
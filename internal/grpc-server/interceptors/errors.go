@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/neracastle/auth/internal/errcode"
 )
 
 type GRPCStatusInterface interface {
@@ -58,6 +60,9 @@ func toGRPCCode(code syserr.Code) codes.Code {
 		res = codes.DeadlineExceeded
 	case syserr.NotFound:
 		res = codes.NotFound
+	case syserr.DomainLogic:
+		//нарушение правил домена, например недопустимая смена статуса или пустая почта в Update
+		res = codes.FailedPrecondition
 	case syserr.AlreadyExists:
 		res = codes.AlreadyExists
 	case syserr.PermissionDenied:
@@ -68,6 +73,8 @@ func toGRPCCode(code syserr.Code) codes.Code {
 		res = codes.Internal
 	case syserr.Unauthenticated:
 		res = codes.Unauthenticated
+	case errcode.Aborted:
+		res = codes.Aborted
 	default:
 		res = codes.Unknown
 	}
@@ -0,0 +1,165 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/neracastle/auth/internal/repository/idempotency"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// IdempotencyKeyHeader ключ идемпотентности в метаданных grpc (в http - заголовок Idempotency-Key)
+const IdempotencyKeyHeader = "idempotency-key"
+
+// maxIdempotencyKeyLen ограничение длины ключа, чтобы не раздувать ключи в хранилище
+const maxIdempotencyKeyLen = 128
+
+// NewIdempotencyInterceptor повторный запрос с тем же Idempotency-Key и телом получает сохраненный ответ
+// без повторного выполнения. Тот же ключ с другим телом отклоняется с FailedPrecondition.
+// Применяется только к перечисленным методам, ответы хранятся ttl
+func NewIdempotencyInterceptor(repo idempotency.Repository, ttl time.Duration, methods []string) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		guarded[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Error(codes.InvalidArgument, "Слишком длинный Idempotency-Key")
+		}
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		log := logger.GetLogger(ctx).With(slog.String("idempotency_key", key))
+
+		hash, err := requestHash(info.FullMethod, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		storeKey := idempotencyStoreKey(ctx, info.FullMethod, key)
+		rec, reserved, err := repo.Reserve(ctx, storeKey, hash, ttl)
+		if err != nil {
+			//хранилище недоступно - выполняем запрос без защиты от повторов, чем отказывать
+			log.Error("failed to reserve idempotency key", slog.String("error", err.Error()))
+			return handler(ctx, req)
+		}
+
+		if !reserved {
+			return replay(rec, hash)
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			//ошибки не сохраняем, запрос с этим ключом можно повторить
+			if errRelease := repo.Release(ctx, storeKey); errRelease != nil {
+				log.Error("failed to release idempotency key", slog.String("error", errRelease.Error()))
+			}
+
+			return res, err
+		}
+
+		resMsg, ok := res.(proto.Message)
+		if !ok {
+			return res, nil
+		}
+
+		packed, err := anypb.New(resMsg)
+		if err == nil {
+			var raw []byte
+			raw, err = proto.Marshal(packed)
+			if err == nil {
+				err = repo.Complete(ctx, storeKey, idempotency.Record{RequestHash: hash, Response: raw, Done: true}, ttl)
+			}
+		}
+		if err != nil {
+			log.Error("failed to store idempotent response", slog.String("error", err.Error()))
+		}
+
+		return res, nil
+	}
+}
+
+func replay(rec *idempotency.Record, hash string) (interface{}, error) {
+	if rec.RequestHash != hash {
+		return nil, status.Error(codes.FailedPrecondition, "Idempotency-Key уже использован с другим запросом")
+	}
+
+	if !rec.Done {
+		return nil, status.Error(codes.Aborted, "Запрос с этим Idempotency-Key еще выполняется")
+	}
+
+	var packed anypb.Any
+	err := proto.Unmarshal(rec.Response, &packed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return res, nil
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// idempotencyStoreKey ключи разных методов и пользователей не пересекаются
+func idempotencyStoreKey(ctx context.Context, method string, key string) string {
+	var userID int64
+	if tokenUser, ok := ctx.Value(auth.AuthorisedUserIDKey{}).(auth.JWTUser); ok {
+		userID = tokenUser.ID
+	}
+
+	return fmt.Sprintf("%s:%d:%s", method, userID, key)
+}
+
+func requestHash(method string, msg proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write(raw)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
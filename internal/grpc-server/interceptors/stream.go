@@ -0,0 +1,31 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// StreamFromUnary применяет unary-перехватчик к потоковому методу: лимиты, логгер, метрики, коды ошибок
+// и проверка доступа работают для потоков так же, как для обычных методов. Перехватчик получает пустой req
+// и видит только контекст, поэтому перехватчики, которым нужно тело запроса, так не переносятся
+func StreamFromUnary(unary grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		unaryInfo := &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}
+		_, err := unary(ss.Context(), nil, unaryInfo, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		})
+
+		return err
+	}
+}
+
+// contextStream поток с контекстом, дополненным перехватчиками
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
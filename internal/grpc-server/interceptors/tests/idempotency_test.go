@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/neracastle/auth/internal/grpc-server/interceptors"
+	"github.com/neracastle/auth/internal/repository/idempotency"
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+type memRepo struct {
+	records map[string]idempotency.Record
+}
+
+func (m *memRepo) Reserve(_ context.Context, key string, hash string, _ time.Duration) (*idempotency.Record, bool, error) {
+	if rec, ok := m.records[key]; ok {
+		return &rec, false, nil
+	}
+	m.records[key] = idempotency.Record{RequestHash: hash}
+
+	return nil, true, nil
+}
+
+func (m *memRepo) Complete(_ context.Context, key string, rec idempotency.Record, _ time.Duration) error {
+	m.records[key] = rec
+	return nil
+}
+
+func (m *memRepo) Release(_ context.Context, key string) error {
+	delete(m.records, key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	var (
+		repo  = &memRepo{records: map[string]idempotency.Record{}}
+		inter = interceptors.NewIdempotencyInterceptor(repo, time.Hour, []string{user_v1.UserV1_Create_FullMethodName})
+		info  = &grpc.UnaryServerInfo{FullMethod: user_v1.UserV1_Create_FullMethodName}
+		calls = 0
+		fail  = false
+	)
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		if fail {
+			return nil, errors.New("db is down")
+		}
+		return &user_v1.CreateResponse{Id: int64(calls)}, nil
+	}
+
+	withKey := func(key string) context.Context {
+		ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(interceptors.IdempotencyKeyHeader, key))
+	}
+
+	req := &user_v1.CreateRequest{Email: "a@b.c", Password: "12345678", PasswordConfirm: "12345678"}
+
+	t.Run("replay identical request", func(t *testing.T) {
+		first, err := inter(withKey("k1"), req, info, handler)
+		require.NoError(t, err)
+
+		second, err := inter(withKey("k1"), proto.Clone(req), info, handler)
+		require.NoError(t, err)
+		require.Equal(t, 1, calls)
+		require.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+	})
+
+	t.Run("same key different payload", func(t *testing.T) {
+		other := proto.Clone(req).(*user_v1.CreateRequest)
+		other.Email = "x@y.z"
+
+		_, err := inter(withKey("k1"), other, info, handler)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+		_, err := inter(ctx, req, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("not guarded method", func(t *testing.T) {
+		getInfo := &grpc.UnaryServerInfo{FullMethod: user_v1.UserV1_Get_FullMethodName}
+		_, err := inter(withKey("k1"), req, getInfo, handler)
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("error is not stored", func(t *testing.T) {
+		fail = true
+		_, err := inter(withKey("k2"), req, info, handler)
+		require.Error(t, err)
+
+		fail = false
+		res, err := inter(withKey("k2"), req, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), res.(*user_v1.CreateResponse).GetId())
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		var inProgressErr error
+		slow := func(ctx context.Context, r interface{}) (interface{}, error) {
+			//повтор приходит, пока первый запрос еще выполняется
+			_, inProgressErr = inter(withKey("k3"), req, info, handler)
+			return handler(ctx, r)
+		}
+
+		_, err := inter(withKey("k3"), req, info, slow)
+		require.NoError(t, err)
+		require.Equal(t, codes.Aborted, status.Code(inProgressErr))
+	})
+}
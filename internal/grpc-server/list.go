@@ -0,0 +1,17 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// ListUsers возвращает постраничный список пользователей
+func (s *Server) ListUsers(ctx context.Context, req *userdesc.ListUsersRequest) (*userdesc.ListUsersResponse, error) {
+	page, err := s.srv.ListUsers(ctx, FromGrpcToListUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return FromUsecaseToListResponse(page), nil
+}
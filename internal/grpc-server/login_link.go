@@ -0,0 +1,30 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// RequestLoginLink отправляет на почту одноразовую ссылку для входа
+func (s *Server) RequestLoginLink(ctx context.Context, req *userdesc.LoginLinkRequest) (*userdesc.LoginLinkResponse, error) {
+	err := s.srv.RequestLoginLink(ctx, req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.LoginLinkResponse{}, nil
+}
+
+// ConsumeLoginLink авторизация по одноразовой ссылке
+func (s *Server) ConsumeLoginLink(ctx context.Context, req *userdesc.ConsumeLoginLinkRequest) (*userdesc.ConsumeLoginLinkResponse, error) {
+	tokens, err := s.srv.ConsumeLoginLink(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.ConsumeLoginLinkResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
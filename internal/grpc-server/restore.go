@@ -0,0 +1,17 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// Restore восстанавливает удаленного клиента
+func (s *Server) Restore(ctx context.Context, req *userdesc.RestoreRequest) (*userdesc.RestoreResponse, error) {
+	err := s.srv.Restore(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.RestoreResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// SearchUsers нечеткий поиск пользователей
+func (s *Server) SearchUsers(ctx context.Context, req *userdesc.SearchUsersRequest) (*userdesc.SearchUsersResponse, error) {
+	hits, err := s.srv.SearchUsers(ctx, req.GetQuery(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &userdesc.SearchUsersResponse{Hits: make([]*userdesc.SearchHit, 0, len(hits))}
+	for _, hit := range hits {
+		rsp.Hits = append(rsp.Hits, &userdesc.SearchHit{
+			Id:        hit.ID,
+			Name:      hit.Name,
+			Email:     hit.Email,
+			Rank:      hit.Rank,
+			Highlight: hit.Highlight,
+		})
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,27 @@
+package grpc_server
+
+import (
+	"context"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// ResetPassword задает пользователю новый пароль
+func (s *Server) ResetPassword(ctx context.Context, req *userdesc.ResetPasswordRequest) (*userdesc.ResetPasswordResponse, error) {
+	err := s.srv.ResetPassword(ctx, req.GetId(), req.GetPassword(), req.GetPasswordConfirm())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.ResetPasswordResponse{}, nil
+}
+
+// RevokeSessions отзывает сессии пользователя
+func (s *Server) RevokeSessions(ctx context.Context, req *userdesc.RevokeSessionsRequest) (*userdesc.RevokeSessionsResponse, error) {
+	err := s.srv.RevokeSessions(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.RevokeSessionsResponse{}, nil
+}
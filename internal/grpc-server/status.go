@@ -0,0 +1,40 @@
+package grpc_server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userdesc "github.com/neracastle/auth/pkg/user_v1"
+)
+
+// BlockUser блокирует или приостанавливает пользователя
+func (s *Server) BlockUser(ctx context.Context, req *userdesc.BlockUserRequest) (*userdesc.BlockUserResponse, error) {
+	var until time.Time
+	if req.GetSuspendedUntil() != nil {
+		until = req.GetSuspendedUntil().AsTime()
+	}
+
+	dto, err := s.srv.BlockUser(ctx, req.GetId(), req.GetReason(), until)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &userdesc.BlockUserResponse{Status: FromUsecaseToStatus(dto.Status)}
+	if !dto.SuspendedUntil.IsZero() {
+		rsp.SuspendedUntil = timestamppb.New(dto.SuspendedUntil)
+	}
+
+	return rsp, nil
+}
+
+// UnblockUser снимает блокировку пользователя
+func (s *Server) UnblockUser(ctx context.Context, req *userdesc.UnblockUserRequest) (*userdesc.UnblockUserResponse, error) {
+	dto, err := s.srv.UnblockUser(ctx, req.GetId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdesc.UnblockUserResponse{Status: FromUsecaseToStatus(dto.Status)}, nil
+}
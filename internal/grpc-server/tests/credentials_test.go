@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	grpc_server "github.com/neracastle/auth/internal/grpc-server"
+	"github.com/neracastle/auth/internal/usecases/mocks"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+const bcryptHash = "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+
+func TestResponsesHaveNoPasswordFields(t *testing.T) {
+	msgs := user_v1.File_user_proto.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		msg := msgs.Get(i)
+		if !strings.HasSuffix(string(msg.Name()), "Response") {
+			continue
+		}
+
+		assertNoPasswordFields(t, msg)
+	}
+}
+
+// assertNoPasswordFields проверяет поля сообщения и вложенных сообщений
+func assertNoPasswordFields(t *testing.T, msg protoreflect.MessageDescriptor) {
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		require.NotContains(t, strings.ToLower(string(f.Name())), "password", "field %s", f.FullName())
+
+		if sub := f.Message(); sub != nil && sub.ParentFile() == msg.ParentFile() && sub != msg {
+			assertNoPasswordFields(t, sub)
+		}
+	}
+}
+
+func TestGetDoesNotLeakPasswordHash(t *testing.T) {
+	mc := minimock.NewController(t)
+	u := &domain.User{ID: 1, Email: "user@example.com", Name: "user", Password: bcryptHash}
+
+	mockedSrv := mocks.NewUserServiceMock(mc)
+	mockedSrv.GetMock.Return(models.FromDomainToUsecase(u), nil)
+
+	rsp, err := grpc_server.NewServer(mockedSrv).Get(context.Background(), &user_v1.GetRequest{Id: u.ID})
+	require.NoError(t, err)
+
+	raw, err := protojson.Marshal(rsp)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), bcryptHash)
+}
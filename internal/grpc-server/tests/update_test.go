@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	grpc_server "github.com/neracastle/auth/internal/grpc-server"
+	"github.com/neracastle/auth/internal/grpc-server/interceptors"
+	"github.com/neracastle/auth/internal/usecases"
+	"github.com/neracastle/auth/internal/usecases/mocks"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1"
+)
+
+func TestUpdateETag(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		etag        string
+		wantVersion int64
+		srvErr      error
+		code        codes.Code
+	}{
+		{
+			name:        "etag in request",
+			ctx:         context.Background(),
+			etag:        grpc_server.FormatETag(3),
+			wantVersion: 3,
+			code:        codes.OK,
+		},
+		{
+			name:        "etag in If-Match",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc_server.IfMatchHeader, `W/"5"`)),
+			wantVersion: 5,
+			code:        codes.OK,
+		},
+		{
+			name:        "without etag",
+			ctx:         context.Background(),
+			wantVersion: 0,
+			code:        codes.OK,
+		},
+		{
+			name:        "stale version",
+			ctx:         context.Background(),
+			etag:        grpc_server.FormatETag(2),
+			wantVersion: 2,
+			srvErr:      usecases.ErrVersionConflict,
+			code:        codes.Aborted,
+		},
+		{
+			name: "invalid etag",
+			ctx:  context.Background(),
+			etag: "abc",
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockedSrv := mocks.NewUserServiceMock(mc)
+			if tt.code != codes.InvalidArgument {
+				mockedSrv.UpdateMock.Set(func(_ context.Context, dto models.UpdateDTO) error {
+					require.Equal(t, tt.wantVersion, dto.Version)
+					return tt.srvErr
+				})
+			}
+
+			//ошибки сервиса превращаются в коды grpc перехватчиком, как в приложении
+			req := &user_v1.UpdateRequest{Id: 1, Name: wrapperspb.String("name"), Etag: tt.etag}
+			_, err := interceptors.ErrorCodesInterceptor(tt.ctx, req, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return grpc_server.NewServer(mockedSrv).Update(ctx, req.(*user_v1.UpdateRequest))
+			})
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestGetResponseETag(t *testing.T) {
+	rsp := grpc_server.FromUsecaseToGetResponse(models.UserDTO{ID: 1, Version: 7})
+	require.Equal(t, `"7"`, rsp.GetEtag())
+
+	version, err := grpc_server.ParseETag(rsp.GetEtag())
+	require.NoError(t, err)
+	require.Equal(t, int64(7), version)
+}
+
+func TestUpdateFieldMask(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *user_v1.UpdateRequest
+		want models.UpdateDTO
+		code codes.Code
+	}{
+		{
+			name: "only masked fields",
+			req: &user_v1.UpdateRequest{
+				Id:         1,
+				Name:       wrapperspb.String("new name"),
+				Email:      wrapperspb.String("new@mail.ru"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			want: models.UpdateDTO{ID: 1, Name: "new name", Fields: []string{models.UpdateFieldName}},
+		},
+		{
+			name: "clear name",
+			req: &user_v1.UpdateRequest{
+				Id:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			want: models.UpdateDTO{ID: 1, Fields: []string{models.UpdateFieldName}},
+		},
+		{
+			name: "without mask uses passed fields",
+			req: &user_v1.UpdateRequest{
+				Id:    1,
+				Email: wrapperspb.String("new@mail.ru"),
+				Role:  user_v1.Role_ADMIN,
+			},
+			want: models.UpdateDTO{
+				ID:      1,
+				Email:   "new@mail.ru",
+				IsAdmin: true,
+				Fields:  []string{models.UpdateFieldEmail, models.UpdateFieldRole},
+			},
+		},
+		{
+			name: "user role",
+			req: &user_v1.UpdateRequest{
+				Id:         1,
+				Role:       user_v1.Role_USER,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+			},
+			want: models.UpdateDTO{ID: 1, IsAdmin: false, Fields: []string{models.UpdateFieldRole}},
+		},
+		{
+			name: "unknown path",
+			req: &user_v1.UpdateRequest{
+				Id:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "role without value",
+			req: &user_v1.UpdateRequest{
+				Id:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "nothing to update",
+			req:  &user_v1.UpdateRequest{Id: 1},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dto, err := grpc_server.FromGrpcToUpdateUsecase(tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				require.Equal(t, tt.want, dto)
+			}
+		})
+	}
+}
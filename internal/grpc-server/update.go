@@ -8,8 +8,18 @@ import (
 
 // Update обновляет данные клиента
 func (s *Server) Update(ctx context.Context, req *userdesc.UpdateRequest) (*userdesc.UpdateResponse, error) {
-	dto := FromGrpcToUpdateUsecase(req)
-	err := s.srv.Update(ctx, dto)
+	version, err := ParseETag(etagFromRequest(ctx, req.GetEtag()))
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := FromGrpcToUpdateUsecase(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dto.Version = version
+	err = s.srv.Update(ctx, dto)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"context"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/mailer"
+)
+
+var _ mailer.Mailer = (*client)(nil)
+
+type client struct {
+	lg *slog.Logger
+}
+
+// New отправщик, который не отправляет письма, а только пишет их в лог. Для локальной разработки
+func New(lg *slog.Logger) mailer.Mailer {
+	return &client{lg: lg}
+}
+
+func (c *client) Send(_ context.Context, msg mailer.Message) error {
+	c.lg.Info("mail sent",
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body))
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package mailer
+
+import "context"
+
+// Message письмо для отправки
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer отправщик писем
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
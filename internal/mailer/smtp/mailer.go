@@ -0,0 +1,45 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/neracastle/auth/internal/mailer"
+)
+
+var _ mailer.Mailer = (*client)(nil)
+
+type client struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// New новый экземпляр отправщика писем через smtp-сервер
+func New(host string, port string, user string, password string, from string) mailer.Mailer {
+	cl := &client{
+		addr: net.JoinHostPort(host, port),
+		from: from,
+	}
+
+	if user != "" {
+		cl.auth = smtp.PlainAuth("", user, password, host)
+	}
+
+	return cl
+}
+
+func (c *client) Send(_ context.Context, msg mailer.Message) error {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("From: %s\r\n", c.from))
+	sb.WriteString(fmt.Sprintf("To: %s\r\n", msg.To))
+	sb.WriteString(fmt.Sprintf("Subject: %s\r\n", msg.Subject))
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+	sb.WriteString(msg.Body)
+
+	return smtp.SendMail(c.addr, c.auth, c.from, []string{msg.To}, []byte(sb.String()))
+}
@@ -0,0 +1,208 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// versionTable таблица версий goose, чтобы миграции, уже накатанные образом с goose, не применялись повторно
+const versionTable = "goose_db_version"
+
+// lockID ключ advisory lock, под которым накатываются миграции. Реплики, стартующие одновременно, ждут друг друга
+const lockID int64 = 0x61757468 // "auth"
+
+// ErrNothingToRollback нет примененных миграций
+var ErrNothingToRollback = errors.New("no applied migrations to roll back")
+
+// Status состояние миграции в бд
+type Status struct {
+	Migration
+	// AppliedAt время применения, нулевое если миграция еще не применена
+	AppliedAt time.Time
+}
+
+// Migrator применяет миграции через отдельное соединение к postgres
+type Migrator struct {
+	conn       *pgx.Conn
+	migrations []Migration
+}
+
+// New мигратор по файлам миграций из fsys
+func New(conn *pgx.Conn, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{conn: conn, migrations: migrations}, nil
+}
+
+// Up применяет все еще не примененные миграции по возрастанию версии и возвращает их
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		versions, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := versions[mig.Version]; ok {
+				continue
+			}
+
+			err = m.apply(ctx, mig, mig.Up,
+				"INSERT INTO "+versionTable+" (version_id, is_applied) VALUES ($1, true)")
+			if err != nil {
+				return fmt.Errorf("migration %s up: %w", mig.Name, err)
+			}
+			applied = append(applied, mig)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down откатывает последнюю примененную миграцию
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var rolledBack Migration
+
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		var version int64
+		err := m.conn.QueryRow(ctx, "SELECT coalesce(max(version_id), 0) FROM "+versionTable).Scan(&version)
+		if err != nil {
+			return err
+		}
+		if version == 0 {
+			return ErrNothingToRollback
+		}
+
+		for _, mig := range m.migrations {
+			if mig.Version != version {
+				continue
+			}
+
+			err = m.apply(ctx, mig, mig.Down, "DELETE FROM "+versionTable+" WHERE version_id = $1")
+			if err != nil {
+				return fmt.Errorf("migration %s down: %w", mig.Name, err)
+			}
+			rolledBack = mig
+
+			return nil
+		}
+
+		return fmt.Errorf("applied migration %d not found in migration files", version)
+	})
+
+	return rolledBack, err
+}
+
+// Status все известные миграции с отметкой о применении
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var exists bool
+	err := m.conn.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", versionTable).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[int64]time.Time{}
+	if exists {
+		applied, err = m.appliedVersions(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		statuses = append(statuses, Status{Migration: mig, AppliedAt: applied[mig.Version]})
+	}
+
+	return statuses, nil
+}
+
+// withLock выполняет f под advisory lock и с созданной таблицей версий
+func (m *Migrator) withLock(ctx context.Context, f func(ctx context.Context) error) error {
+	_, err := m.conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID)
+	if err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		//соединение могли закрыть вместе с ctx, поэтому отпускаем блокировку в любом случае
+		_, _ = m.conn.Exec(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockID)
+	}()
+
+	_, err = m.conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+versionTable+` (
+		id integer PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+		version_id bigint NOT NULL,
+		is_applied boolean NOT NULL,
+		tstamp timestamp NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("create version table: %w", err)
+	}
+
+	//нулевая версия как у goose
+	_, err = m.conn.Exec(ctx, "INSERT INTO "+versionTable+" (version_id, is_applied) "+
+		"SELECT 0, true WHERE NOT EXISTS (SELECT 1 FROM "+versionTable+")")
+	if err != nil {
+		return fmt.Errorf("init version table: %w", err)
+	}
+
+	return f(ctx)
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := m.conn.Query(ctx, "SELECT version_id, tstamp FROM "+versionTable+" WHERE version_id > 0 AND is_applied")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			version int64
+			ts      time.Time
+		)
+		if err = rows.Scan(&version, &ts); err != nil {
+			return nil, err
+		}
+		versions[version] = ts
+	}
+
+	return versions, rows.Err()
+}
+
+// apply выполняет запросы миграции и отмечает это в таблице версий
+func (m *Migrator) apply(ctx context.Context, mig Migration, statements []string, mark string) error {
+	if mig.NoTx {
+		for _, stmt := range statements {
+			if _, err := m.conn.Exec(ctx, stmt); err != nil {
+				return err
+			}
+		}
+
+		_, err := m.conn.Exec(ctx, mark, mig.Version)
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, m.conn, func(tx pgx.Tx) error {
+		for _, stmt := range statements {
+			if _, err := tx.Exec(ctx, stmt); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Exec(ctx, mark, mig.Version)
+		return err
+	})
+}
@@ -0,0 +1,164 @@
+package migrator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// аннотации goose, которыми размечены файлы миграций
+const (
+	annotationUp             = "-- +goose Up"
+	annotationDown           = "-- +goose Down"
+	annotationStatementBegin = "-- +goose StatementBegin"
+	annotationStatementEnd   = "-- +goose StatementEnd"
+	annotationNoTransaction  = "-- +goose NO TRANSACTION"
+)
+
+// ErrNoUpSection в файле миграции нет секции Up
+var ErrNoUpSection = errors.New("migration has no Up section")
+
+// Migration миграция из файла в формате goose: <версия>_<название>.sql
+type Migration struct {
+	Version int64
+	Name    string
+	Up      []string
+	Down    []string
+	// NoTx миграция выполняется вне транзакции, например для CREATE INDEX CONCURRENTLY
+	NoTx bool
+}
+
+// Load читает все *.sql из корня fsys и возвращает миграции по возрастанию версии
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(files))
+	versions := make(map[int64]string, len(files))
+	for _, file := range files {
+		m, err := parseFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		if prev, ok := versions[m.Version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", m.Version, prev, file)
+		}
+		versions[m.Version] = file
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func parseFile(fsys fs.FS, file string) (Migration, error) {
+	name := strings.TrimSuffix(path.Base(file), ".sql")
+	prefix, _, ok := strings.Cut(name, "_")
+	if !ok {
+		return Migration{}, fmt.Errorf("file name must be <version>_<name>.sql")
+	}
+
+	version, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil || version <= 0 {
+		return Migration{}, fmt.Errorf("invalid migration version %q", prefix)
+	}
+
+	f, err := fsys.Open(file)
+	if err != nil {
+		return Migration{}, err
+	}
+	defer f.Close()
+
+	m := Migration{Version: version, Name: name}
+	var (
+		section *[]string
+		inBlock bool
+		buf     strings.Builder
+		hasUp   bool
+	)
+
+	flush := func() {
+		stmt := strings.TrimSpace(buf.String())
+		buf.Reset()
+		if section != nil && !onlyComments(stmt) {
+			*section = append(*section, stmt)
+		}
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, annotationUp):
+			flush()
+			section, hasUp = &m.Up, true
+			continue
+		case strings.HasPrefix(trimmed, annotationDown):
+			flush()
+			section = &m.Down
+			continue
+		case strings.HasPrefix(trimmed, annotationNoTransaction):
+			m.NoTx = true
+			continue
+		case strings.HasPrefix(trimmed, annotationStatementBegin):
+			flush()
+			inBlock = true
+			continue
+		case strings.HasPrefix(trimmed, annotationStatementEnd):
+			flush()
+			inBlock = false
+			continue
+		}
+
+		if section == nil {
+			continue
+		}
+
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		//вне блока StatementBegin/End запрос заканчивается на ;
+		if !inBlock && strings.HasSuffix(trimmed, ";") && !strings.HasPrefix(trimmed, "--") {
+			flush()
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return Migration{}, err
+	}
+	if inBlock {
+		return Migration{}, fmt.Errorf("StatementBegin without StatementEnd")
+	}
+	flush()
+
+	if !hasUp {
+		return Migration{}, ErrNoUpSection
+	}
+
+	return m, nil
+}
+
+// onlyComments в тексте нет ничего, кроме пустых строк и комментариев
+func onlyComments(sql string) bool {
+	for _, line := range strings.Split(sql, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+
+	return true
+}
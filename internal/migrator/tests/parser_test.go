@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/migrator"
+	"github.com/neracastle/auth/migrations"
+)
+
+func TestLoadEmbedded(t *testing.T) {
+	list, err := migrator.Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+
+	for i, m := range list {
+		if i > 0 {
+			require.Greater(t, m.Version, list[i-1].Version)
+		}
+		require.NotEmpty(t, m.Up, m.Name)
+		//каждую миграцию должно быть можно откатить
+		require.NotEmpty(t, m.Down, m.Name)
+	}
+}
+
+func TestLoadSplitsStatements(t *testing.T) {
+	fsys := fstest.MapFS{
+		"2_second.sql": {Data: []byte(`-- +goose NO TRANSACTION
+-- +goose Up
+CREATE INDEX CONCURRENTLY a_idx ON t(a);
+-- +goose Down
+DROP INDEX CONCURRENTLY a_idx;
+`)},
+		"1_first.sql": {Data: []byte(`-- +goose Up
+-- комментарий не является запросом
+CREATE TABLE t (a int);
+INSERT INTO t
+VALUES (1);
+
+-- +goose StatementBegin
+CREATE FUNCTION f() RETURNS int AS $$
+BEGIN
+    RETURN 1;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP FUNCTION f;
+DROP TABLE t;
+`)},
+	}
+
+	list, err := migrator.Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	first := list[0]
+	require.Equal(t, int64(1), first.Version)
+	require.Equal(t, "1_first", first.Name)
+	require.False(t, first.NoTx)
+	require.Len(t, first.Up, 3)
+	require.Contains(t, first.Up[0], "CREATE TABLE t")
+	require.Contains(t, first.Up[1], "VALUES (1);")
+	require.Contains(t, first.Up[2], "RETURN 1;")
+	require.Contains(t, first.Up[2], "LANGUAGE plpgsql;")
+	require.Equal(t, []string{"DROP FUNCTION f;", "DROP TABLE t;"}, first.Down)
+
+	second := list[1]
+	require.Equal(t, int64(2), second.Version)
+	require.True(t, second.NoTx)
+	require.Len(t, second.Up, 1)
+}
+
+func TestLoadRejectsInvalidFiles(t *testing.T) {
+	cases := map[string]fstest.MapFS{
+		"duplicate version": {
+			"1_a.sql": {Data: []byte("-- +goose Up\nSELECT 1;\n")},
+			"1_b.sql": {Data: []byte("-- +goose Up\nSELECT 1;\n")},
+		},
+		"no version":    {"init.sql": {Data: []byte("-- +goose Up\nSELECT 1;\n")}},
+		"no up section": {"1_a.sql": {Data: []byte("SELECT 1;\n")}},
+		"unclosed block": {
+			"1_a.sql": {Data: []byte("-- +goose Up\n-- +goose StatementBegin\nSELECT 1;\n")},
+		},
+	}
+
+	for name, fsys := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := migrator.Load(fsys)
+			require.Error(t, err)
+		})
+	}
+}
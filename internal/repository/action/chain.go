@@ -0,0 +1,88 @@
+package action
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+)
+
+// chainContent поля записи, которые защищает цепочка. Порядок и формат менять нельзя:
+// иначе хэши уже записанных действий перестанут сходиться
+type chainContent struct {
+	ID        int64             `json:"id"`
+	UserID    int64             `json:"user_id"`
+	ActorID   int64             `json:"actor_id"`
+	Name      string            `json:"name"`
+	OldValue  string            `json:"old_value"`
+	NewValue  string            `json:"new_value"`
+	RequestID string            `json:"request_id"`
+	TraceID   string            `json:"trace_id"`
+	IP        string            `json:"ip"`
+	Details   map[string]string `json:"details"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// ContentHash sha256 содержимого записи. Время учитывается с точностью до секунды, как оно хранится в бд
+func ContentHash(a model.ActionDTO) []byte {
+	details := a.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	//ключи map json кодирует в отсортированном порядке, так что представление однозначно
+	raw, _ := json.Marshal(chainContent{
+		ID:        a.ID,
+		UserID:    a.UserID,
+		ActorID:   a.ActorID,
+		Name:      a.Name,
+		OldValue:  a.OldValue,
+		NewValue:  a.NewValue,
+		RequestID: a.RequestID,
+		TraceID:   a.TraceID,
+		IP:        a.IP,
+		Details:   details,
+		CreatedAt: a.CreatedAt.Unix(),
+	})
+	sum := sha256.Sum256(raw)
+
+	return sum[:]
+}
+
+// ChainHash звено цепочки: sha256 от хэша предыдущей записи и хэшей содержимого текущей
+func ChainHash(prevHash []byte, contentHashes ...[]byte) []byte {
+	h := sha256.New()
+	h.Write(prevHash)
+	for _, contentHash := range contentHashes {
+		h.Write(contentHash)
+	}
+
+	return h.Sum(nil)
+}
+
+// Redacted запись в том виде, в каком ее оставляет стирание пользователя userID:
+// ip убирается всегда, значения и детали - если действие совершено над ним
+func Redacted(a model.ActionDTO, userID int64) model.ActionDTO {
+	if a.UserID == userID {
+		a.OldValue, a.NewValue, a.Details = "", "", map[string]string{}
+	}
+	a.IP = ""
+
+	return a
+}
+
+// Seal заполняет время и хэши записи, которая продолжает цепочку после prevHash
+func Seal(a *model.ActionDTO, prevHash []byte) {
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+	a.CreatedAt = a.CreatedAt.UTC().Truncate(time.Second)
+
+	a.PrevHash = prevHash
+	a.ContentHash = ContentHash(*a)
+	//хэши обезличенного вида не содержат персональных данных и позволяют проверить запись после стирания
+	a.UserRedactedHash = ContentHash(Redacted(*a, a.UserID))
+	a.ActorRedactedHash = ContentHash(Redacted(*a, a.ActorID))
+	a.Hash = ChainHash(prevHash, a.ContentHash, a.UserRedactedHash, a.ActorRedactedHash)
+}
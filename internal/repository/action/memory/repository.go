@@ -0,0 +1,160 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+	"github.com/neracastle/auth/internal/repository/memory"
+)
+
+var _ action.Repository = (*repo)(nil)
+
+type repo struct {
+	mu          sync.Mutex
+	actions     []model.ActionDTO
+	checkpoints []model.CheckpointDTO
+	lastID      int64
+}
+
+// New журнал действий в памяти процесса
+func New() action.Repository {
+	return &repo{}
+}
+
+func (r *repo) Save(ctx context.Context, dto model.ActionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	dto.ID = r.lastID
+	dto.Details = maps.Clone(dto.Details)
+	action.Seal(&dto, r.lastHash(len(r.actions)))
+
+	r.actions = append(r.actions, dto)
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		//запись вне транзакции могла встать после отменяемой: перецепляем хвост
+		i := slices.IndexFunc(r.actions, func(a model.ActionDTO) bool { return a.ID == dto.ID })
+		if i < 0 {
+			return
+		}
+		r.actions = slices.Delete(r.actions, i, i+1)
+		for ; i < len(r.actions); i++ {
+			action.Seal(&r.actions[i], r.lastHash(i))
+		}
+	})
+
+	return nil
+}
+
+// lastHash хэш записи перед позицией i
+func (r *repo) lastHash(i int) []byte {
+	if i == 0 {
+		return nil
+	}
+
+	return r.actions[i-1].Hash
+}
+
+func (r *repo) List(_ context.Context, filter action.Filter) ([]model.ActionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var found []model.ActionDTO
+	for i := range r.actions {
+		//записи хранятся по возрастанию id, для Desc идем с конца
+		a := r.actions[i]
+		if filter.Desc {
+			a = r.actions[len(r.actions)-1-i]
+		}
+
+		if filter.Limit > 0 && uint64(len(found)) == filter.Limit {
+			break
+		}
+		if matches(a, filter) {
+			a.Details = maps.Clone(a.Details)
+			found = append(found, a)
+		}
+	}
+
+	return found, nil
+}
+
+func matches(a model.ActionDTO, filter action.Filter) bool {
+	switch {
+	case filter.UserID > 0 && a.UserID != filter.UserID:
+		return false
+	case filter.ActorID > 0 && a.ActorID != filter.ActorID:
+		return false
+	case len(filter.Names) > 0 && !slices.Contains(filter.Names, a.Name):
+		return false
+	case a.CreatedAt.Before(filter.Since):
+		return false
+	case !filter.Until.IsZero() && !a.CreatedAt.Before(filter.Until):
+		return false
+	case filter.AfterID > 0 && a.ID <= filter.AfterID:
+		return false
+	case filter.BeforeID > 0 && a.ID >= filter.BeforeID:
+		return false
+	}
+
+	return true
+}
+
+func (r *repo) Redact(ctx context.Context, userID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	prev := make(map[int64]model.ActionDTO)
+	for i := range r.actions {
+		a := &r.actions[i]
+		//обезличенная по инициатору запись обезличивается еще раз, если стирается тот, над кем действие
+		if a.UserID != userID && (a.ActorID != userID || a.RedactedAt != nil) {
+			continue
+		}
+
+		prev[a.ID] = *a
+		*a = action.Redacted(*a, userID)
+		if a.RedactedAt == nil {
+			a.RedactedAt = &now
+		}
+	}
+
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for i := range r.actions {
+			if a, ok := prev[r.actions[i].ID]; ok {
+				r.actions[i] = a
+			}
+		}
+	})
+
+	return int64(len(prev)), nil
+}
+
+func (r *repo) SaveCheckpoint(_ context.Context, dto model.CheckpointDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = int64(len(r.checkpoints) + 1)
+	dto.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	r.checkpoints = append(r.checkpoints, dto)
+
+	return nil
+}
+
+func (r *repo) Checkpoints(context.Context) ([]model.CheckpointDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.checkpoints), nil
+}
@@ -6,9 +6,36 @@ import (
 
 // ActionDTO модель события действий пользователя
 type ActionDTO struct {
-	UserID    int64     `db:"user_id"`
-	Name      string    `db:"name"`
-	OldValue  string    `db:"old_value"`
-	NewValue  string    `db:"new_value"`
+	ID int64 `db:"id"`
+	// UserID над кем совершено действие, 0 - не над конкретным пользователем
+	UserID int64 `db:"user_id"`
+	// ActorID кто совершил действие, 0 - анонимный запрос или сам сервис
+	ActorID   int64             `db:"actor_id"`
+	Name      string            `db:"name"`
+	OldValue  string            `db:"old_value"`
+	NewValue  string            `db:"new_value"`
+	RequestID string            `db:"request_id"`
+	TraceID   string            `db:"trace_id"`
+	IP        string            `db:"ip"`
+	Details   map[string]string `db:"details"`
+	CreatedAt time.Time         `db:"created_at"`
+	// PrevHash, ContentHash, Hash звено цепочки журнала, у записей до ее введения пустые
+	PrevHash    []byte `db:"prev_hash"`
+	ContentHash []byte `db:"content_hash"`
+	Hash        []byte `db:"hash"`
+	// UserRedactedHash, ActorRedactedHash хэши содержимого после стирания пользователя или инициатора,
+	// входят в звено цепочки. У записей до их введения пустые
+	UserRedactedHash  []byte `db:"user_redacted_hash"`
+	ActorRedactedHash []byte `db:"actor_redacted_hash"`
+	// RedactedAt когда персональные данные записи стерты, nil - запись не обезличивалась
+	RedactedAt *time.Time `db:"redacted_at"`
+}
+
+// CheckpointDTO контрольная точка цепочки журнала, подписанная ключом сервиса
+type CheckpointDTO struct {
+	ID        int64     `db:"id"`
+	ActionID  int64     `db:"action_id"`
+	Hash      []byte    `db:"hash"`
+	Signature []byte    `db:"signature"`
 	CreatedAt time.Time `db:"created_at"`
 }
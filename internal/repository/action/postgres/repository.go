@@ -2,8 +2,12 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/db/pg"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"golang.org/x/exp/slog"
 
@@ -13,6 +17,9 @@ import (
 
 var _ action.Repository = (*repo)(nil)
 
+// chainLockID ключ advisory lock, под которым дописывается цепочка журнала
+const chainLockID = 0x61756474
+
 type repo struct {
 	conn db.Client
 }
@@ -25,16 +32,74 @@ func New(conn db.Client) action.Repository {
 }
 
 func (r *repo) Save(ctx context.Context, dto model.ActionDTO) error {
+	//звенья цепочки дописываются строго по одному, поэтому запись всегда идет в транзакции.
+	//Вложенная ReadCommitted закоммитила бы транзакцию вызывающего, поэтому открываем свою, только если ее нет
+	if _, inTx := ctx.Value(pg.TxCtxKey).(pgx.Tx); inTx {
+		return r.save(ctx, dto)
+	}
+
+	return r.conn.DB().ReadCommitted(ctx, func(ctx context.Context) error {
+		return r.save(ctx, dto)
+	})
+}
+
+func (r *repo) save(ctx context.Context, dto model.ActionDTO) error {
 	log := logger.GetLogger(ctx)
 	log = log.With(slog.String("method", "repository.postgres.Save"))
 
-	q := db.Query{Name: "Save", QueryRaw: "INSERT INTO auth.user_actions(user_id, name, old_value, new_value) VALUES ($1, $2, $3, $4)"}
+	if dto.Details == nil {
+		dto.Details = map[string]string{}
+	}
+
+	//блокировка до конца транзакции: следующая запись увидит хэш этой уже закоммиченным
+	q := db.Query{Name: "LockChain", QueryRaw: "SELECT pg_advisory_xact_lock($1)"}
+	_, err := r.conn.DB().Exec(ctx, q, chainLockID)
+	if err != nil {
+		log.Error("failed to lock audit chain", slog.String("error", err.Error()))
+		return err
+	}
+
+	var prevHash []byte
+	q = db.Query{Name: "LastHash", QueryRaw: "SELECT hash FROM auth.user_actions WHERE hash IS NOT NULL ORDER BY id DESC LIMIT 1"}
+	err = r.conn.DB().QueryRow(ctx, q).Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Error("failed to get last audit hash", slog.String("error", err.Error()))
+		return err
+	}
+
+	//id входит в хэш, поэтому берем его до вставки
+	q = db.Query{Name: "NextID", QueryRaw: "SELECT nextval(pg_get_serial_sequence('auth.user_actions', 'id'))"}
+	err = r.conn.DB().QueryRow(ctx, q).Scan(&dto.ID)
+	if err != nil {
+		log.Error("failed to get next action id", slog.String("error", err.Error()))
+		return err
+	}
 
-	_, err := r.conn.DB().Exec(ctx, q,
+	action.Seal(&dto, prevHash)
+
+	//0 в user_id и actor_id - действие не над пользователем или без инициатора
+	q = db.Query{Name: "Save", QueryRaw: `INSERT INTO auth.user_actions
+		(id, user_id, actor_id, name, old_value, new_value, request_id, trace_id, ip, details, created_at,
+		 prev_hash, content_hash, hash, user_redacted_hash, actor_redacted_hash)
+		VALUES ($1, NULLIF($2, 0), NULLIF($3, 0), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`}
+
+	_, err = r.conn.DB().Exec(ctx, q,
+		dto.ID,
 		dto.UserID,
+		dto.ActorID,
 		dto.Name,
 		dto.OldValue,
-		dto.NewValue)
+		dto.NewValue,
+		dto.RequestID,
+		dto.TraceID,
+		dto.IP,
+		dto.Details,
+		dto.CreatedAt,
+		dto.PrevHash,
+		dto.ContentHash,
+		dto.Hash,
+		dto.UserRedactedHash,
+		dto.ActorRedactedHash)
 	if err != nil {
 		log.Error("failed to save user action in db", slog.String("error", err.Error()))
 		return err
@@ -42,3 +107,119 @@ func (r *repo) Save(ctx context.Context, dto model.ActionDTO) error {
 
 	return nil
 }
+
+func (r *repo) List(ctx context.Context, filter action.Filter) ([]model.ActionDTO, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "repository.postgres.List"))
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "coalesce(user_id, 0) AS user_id", "coalesce(actor_id, 0) AS actor_id", "name", "old_value",
+			"coalesce(new_value, '') AS new_value", "request_id", "trace_id", "ip", "details", "created_at",
+			"prev_hash", "content_hash", "hash", "user_redacted_hash", "actor_redacted_hash", "redacted_at").
+		From("auth.user_actions")
+
+	if filter.UserID > 0 {
+		builder = builder.Where(sq.Eq{"user_id": filter.UserID})
+	}
+	if filter.ActorID > 0 {
+		builder = builder.Where(sq.Eq{"actor_id": filter.ActorID})
+	}
+	if len(filter.Names) > 0 {
+		builder = builder.Where(sq.Eq{"name": filter.Names})
+	}
+	if !filter.Since.IsZero() {
+		builder = builder.Where(sq.GtOrEq{"created_at": filter.Since.UTC()})
+	}
+	if !filter.Until.IsZero() {
+		builder = builder.Where(sq.Lt{"created_at": filter.Until.UTC()})
+	}
+	if filter.AfterID > 0 {
+		builder = builder.Where(sq.Gt{"id": filter.AfterID})
+	}
+	if filter.BeforeID > 0 {
+		builder = builder.Where(sq.Lt{"id": filter.BeforeID})
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+
+	if filter.Desc {
+		builder = builder.OrderBy("id DESC")
+	} else {
+		builder = builder.OrderBy("id")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Error("failed to build list query", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	q := db.Query{Name: "List", QueryRaw: query}
+	res, err := r.conn.DB().Query(ctx, q, args...)
+	if err != nil {
+		log.Error("failed to list user actions", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	actions, err := pgx.CollectRows(res, pgx.RowToStructByName[model.ActionDTO])
+	if err != nil {
+		log.Error("failed to scan user actions", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func (r *repo) Redact(ctx context.Context, userID int64) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "repository.postgres.Redact"))
+
+	//хэши не пересчитываются: новое содержимое сверяется с user_redacted_hash или actor_redacted_hash,
+	//а само стирание подтверждает запись Erase, дописанная в цепочку следом.
+	//Записи, уже обезличенные по инициатору, обезличиваются еще раз, если стирается тот, над кем действие
+	q := db.Query{Name: "Redact", QueryRaw: `UPDATE auth.user_actions SET
+			old_value = CASE WHEN user_id = $1 THEN '' ELSE old_value END,
+			new_value = CASE WHEN user_id = $1 THEN '' ELSE new_value END,
+			details = CASE WHEN user_id = $1 THEN '{}'::jsonb ELSE details END,
+			ip = '',
+			redacted_at = coalesce(redacted_at, now())
+		WHERE user_id = $1 OR (actor_id = $1 AND redacted_at IS NULL)`}
+	qr, err := r.conn.DB().Exec(ctx, q, userID)
+	if err != nil {
+		log.Error("failed to redact user actions", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return qr.RowsAffected(), nil
+}
+
+func (r *repo) SaveCheckpoint(ctx context.Context, dto model.CheckpointDTO) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", "repository.postgres.SaveCheckpoint"))
+
+	q := db.Query{Name: "SaveCheckpoint", QueryRaw: "INSERT INTO auth.audit_checkpoints(action_id, hash, signature) VALUES ($1, $2, $3)"}
+	_, err := r.conn.DB().Exec(ctx, q, dto.ActionID, dto.Hash, dto.Signature)
+	if err != nil {
+		log.Error("failed to save audit checkpoint", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) Checkpoints(ctx context.Context) ([]model.CheckpointDTO, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "repository.postgres.Checkpoints"))
+
+	q := db.Query{Name: "Checkpoints", QueryRaw: "SELECT id, action_id, hash, signature, created_at FROM auth.audit_checkpoints ORDER BY action_id, id"}
+	res, err := r.conn.DB().Query(ctx, q)
+	if err != nil {
+		log.Error("failed to list audit checkpoints", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	checkpoints, err := pgx.CollectRows(res, pgx.RowToStructByName[model.CheckpointDTO])
+	if err != nil {
+		log.Error("failed to scan audit checkpoints", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
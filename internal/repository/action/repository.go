@@ -2,11 +2,42 @@ package action
 
 import (
 	"context"
+	"time"
 
 	"github.com/neracastle/auth/internal/repository/action/postgres/model"
 )
 
+// Filter отбор действий при чтении журнала
+type Filter struct {
+	// UserID если задан, действия только над этим пользователем
+	UserID int64
+	// ActorID если задан, действия только этого инициатора
+	ActorID int64
+	// Names если заданы, только действия с этими названиями
+	Names []string
+	// Since, Until диапазон времени действия [since, until)
+	Since time.Time
+	Until time.Time
+	// AfterID, BeforeID курсоры: только действия с id больше или меньше заданного
+	AfterID  int64
+	BeforeID int64
+	// Limit сколько действий вернуть
+	Limit uint64
+	// Desc сначала последние действия
+	Desc bool
+}
+
 // Repository хранилище действий клиента
 type Repository interface {
+	// Save дописывает действие в конец цепочки журнала
 	Save(context.Context, model.ActionDTO) error
+	// List действия по фильтру в порядке id
+	List(context.Context, Filter) ([]model.ActionDTO, error)
+	// Redact обезличивает записи о пользователе: у действий над ним стираются значения, подробности и ip,
+	// у действий, которые он совершил, - ip. Возвращает кол-во обезличенных записей
+	Redact(ctx context.Context, userID int64) (int64, error)
+	// SaveCheckpoint сохраняет подписанную контрольную точку цепочки
+	SaveCheckpoint(context.Context, model.CheckpointDTO) error
+	// Checkpoints контрольные точки в порядке id записей, на которые они указывают
+	Checkpoints(context.Context) ([]model.CheckpointDTO, error)
 }
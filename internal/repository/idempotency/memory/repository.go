@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/idempotency"
+)
+
+var _ idempotency.Repository = (*repo)(nil)
+
+type entry struct {
+	rec       idempotency.Record
+	expiresAt time.Time
+}
+
+type repo struct {
+	mu   sync.Mutex
+	keys map[string]entry
+}
+
+// New хранилище ключей идемпотентности в памяти процесса
+func New() idempotency.Repository {
+	return &repo{keys: make(map[string]entry)}
+}
+
+func (r *repo) Reserve(_ context.Context, key string, hash string, ttl time.Duration) (*idempotency.Record, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.keys[key]; ok && time.Now().Before(e.expiresAt) {
+		rec := e.rec
+		return &rec, false, nil
+	}
+
+	r.keys[key] = entry{rec: idempotency.Record{RequestHash: hash}, expiresAt: time.Now().Add(ttl)}
+
+	return nil, true, nil
+}
+
+func (r *repo) Complete(_ context.Context, key string, rec idempotency.Record, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[key] = entry{rec: rec, expiresAt: time.Now().Add(ttl)}
+
+	return nil
+}
+
+func (r *repo) Release(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.keys, key)
+
+	return nil
+}
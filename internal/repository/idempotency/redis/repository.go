@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/neracastle/auth/internal/repository/idempotency"
+)
+
+const keyPrefix = "idempotency:"
+
+var _ idempotency.Repository = (*repo)(nil)
+
+type repo struct {
+	pool *redigo.Pool
+}
+
+// New новый экземпляр. Нужен пул, т.к. в redis.Client нет SET NX
+func New(pool *redigo.Pool) idempotency.Repository {
+	return &repo{pool: pool}
+}
+
+func (r *repo) Reserve(ctx context.Context, key string, hash string, ttl time.Duration) (*idempotency.Record, bool, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer conn.Close()
+
+	value, err := json.Marshal(idempotency.Record{RequestHash: hash})
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, err = redigo.String(conn.Do("SET", keyPrefix+key, value, "NX", "PX", ttl.Milliseconds()))
+	if err == nil {
+		return nil, true, nil
+	}
+
+	if !errors.Is(err, redigo.ErrNil) {
+		return nil, false, err
+	}
+
+	//ключ уже занят, возвращаем сохраненную запись
+	raw, err := redigo.Bytes(conn.Do("GET", keyPrefix+key))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			//успел истечь между SET и GET
+			return r.Reserve(ctx, key, hash, ttl)
+		}
+		return nil, false, err
+	}
+
+	var rec idempotency.Record
+	err = json.Unmarshal(raw, &rec)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &rec, false, nil
+}
+
+func (r *repo) Complete(ctx context.Context, key string, rec idempotency.Record, ttl time.Duration) error {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("SET", keyPrefix+key, value, "PX", ttl.Milliseconds())
+
+	return err
+}
+
+func (r *repo) Release(ctx context.Context, key string) error {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("DEL", keyPrefix+key)
+
+	return err
+}
@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Record сохраненный результат запроса с ключом идемпотентности
+type Record struct {
+	// RequestHash хэш тела запроса, повтор с другим телом отклоняется
+	RequestHash string `json:"hash"`
+	// Response сериализованный ответ, пустой пока запрос выполняется
+	Response []byte `json:"response,omitempty"`
+	// Done запрос выполнен, Response можно отдавать повторно
+	Done bool `json:"done"`
+}
+
+// Repository хранилище ключей идемпотентности
+type Repository interface {
+	// Reserve атомарно занимает ключ. Если ключ уже занят, возвращает существующую запись и false
+	Reserve(ctx context.Context, key string, hash string, ttl time.Duration) (*Record, bool, error)
+	// Complete сохраняет ответ выполненного запроса
+	Complete(ctx context.Context, key string, rec Record, ttl time.Duration) error
+	// Release освобождает ключ, например если запрос завершился ошибкой и его можно повторить
+	Release(ctx context.Context, key string) error
+}
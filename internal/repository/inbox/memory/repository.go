@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/inbox"
+	"github.com/neracastle/auth/internal/repository/memory"
+)
+
+var _ inbox.Repository = (*repo)(nil)
+
+type key struct {
+	consumer  string
+	messageID string
+}
+
+type repo struct {
+	mu        sync.Mutex
+	processed map[key]time.Time
+}
+
+// New журнал обработанных сообщений в памяти процесса
+func New() inbox.Repository {
+	return &repo{processed: make(map[key]time.Time)}
+}
+
+func (r *repo) Save(ctx context.Context, consumer string, messageID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := key{consumer: consumer, messageID: messageID}
+	if _, ok := r.processed[k]; ok {
+		return false, nil
+	}
+
+	r.processed[k] = time.Now()
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.processed, k)
+	})
+
+	return true, nil
+}
+
+func (r *repo) Purge(_ context.Context, retention time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	border := time.Now().Add(-retention)
+	var cnt int64
+	for k, at := range r.processed {
+		if at.Before(border) {
+			delete(r.processed, k)
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/inbox"
+)
+
+const (
+	saveMethod  = "repository.inbox.postgres.Save"
+	purgeMethod = "repository.inbox.postgres.Purge"
+)
+
+var _ inbox.Repository = (*repo)(nil)
+
+type repo struct {
+	conn db.Client
+}
+
+// New новый экземпляр репозитория pg
+func New(conn db.Client) inbox.Repository {
+	return &repo{conn: conn}
+}
+
+func (r *repo) Save(ctx context.Context, consumer string, messageID string) (bool, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", saveMethod))
+
+	q := db.Query{Name: saveMethod, QueryRaw: `INSERT INTO auth.consumer_inbox(consumer, message_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`}
+	res, err := r.conn.DB().Exec(ctx, q, consumer, messageID)
+	if err != nil {
+		log.Error("failed to save inbox message", slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+func (r *repo) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	q := db.Query{Name: purgeMethod, QueryRaw: "DELETE FROM auth.consumer_inbox WHERE processed_at < now() - make_interval(secs => $1)"}
+	res, err := r.conn.DB().Exec(ctx, q, retention.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,15 @@
+package inbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository журнал обработанных входящих сообщений, нужен для идемпотентной обработки kafka-сообщений
+type Repository interface {
+	// Save отмечает сообщение обработанным. Возвращает false, если оно уже было обработано раньше.
+	// Вызывается в одной транзакции с изменениями обработчика
+	Save(ctx context.Context, consumer string, messageID string) (bool, error)
+	// Purge удаляет отметки старше retention
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/loginlink"
+	"github.com/neracastle/auth/internal/repository/loginlink/postgres/model"
+	"github.com/neracastle/auth/internal/repository/memory"
+)
+
+var _ loginlink.Repository = (*repo)(nil)
+
+type link struct {
+	dto       model.LinkDTO
+	expiresAt time.Time
+	used      bool
+}
+
+type repo struct {
+	mu    sync.Mutex
+	links map[string]*link
+}
+
+// New хранилище ссылок для входа в памяти процесса
+func New() loginlink.Repository {
+	return &repo{links: make(map[string]*link)}
+}
+
+func (r *repo) Save(ctx context.Context, dto model.LinkDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	dto.CreatedAt = now
+	r.links[dto.ID] = &link{dto: dto, expiresAt: now.Add(dto.TTL)}
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.links, dto.ID)
+	})
+
+	return nil
+}
+
+func (r *repo) CountSince(_ context.Context, email string, period time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	border := time.Now().Add(-period)
+	cnt := 0
+	for _, l := range r.links {
+		if l.dto.Email == email && l.dto.CreatedAt.After(border) {
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
+
+func (r *repo) Consume(ctx context.Context, id string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.links[id]
+	if !ok || l.used || !time.Now().Before(l.expiresAt) {
+		return 0, loginlink.ErrLinkNotFound
+	}
+
+	l.used = true
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		l.used = false
+	})
+
+	return l.dto.UserID, nil
+}
+
+func (r *repo) DeleteByUser(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := make(map[string]*link)
+	for id, l := range r.links {
+		if l.dto.UserID == userID {
+			deleted[id] = l
+			delete(r.links, id)
+		}
+	}
+
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for id, l := range deleted {
+			r.links[id] = l
+		}
+	})
+
+	return nil
+}
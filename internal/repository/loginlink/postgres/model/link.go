@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// LinkDTO модель выданной ссылки для входа
+type LinkDTO struct {
+	ID        string        `db:"id"`
+	UserID    int64         `db:"user_id"`
+	Email     string        `db:"email"`
+	TTL       time.Duration `db:"-"`
+	CreatedAt time.Time     `db:"created_at"`
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/loginlink"
+	"github.com/neracastle/auth/internal/repository/loginlink/postgres/model"
+)
+
+const (
+	saveMethod    = "repository.loginlink.postgres.Save"
+	countMethod   = "repository.loginlink.postgres.CountSince"
+	consumeMethod = "repository.loginlink.postgres.Consume"
+	deleteMethod  = "repository.loginlink.postgres.DeleteByUser"
+)
+
+var _ loginlink.Repository = (*repo)(nil)
+
+type repo struct {
+	conn db.Client
+}
+
+// New новый экземпляр репозитория pg
+func New(conn db.Client) loginlink.Repository {
+	return &repo{conn: conn}
+}
+
+func (r *repo) Save(ctx context.Context, dto model.LinkDTO) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", saveMethod))
+
+	q := db.Query{Name: saveMethod, QueryRaw: `INSERT INTO auth.login_links(id, user_id, email, expires_at)
+		VALUES ($1, $2, $3, now() + make_interval(secs => $4))`}
+
+	_, err := r.conn.DB().Exec(ctx, q, dto.ID, dto.UserID, dto.Email, dto.TTL.Seconds())
+	if err != nil {
+		log.Error("failed to save login link", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) CountSince(ctx context.Context, email string, period time.Duration) (int, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", countMethod))
+
+	q := db.Query{Name: countMethod, QueryRaw: `SELECT count(*) FROM auth.login_links
+		WHERE email = $1 AND created_at > now() - make_interval(secs => $2)`}
+
+	var cnt int
+	err := r.conn.DB().QueryRow(ctx, q, email, period.Seconds()).Scan(&cnt)
+	if err != nil {
+		log.Error("failed to count login links", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return cnt, nil
+}
+
+func (r *repo) Consume(ctx context.Context, id string) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", consumeMethod))
+
+	//одним запросом, чтобы две параллельные попытки не смогли использовать одну ссылку
+	q := db.Query{Name: consumeMethod, QueryRaw: `UPDATE auth.login_links SET used_at = now()
+		WHERE id = $1 AND used_at IS NULL AND expires_at > now()
+		RETURNING user_id`}
+
+	var userID int64
+	err := r.conn.DB().QueryRow(ctx, q, id).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, loginlink.ErrLinkNotFound
+		}
+
+		log.Error("failed to consume login link", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+func (r *repo) DeleteByUser(ctx context.Context, userID int64) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", deleteMethod))
+
+	q := db.Query{Name: deleteMethod, QueryRaw: "DELETE FROM auth.login_links WHERE user_id = $1"}
+	_, err := r.conn.DB().Exec(ctx, q, userID)
+	if err != nil {
+		log.Error("failed to delete login links", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package loginlink
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/loginlink/postgres/model"
+)
+
+// Repository хранилище выданных ссылок для входа
+type Repository interface {
+	Save(ctx context.Context, dto model.LinkDTO) error
+	// CountSince кол-во ссылок, выданных на email за последний период
+	CountSince(ctx context.Context, email string, period time.Duration) (int, error)
+	// Consume помечает ссылку использованной и возвращает ID пользователя.
+	// Если ссылка уже использована или истекла, вернется ErrLinkNotFound
+	Consume(ctx context.Context, id string) (int64, error)
+	// DeleteByUser удаляет все ссылки пользователя вместе с почтой, на которую они выданы
+	DeleteByUser(ctx context.Context, userID int64) error
+}
+
+var (
+	// ErrLinkNotFound ссылка не найдена, уже использована или истекла
+	ErrLinkNotFound = errors.New("ссылка не найдена")
+)
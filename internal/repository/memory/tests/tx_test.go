@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/memory"
+	"github.com/neracastle/auth/internal/repository/user"
+	usersMemory "github.com/neracastle/auth/internal/repository/user/memory"
+)
+
+func TestRollbackUndoesAllChanges(t *testing.T) {
+	ctx := context.Background()
+	tx := memory.NewClient().DB()
+	repo := usersMemory.New()
+
+	existing, err := domain.NewUser("old@example.com", "secret", "old")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, existing))
+
+	errFail := errors.New("fail")
+	err = tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		created, _ := domain.NewUser("new@example.com", "secret", "new")
+		if errTx := repo.Save(ctx, created); errTx != nil {
+			return errTx
+		}
+
+		existing.Name = "changed"
+		if errTx := repo.Update(ctx, existing); errTx != nil {
+			return errTx
+		}
+
+		//вложенная транзакция продолжает внешнюю и откатывается вместе с ней
+		return tx.ReadCommitted(ctx, func(ctx context.Context) error {
+			if errTx := repo.Delete(ctx, existing.ID); errTx != nil {
+				return errTx
+			}
+
+			return errFail
+		})
+	})
+	require.ErrorIs(t, err, errFail)
+
+	_, err = repo.Get(ctx, user.SearchFilter{Email: "new@example.com"})
+	require.ErrorIs(t, err, user.ErrUserNotFound)
+
+	got, err := repo.Get(ctx, user.SearchFilter{ID: existing.ID})
+	require.NoError(t, err)
+	require.Equal(t, "old", got.Name)
+	require.Equal(t, int64(1), got.Version)
+}
+
+func TestCommitKeepsChanges(t *testing.T) {
+	ctx := context.Background()
+	tx := memory.NewClient().DB()
+	repo := usersMemory.New()
+
+	u, _ := domain.NewUser("user@example.com", "secret", "user")
+	err := tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		return repo.Save(ctx, u)
+	})
+	require.NoError(t, err)
+
+	_, err = repo.Get(ctx, user.SearchFilter{ID: u.ID})
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/neracastle/go-libs/pkg/db"
+)
+
+// ErrNotSupported sql-запросы в режиме хранения в памяти недоступны, репозитории работают без них
+var ErrNotSupported = errors.New("sql-запросы не поддерживаются хранилищем в памяти")
+
+var (
+	_ db.Client = (*Client)(nil)
+	_ db.DB     = (*DB)(nil)
+)
+
+// Client клиент "бд" для режима хранения в памяти
+type Client struct {
+	db *DB
+}
+
+// NewClient новый клиент
+func NewClient() *Client {
+	return &Client{db: &DB{}}
+}
+
+func (c *Client) DB() db.DB {
+	return c.db
+}
+
+func (c *Client) Close() error {
+	return nil
+}
+
+// DB менеджер транзакций над репозиториями в памяти. Транзакции выполняются по одной,
+// при ошибке изменения откатываются в обратном порядке функциями, зарегистрированными через OnRollback
+type DB struct {
+	mu sync.Mutex
+}
+
+type txKey struct{}
+
+// journal функции отката изменений текущей транзакции
+type journal struct {
+	undo []func()
+}
+
+func (d *DB) ReadCommitted(ctx context.Context, f db.Handler) error {
+	//вложенная транзакция продолжает внешнюю
+	if _, ok := ctx.Value(txKey{}).(*journal); ok {
+		return f(ctx)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	j := &journal{}
+	err := f(context.WithValue(ctx, txKey{}, j))
+	if err != nil {
+		for i := len(j.undo) - 1; i >= 0; i-- {
+			j.undo[i]()
+		}
+	}
+
+	return err
+}
+
+// OnRollback регистрирует отмену изменения, если оно сделано внутри транзакции
+func OnRollback(ctx context.Context, undo func()) {
+	if j, ok := ctx.Value(txKey{}).(*journal); ok {
+		j.undo = append(j.undo, undo)
+	}
+}
+
+func (d *DB) BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error) {
+	return nil, ErrNotSupported
+}
+
+func (d *DB) Exec(context.Context, db.Query, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, ErrNotSupported
+}
+
+func (d *DB) Query(context.Context, db.Query, ...interface{}) (pgx.Rows, error) {
+	return nil, ErrNotSupported
+}
+
+func (d *DB) QueryRow(context.Context, db.Query, ...interface{}) pgx.Row {
+	return errRow{}
+}
+
+func (d *DB) SetQueryLogger(db.QueryLogger) {}
+
+func (d *DB) Ping(context.Context) error {
+	return nil
+}
+
+func (d *DB) Close() {}
+
+type errRow struct{}
+
+func (errRow) Scan(...any) error {
+	return ErrNotSupported
+}
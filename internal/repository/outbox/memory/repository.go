@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/memory"
+	"github.com/neracastle/auth/internal/repository/outbox"
+	"github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+)
+
+var _ outbox.Repository = (*repo)(nil)
+
+type message struct {
+	dto           model.MessageDTO
+	sent          bool
+	nextAttemptAt time.Time
+	lastError     string
+}
+
+type repo struct {
+	mu       sync.Mutex
+	messages []*message
+}
+
+// New outbox в памяти процесса. Рассчитан на один экземпляр сервиса, блокировки сообщений не нужны
+func New() outbox.Repository {
+	return &repo{}
+}
+
+func (r *repo) Save(ctx context.Context, msg model.MessageDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	msg.CreatedAt = time.Now()
+	r.messages = append(r.messages, &message{dto: msg, nextAttemptAt: msg.CreatedAt})
+	n := len(r.messages)
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.messages = r.messages[:n-1]
+	})
+
+	return nil
+}
+
+func (r *repo) FetchPending(_ context.Context, limit uint64) ([]model.MessageDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var res []model.MessageDTO
+	//ключи, у которых уже есть более раннее неотправленное сообщение
+	waiting := make(map[string]bool)
+	for _, m := range r.messages {
+		if uint64(len(res)) == limit {
+			break
+		}
+		if m.sent || waiting[m.dto.Key] {
+			continue
+		}
+		if m.dto.Key != "" {
+			waiting[m.dto.Key] = true
+		}
+
+		if !m.nextAttemptAt.After(now) {
+			res = append(res, m.dto)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *repo) MarkSent(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	//отправленные сообщения больше не нужны, в отличие от pg историю не храним
+	for i, m := range r.messages {
+		if m.dto.ID == id {
+			r.messages = append(r.messages[:i], r.messages[i+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *repo) MarkFailed(_ context.Context, id string, reason string, retryAfter time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.dto.ID == id {
+			m.dto.Attempts++
+			m.lastError = reason
+			m.nextAttemptAt = time.Now().Add(retryAfter)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Purge отправленные сообщения удаляются сразу в MarkSent, поэтому чистить нечего
+func (r *repo) Purge(context.Context, time.Duration) (int64, error) {
+	return 0, nil
+}
+
+// DeleteSentByKey отправленные сообщения удаляются сразу в MarkSent, поэтому удалять нечего
+func (r *repo) DeleteSentByKey(context.Context, string) (int64, error) {
+	return 0, nil
+}
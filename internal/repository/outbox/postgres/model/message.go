@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// MessageDTO исходящее сообщение для kafka
+type MessageDTO struct {
+	// ID стабильный идентификатор события, передается получателям в заголовке
+	ID        string            `db:"id"`
+	Topic     string            `db:"topic"`
+	Key       string            `db:"key"`
+	Payload   []byte            `db:"payload"`
+	Headers   map[string]string `db:"headers"`
+	Attempts  int               `db:"attempts"`
+	CreatedAt time.Time         `db:"created_at"`
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/outbox"
+	"github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+)
+
+const (
+	saveMethod       = "repository.outbox.postgres.Save"
+	fetchMethod      = "repository.outbox.postgres.FetchPending"
+	markSentMethod   = "repository.outbox.postgres.MarkSent"
+	markFailedMethod = "repository.outbox.postgres.MarkFailed"
+	purgeMethod      = "repository.outbox.postgres.Purge"
+	deleteSentMethod = "repository.outbox.postgres.DeleteSentByKey"
+)
+
+var _ outbox.Repository = (*repo)(nil)
+
+type repo struct {
+	conn db.Client
+}
+
+// New новый экземпляр репозитория pg
+func New(conn db.Client) outbox.Repository {
+	return &repo{conn: conn}
+}
+
+func (r *repo) Save(ctx context.Context, msg model.MessageDTO) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", saveMethod))
+
+	headers := msg.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	q := db.Query{Name: saveMethod, QueryRaw: "INSERT INTO auth.outbox(id, topic, key, payload, headers) VALUES ($1, $2, $3, $4, $5)"}
+	_, err := r.conn.DB().Exec(ctx, q, msg.ID, msg.Topic, msg.Key, msg.Payload, headers)
+	if err != nil {
+		log.Error("failed to save outbox message", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) FetchPending(ctx context.Context, limit uint64) ([]model.MessageDTO, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", fetchMethod))
+
+	//сообщение с ключом ждет, пока не уйдут более ранние с тем же ключом, даже отложенные или заблокированные
+	//другим экземпляром: иначе получатель увидит, например, user.deleted раньше user.created
+	q := db.Query{Name: fetchMethod, QueryRaw: `SELECT id, topic, key, payload, headers, attempts, created_at
+		FROM auth.outbox o
+		WHERE sent_at IS NULL AND next_attempt_at <= now()
+			AND (key = '' OR NOT EXISTS (
+				SELECT 1 FROM auth.outbox e WHERE e.key = o.key AND e.sent_at IS NULL AND e.seq < o.seq))
+		ORDER BY seq
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`}
+
+	res, err := r.conn.DB().Query(ctx, q, limit)
+	if err != nil {
+		log.Error("failed to fetch outbox messages", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	msgs, err := pgx.CollectRows(res, pgx.RowToStructByName[model.MessageDTO])
+	if err != nil {
+		log.Error("failed to scan outbox messages", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+func (r *repo) MarkSent(ctx context.Context, id string) error {
+	q := db.Query{Name: markSentMethod, QueryRaw: "UPDATE auth.outbox SET sent_at = now(), attempts = attempts + 1 WHERE id = $1"}
+	_, err := r.conn.DB().Exec(ctx, q, id)
+
+	return err
+}
+
+func (r *repo) MarkFailed(ctx context.Context, id string, reason string, retryAfter time.Duration) error {
+	q := db.Query{Name: markFailedMethod, QueryRaw: `UPDATE auth.outbox
+		SET attempts = attempts + 1, last_error = $2, next_attempt_at = now() + make_interval(secs => $3)
+		WHERE id = $1`}
+	_, err := r.conn.DB().Exec(ctx, q, id, reason, retryAfter.Seconds())
+
+	return err
+}
+
+func (r *repo) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	q := db.Query{Name: purgeMethod, QueryRaw: "DELETE FROM auth.outbox WHERE sent_at < now() - make_interval(secs => $1)"}
+	res, err := r.conn.DB().Exec(ctx, q, retention.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+func (r *repo) DeleteSentByKey(ctx context.Context, key string) (int64, error) {
+	q := db.Query{Name: deleteSentMethod, QueryRaw: "DELETE FROM auth.outbox WHERE key = $1 AND sent_at IS NOT NULL"}
+	res, err := r.conn.DB().Exec(ctx, q, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
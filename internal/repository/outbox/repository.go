@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+)
+
+// Repository хранилище исходящих сообщений (transactional outbox).
+// Сообщение сохраняется в той же транзакции, что и изменение данных, и позже отправляется в kafka
+type Repository interface {
+	Save(ctx context.Context, msg model.MessageDTO) error
+	// FetchPending блокирует до limit сообщений, готовых к отправке, в порядке сохранения. Вызывается внутри транзакции,
+	// заблокированные другими экземплярами сервиса сообщения пропускаются. Из сообщений с одним непустым ключом
+	// выбирается только самое раннее неотправленное, чтобы получатели видели их в том же порядке
+	FetchPending(ctx context.Context, limit uint64) ([]model.MessageDTO, error)
+	MarkSent(ctx context.Context, id string) error
+	// MarkFailed сохраняет ошибку отправки и откладывает следующую попытку
+	MarkFailed(ctx context.Context, id string, reason string, retryAfter time.Duration) error
+	// Purge удаляет отправленные сообщения старше retention
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	// DeleteSentByKey удаляет отправленные сообщения с ключом key, например все события стертого пользователя
+	DeleteSentByKey(ctx context.Context, key string) (int64, error)
+}
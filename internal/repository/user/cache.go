@@ -12,6 +12,17 @@ import (
 type Cache interface {
 	Save(context.Context, *domain.User, time.Duration) error
 	GetByID(ctx context.Context, id int64) (*domain.User, error)
+	// GetMany возвращает найденных в кэше пользователей, отсутствующих в результате нет
+	GetMany(ctx context.Context, ids []int64) (map[int64]*domain.User, error)
+	SaveMany(context.Context, []*domain.User, time.Duration) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// Invalidator рассылает остальным экземплярам сервиса id пользователей, чьи записи в кэше устарели
+type Invalidator interface {
+	Publish(ctx context.Context, id int64) error
+	// Subscribe вызывает fn на каждое оповещение других экземпляров. Блокируется до отмены ctx или ошибки
+	Subscribe(ctx context.Context, fn func(id int64)) error
 }
 
 var (
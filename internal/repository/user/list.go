@@ -0,0 +1,56 @@
+package user
+
+import (
+	"strconv"
+	"time"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+)
+
+// SortField поле сортировки списка пользователей
+type SortField string
+
+const (
+	// SortByID сортировка по ID
+	SortByID SortField = "id"
+	// SortByEmail сортировка по почте
+	SortByEmail SortField = "email"
+	// SortByName сортировка по имени
+	SortByName SortField = "name"
+	// SortByCreatedAt сортировка по дате регистрации
+	SortByCreatedAt SortField = "created_at"
+)
+
+// Cursor ключ последней записи страницы для keyset-пагинации
+type Cursor struct {
+	// Value значение поля сортировки, для SortByID не используется
+	Value string
+	ID    int64
+}
+
+// ListOptions параметры постраничной выборки
+type ListOptions struct {
+	Limit  uint64
+	SortBy SortField
+	Desc   bool
+	// After если задан, выборка начинается со следующей за ним записи
+	After *Cursor
+}
+
+// CursorFor формирует ключ пагинации по записи для заданной сортировки
+func CursorFor(u *domain.User, sortBy SortField) Cursor {
+	c := Cursor{ID: u.ID}
+
+	switch sortBy {
+	case SortByEmail:
+		c.Value = u.Email
+	case SortByName:
+		c.Value = u.Name
+	case SortByCreatedAt:
+		c.Value = u.RegDate.UTC().Format(time.RFC3339Nano)
+	default:
+		c.Value = strconv.FormatInt(u.ID, 10)
+	}
+
+	return c
+}
@@ -0,0 +1,185 @@
+package lru
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+)
+
+var _ user.Cache = (*Cache)(nil)
+
+// Cache кэш пользователей в памяти процесса перед общим кэшем next.
+// Записи живут не дольше ttl, при переполнении вытесняются давно не читанные
+type Cache struct {
+	next user.Cache
+	size int
+	ttl  time.Duration
+
+	mu    sync.Mutex
+	order *list.List
+	items map[int64]*list.Element
+}
+
+type entry struct {
+	user      domain.User
+	expiresAt time.Time
+}
+
+// New новый кэш на size записей
+func New(next user.Cache, size int, ttl time.Duration) *Cache {
+	return &Cache{
+		next:  next,
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[int64]*list.Element, size),
+	}
+}
+
+// Save сохраняет пользователя в общий кэш, затем в локальный
+func (c *Cache) Save(ctx context.Context, u *domain.User, ttl time.Duration) error {
+	err := c.next.Save(ctx, u, ttl)
+	if err != nil {
+		c.Evict(u.ID)
+		return err
+	}
+
+	c.put(u)
+
+	return nil
+}
+
+// GetByID ищет сначала в памяти, затем в общем кэше
+func (c *Cache) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+	if u, ok := c.get(id); ok {
+		observe(TierLocal, 1, 0)
+		return u, nil
+	}
+	observe(TierLocal, 0, 1)
+
+	u, err := c.next.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.put(u)
+
+	return u, nil
+}
+
+func (c *Cache) GetMany(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	res := make(map[int64]*domain.User, len(ids))
+	var missed []int64
+	for _, id := range ids {
+		if u, ok := c.get(id); ok {
+			res[id] = u
+			continue
+		}
+		missed = append(missed, id)
+	}
+	observe(TierLocal, len(res), len(missed))
+
+	if len(missed) == 0 {
+		return res, nil
+	}
+
+	found, err := c.next.GetMany(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, u := range found {
+		c.put(u)
+		res[id] = u
+	}
+
+	return res, nil
+}
+
+func (c *Cache) SaveMany(ctx context.Context, users []*domain.User, ttl time.Duration) error {
+	err := c.next.SaveMany(ctx, users, ttl)
+	if err != nil {
+		for _, u := range users {
+			c.Evict(u.ID)
+		}
+		return err
+	}
+
+	for _, u := range users {
+		c.put(u)
+	}
+
+	return nil
+}
+
+func (c *Cache) Delete(ctx context.Context, id int64) error {
+	c.Evict(id)
+	return c.next.Delete(ctx, id)
+}
+
+// Evict удаляет пользователя только из памяти процесса, общий кэш не трогает
+func (c *Cache) Evict(id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[id]; ok {
+		c.order.Remove(el)
+		delete(c.items, id)
+	}
+}
+
+// Len количество записей в памяти
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// get возвращает копию записи, чтобы вызывающий не мог изменить кэш
+func (c *Cache) get(id int64) (*domain.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if !time.Now().Before(e.expiresAt) {
+		c.order.Remove(el)
+		delete(c.items, id)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	u := e.user
+
+	return &u, true
+}
+
+func (c *Cache) put(u *domain.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &entry{user: *u, expiresAt: time.Now().Add(c.ttl)}
+	//как и в redis, хэш пароля в кэше не держим
+	e.user.Password = ""
+	if el, ok := c.items[u.ID]; ok {
+		el.Value = e
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[u.ID] = c.order.PushFront(e)
+	for c.order.Len() > c.size {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.items, last.Value.(*entry).user.ID)
+	}
+}
@@ -0,0 +1,67 @@
+package lru
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+)
+
+// Уровни кэша для метрик
+const (
+	TierLocal = "local"
+	TierRedis = "redis"
+)
+
+var cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "users",
+	Subsystem: "cache",
+	Name:      "lookups_total",
+	Help:      "User cache lookups by tier and result",
+}, []string{"tier", "result"})
+
+func observe(tier string, hits, misses int) {
+	if hits > 0 {
+		cacheLookups.WithLabelValues(tier, "hit").Add(float64(hits))
+	}
+	if misses > 0 {
+		cacheLookups.WithLabelValues(tier, "miss").Add(float64(misses))
+	}
+}
+
+var _ user.Cache = (*instrumented)(nil)
+
+type instrumented struct {
+	user.Cache
+	tier string
+}
+
+// Instrument считает попадания и промахи кэша c под меткой tier
+func Instrument(tier string, c user.Cache) user.Cache {
+	return &instrumented{Cache: c, tier: tier}
+}
+
+func (i *instrumented) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+	u, err := i.Cache.GetByID(ctx, id)
+	switch {
+	case err == nil:
+		observe(i.tier, 1, 0)
+	case errors.Is(err, user.ErrUserNotCached):
+		observe(i.tier, 0, 1)
+	}
+
+	return u, err
+}
+
+func (i *instrumented) GetMany(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	found, err := i.Cache.GetMany(ctx, ids)
+	if err == nil {
+		observe(i.tier, len(found), len(ids)-len(found))
+	}
+
+	return found, err
+}
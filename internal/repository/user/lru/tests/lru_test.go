@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/lru"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+)
+
+func TestLocalHitSkipsNext(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+	u := &domain.User{ID: 1, Email: "a@example.com"}
+
+	next := mocks.NewCacheMock(mc)
+	//в общий кэш идем только один раз, второе чтение из памяти
+	next.GetByIDMock.Expect(ctx, u.ID).Return(u, nil)
+
+	c := lru.New(next, 10, time.Minute)
+	for i := 0; i < 2; i++ {
+		got, err := c.GetByID(ctx, u.ID)
+		require.NoError(t, err)
+		require.Equal(t, u, got)
+	}
+
+	//возвращается копия, изменение не портит кэш
+	got, _ := c.GetByID(ctx, u.ID)
+	got.Email = "changed@example.com"
+	got, _ = c.GetByID(ctx, u.ID)
+	require.Equal(t, "a@example.com", got.Email)
+}
+
+func TestEvictsLeastRecentlyUsed(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewCacheMock(mc)
+	next.SaveMock.Return(nil)
+	next.GetByIDMock.Return(nil, user.ErrUserNotCached)
+
+	c := lru.New(next, 2, time.Minute)
+	for id := int64(1); id <= 2; id++ {
+		require.NoError(t, c.Save(ctx, &domain.User{ID: id}, time.Minute))
+	}
+
+	//читаем первого, вытеснен должен быть второй
+	_, err := c.GetByID(ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, c.Save(ctx, &domain.User{ID: 3}, time.Minute))
+
+	require.Equal(t, 2, c.Len())
+	_, err = c.GetByID(ctx, 2)
+	require.ErrorIs(t, err, user.ErrUserNotCached)
+	_, err = c.GetByID(ctx, 3)
+	require.NoError(t, err)
+}
+
+func TestEntryExpires(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewCacheMock(mc)
+	next.SaveMock.Return(nil)
+	next.GetByIDMock.Return(nil, user.ErrUserNotCached)
+
+	c := lru.New(next, 10, 20*time.Millisecond)
+	require.NoError(t, c.Save(ctx, &domain.User{ID: 1}, time.Minute))
+
+	time.Sleep(30 * time.Millisecond)
+	_, err := c.GetByID(ctx, 1)
+	require.ErrorIs(t, err, user.ErrUserNotCached)
+}
+
+func TestEvictKeepsNext(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+	u := &domain.User{ID: 1}
+
+	next := mocks.NewCacheMock(mc)
+	next.SaveMock.Return(nil)
+	next.GetByIDMock.Expect(ctx, u.ID).Return(u, nil)
+
+	c := lru.New(next, 10, time.Minute)
+	require.NoError(t, c.Save(ctx, u, time.Minute))
+
+	//оповещение с другого экземпляра сбрасывает только память, запись перечитывается из общего кэша
+	c.Evict(u.ID)
+	require.Equal(t, 0, c.Len())
+
+	got, err := c.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	require.Equal(t, u, got)
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+)
+
+var _ user.Cache = (*cache)(nil)
+
+type cachedUser struct {
+	user      domain.User
+	expiresAt time.Time
+}
+
+type cache struct {
+	mu    sync.Mutex
+	users map[int64]cachedUser
+}
+
+// NewCache кэш пользователей в памяти вместо redis. Как и redis, хранит только публичные поля
+func NewCache() user.Cache {
+	return &cache{users: make(map[int64]cachedUser)}
+}
+
+func (c *cache) Save(_ context.Context, u *domain.User, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.put(u, ttl)
+
+	return nil
+}
+
+func (c *cache) GetByID(_ context.Context, id int64) (*domain.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	u, ok := c.get(id)
+	if !ok {
+		return nil, user.ErrUserNotCached
+	}
+
+	return u, nil
+}
+
+func (c *cache) GetMany(_ context.Context, ids []int64) (map[int64]*domain.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make(map[int64]*domain.User, len(ids))
+	for _, id := range ids {
+		if u, ok := c.get(id); ok {
+			res[id] = u
+		}
+	}
+
+	return res, nil
+}
+
+func (c *cache) SaveMany(_ context.Context, users []*domain.User, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, u := range users {
+		c.put(u, ttl)
+	}
+
+	return nil
+}
+
+func (c *cache) Delete(_ context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.users, id)
+
+	return nil
+}
+
+func (c *cache) put(u *domain.User, ttl time.Duration) {
+	stored := *u
+	stored.Password = ""
+	c.users[u.ID] = cachedUser{user: stored, expiresAt: time.Now().Add(ttl)}
+}
+
+func (c *cache) get(id int64) (*domain.User, bool) {
+	cached, ok := c.users[id]
+	if !ok {
+		return nil, false
+	}
+
+	if !time.Now().Before(cached.expiresAt) {
+		delete(c.users, id)
+		return nil, false
+	}
+
+	u := cached.user
+
+	return &u, true
+}
+
+var _ user.Invalidator = invalidator{}
+
+type invalidator struct{}
+
+// NewInvalidator оповещения для единственного экземпляра: рассылать некому
+func NewInvalidator() user.Invalidator {
+	return invalidator{}
+}
+
+func (invalidator) Publish(context.Context, int64) error {
+	return nil
+}
+
+func (invalidator) Subscribe(ctx context.Context, _ func(id int64)) error {
+	<-ctx.Done()
+	return nil
+}
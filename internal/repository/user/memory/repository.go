@@ -0,0 +1,482 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/memory"
+	"github.com/neracastle/auth/internal/repository/user"
+)
+
+var _ user.Repository = (*repo)(nil)
+
+// record пользователь с полями, которых нет в доменной модели
+type record struct {
+	user         domain.User
+	deletedAt    time.Time
+	erasedAt     time.Time
+	lastActivity time.Time
+}
+
+func (r *record) deleted() bool {
+	return !r.deletedAt.IsZero()
+}
+
+type repo struct {
+	mu     sync.RWMutex
+	lastID int64
+	users  map[int64]*record
+}
+
+// New репозиторий пользователей в памяти процесса
+func New() user.Repository {
+	return &repo{users: make(map[int64]*record)}
+}
+
+func (r *repo) Save(ctx context.Context, u *domain.User) error {
+	//как и в pg, пароль хэшируется при сохранении
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return r.insert(ctx, u, string(pwdHash))
+}
+
+func (r *repo) SaveHashed(ctx context.Context, u *domain.User) error {
+	return r.insert(ctx, u, u.Password)
+}
+
+func (r *repo) insert(ctx context.Context, u *domain.User, pwdHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.emailTaken(u.Email, 0) {
+		return user.ErrEmailTaken
+	}
+
+	r.lastID++
+	u.ID = r.lastID
+	u.Version = 1
+
+	//как и в pg, по умолчанию пользователь активен
+	if u.Status == "" {
+		u.Status = domain.StatusActive
+	}
+
+	stored := *u
+	stored.Password = pwdHash
+	if stored.RegDate.IsZero() {
+		stored.RegDate = time.Now().Truncate(time.Second)
+	}
+	r.users[u.ID] = &record{user: stored}
+
+	id := u.ID
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.users, id)
+	})
+
+	return nil
+}
+
+func (r *repo) Update(ctx context.Context, u *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.users[u.ID]
+	if !ok || rec.deleted() {
+		return user.ErrUserNotFound
+	}
+
+	if rec.user.Version != u.Version {
+		return user.ErrVersionConflict
+	}
+
+	if r.emailTaken(u.Email, u.ID) {
+		return user.ErrEmailTaken
+	}
+
+	prev := rec.user
+	rec.user = *u
+	rec.user.RegDate = prev.RegDate
+	rec.user.Version++
+	u.Version = rec.user.Version
+
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		rec.user = prev
+	})
+
+	return nil
+}
+
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.users[id]
+	if !ok || rec.deleted() {
+		return user.ErrUserNotFound
+	}
+
+	rec.deletedAt = time.Now()
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		rec.deletedAt = time.Time{}
+	})
+
+	return nil
+}
+
+func (r *repo) Restore(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.users[id]
+	if !ok || !rec.deleted() || !rec.erasedAt.IsZero() {
+		return user.ErrUserNotFound
+	}
+
+	if r.emailTaken(rec.user.Email, id) {
+		return user.ErrEmailTaken
+	}
+
+	deletedAt := rec.deletedAt
+	rec.deletedAt = time.Time{}
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		rec.deletedAt = deletedAt
+	})
+
+	return nil
+}
+
+func (r *repo) Erase(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.users[id]
+	if !ok || !rec.erasedAt.IsZero() {
+		return user.ErrUserNotFound
+	}
+
+	prev := *rec
+	rec.user.Erase()
+	rec.user.Version++
+	rec.lastActivity = time.Time{}
+	rec.erasedAt = time.Now()
+	if !rec.deleted() {
+		rec.deletedAt = rec.erasedAt
+	}
+
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		*rec = prev
+	})
+
+	return nil
+}
+
+func (r *repo) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	border := time.Now().Add(-retention)
+	purged := make(map[int64]*record)
+	for id, rec := range r.users {
+		if rec.deleted() && rec.deletedAt.Before(border) && rec.erasedAt.IsZero() {
+			purged[id] = rec
+			delete(r.users, id)
+		}
+	}
+
+	memory.OnRollback(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for id, rec := range purged {
+			r.users[id] = rec
+		}
+	})
+
+	return int64(len(purged)), nil
+}
+
+func (r *repo) Get(_ context.Context, filter user.SearchFilter) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rec := range r.sorted() {
+		if matches(rec, filter) {
+			u := rec.user
+			return &u, nil
+		}
+	}
+
+	return nil, user.ErrUserNotFound
+}
+
+func (r *repo) GetMany(_ context.Context, ids []int64) ([]*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]*domain.User, 0, len(ids))
+	for _, id := range ids {
+		rec, ok := r.users[id]
+		if !ok || rec.deleted() {
+			continue
+		}
+
+		u := rec.user
+		users = append(users, &u)
+	}
+
+	return users, nil
+}
+
+func (r *repo) List(_ context.Context, filter user.SearchFilter, opts user.ListOptions) ([]*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var users []*domain.User
+	for _, rec := range r.sorted() {
+		if matches(rec, filter) {
+			u := rec.user
+			users = append(users, &u)
+		}
+	}
+
+	//тот же порядок, что и в pg: поле сортировки, затем id
+	sort.SliceStable(users, func(i, j int) bool {
+		c := compare(users[i], users[j], opts.SortBy)
+		if opts.Desc {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	res := make([]*domain.User, 0, opts.Limit)
+	for _, u := range users {
+		if uint64(len(res)) == opts.Limit {
+			break
+		}
+
+		if opts.After != nil {
+			c := compareCursor(u, *opts.After, opts.SortBy)
+			if (!opts.Desc && c <= 0) || (opts.Desc && c >= 0) {
+				continue
+			}
+		}
+
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+func (r *repo) Search(_ context.Context, query string, opts user.SearchOptions) ([]user.SearchHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, nil
+	}
+
+	var hits []user.SearchHit
+	for _, rec := range r.sorted() {
+		if rec.deleted() {
+			continue
+		}
+
+		text := rec.user.Name
+		if opts.WithEmail {
+			text += " " + rec.user.Email
+		}
+
+		rank := matchRank(text, query)
+		if rank == 0 {
+			continue
+		}
+
+		hits = append(hits, user.SearchHit{
+			User:      &domain.User{ID: rec.user.ID, Email: rec.user.Email, Name: rec.user.Name},
+			Rank:      rank,
+			Highlight: highlight(text, query),
+		})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Rank > hits[j].Rank
+	})
+
+	if uint64(len(hits)) > opts.Limit {
+		hits = hits[:opts.Limit]
+	}
+
+	return hits, nil
+}
+
+func (r *repo) TouchActivity(_ context.Context, id int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.users[id]
+	if ok && !rec.deleted() && at.After(rec.lastActivity) {
+		rec.lastActivity = at
+	}
+
+	return nil
+}
+
+// emailTaken занята ли почта активным пользователем, кроме exceptID
+func (r *repo) emailTaken(email string, exceptID int64) bool {
+	for id, rec := range r.users {
+		if id != exceptID && !rec.deleted() && rec.user.Email == email {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sorted записи по возрастанию id
+func (r *repo) sorted() []*record {
+	recs := make([]*record, 0, len(r.users))
+	for _, rec := range r.users {
+		recs = append(recs, rec)
+	}
+
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i].user.ID < recs[j].user.ID
+	})
+
+	return recs
+}
+
+// matches повторяет условия applyFilter из pg-репозитория
+func matches(rec *record, filter user.SearchFilter) bool {
+	u := rec.user
+	switch {
+	case rec.deleted():
+		return false
+	case filter.ID > 0 && u.ID != filter.ID:
+		return false
+	case filter.Email != "" && u.Email != filter.Email:
+		return false
+	case filter.IsAdmin != nil && u.IsAdmin != *filter.IsAdmin:
+		return false
+	case !filter.CreatedFrom.IsZero() && u.RegDate.Before(filter.CreatedFrom):
+		return false
+	case !filter.CreatedTo.IsZero() && !u.RegDate.Before(filter.CreatedTo):
+		return false
+	}
+
+	if filter.Query != "" {
+		q := strings.ToLower(filter.Query)
+		return strings.Contains(strings.ToLower(u.Email), q) || strings.Contains(strings.ToLower(u.Name), q)
+	}
+
+	return true
+}
+
+// compare сравнивает пользователей по полю сортировки, при равенстве по id
+func compare(a, b *domain.User, sortBy user.SortField) int {
+	var c int
+	switch sortBy {
+	case user.SortByEmail:
+		c = strings.Compare(a.Email, b.Email)
+	case user.SortByName:
+		c = strings.Compare(a.Name, b.Name)
+	case user.SortByCreatedAt:
+		c = a.RegDate.Compare(b.RegDate)
+	}
+
+	if c != 0 {
+		return c
+	}
+
+	return compareInt(a.ID, b.ID)
+}
+
+// compareCursor сравнивает пользователя с ключом пагинации
+func compareCursor(u *domain.User, after user.Cursor, sortBy user.SortField) int {
+	var c int
+	switch sortBy {
+	case user.SortByEmail:
+		c = strings.Compare(u.Email, after.Value)
+	case user.SortByName:
+		c = strings.Compare(u.Name, after.Value)
+	case user.SortByCreatedAt:
+		at, err := time.Parse(time.RFC3339Nano, after.Value)
+		if err == nil {
+			c = u.RegDate.Compare(at)
+		}
+	}
+
+	if c != 0 {
+		return c
+	}
+
+	return compareInt(u.ID, after.ID)
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// matchRank грубая замена ts_rank: совпадение с началом слова ценнее подстроки
+func matchRank(text, query string) float32 {
+	text = strings.ToLower(text)
+	for _, word := range strings.Fields(text) {
+		if strings.HasPrefix(word, query) {
+			return 1
+		}
+	}
+
+	if strings.Contains(text, query) {
+		return 0.5
+	}
+
+	return 0
+}
+
+// highlight выделяет совпадения так же, как ts_headline в pg
+func highlight(text, query string) string {
+	lower := strings.ToLower(text)
+	//у некоторых символов строчная форма другой длины, тогда позиции не совпадут
+	if len(lower) != len(text) {
+		return text
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 {
+			b.WriteString(text)
+			return b.String()
+		}
+
+		b.WriteString(text[:i])
+		b.WriteString("<b>" + text[i:i+len(query)] + "</b>")
+		text, lower = text[i+len(query):], lower[i+len(query):]
+	}
+}
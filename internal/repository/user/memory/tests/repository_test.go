@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	usersMemory "github.com/neracastle/auth/internal/repository/user/memory"
+)
+
+func save(t *testing.T, repo user.Repository, email, name string) *domain.User {
+	u, err := domain.NewUser(email, "secret", name)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), u))
+
+	return u
+}
+
+func TestSaveHashesPassword(t *testing.T) {
+	repo := usersMemory.New()
+	u := save(t, repo, "user@example.com", "user")
+
+	got, err := repo.Get(context.Background(), user.SearchFilter{Email: u.Email})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(got.Password), []byte("secret")))
+}
+
+func TestDeleteRestore(t *testing.T) {
+	ctx := context.Background()
+	repo := usersMemory.New()
+	u := save(t, repo, "user@example.com", "user")
+
+	require.NoError(t, repo.Delete(ctx, u.ID))
+	_, err := repo.Get(ctx, user.SearchFilter{ID: u.ID})
+	require.ErrorIs(t, err, user.ErrUserNotFound)
+
+	//пока пользователь удален, его почту можно занять
+	other := save(t, repo, "user@example.com", "other")
+	require.ErrorIs(t, repo.Restore(ctx, u.ID), user.ErrEmailTaken)
+
+	require.NoError(t, repo.Delete(ctx, other.ID))
+	require.NoError(t, repo.Restore(ctx, u.ID))
+	require.ErrorIs(t, repo.Restore(ctx, u.ID), user.ErrUserNotFound)
+}
+
+func TestUpdateVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	repo := usersMemory.New()
+	u := save(t, repo, "user@example.com", "user")
+
+	stale := *u
+	u.Name = "first"
+	require.NoError(t, repo.Update(ctx, u))
+	require.Equal(t, int64(2), u.Version)
+
+	stale.Name = "second"
+	require.ErrorIs(t, repo.Update(ctx, &stale), user.ErrVersionConflict)
+}
+
+func TestListKeyset(t *testing.T) {
+	ctx := context.Background()
+	repo := usersMemory.New()
+	for i, name := range []string{"b", "a", "c", "a"} {
+		save(t, repo, fmt.Sprintf("user%d@example.com", i), name)
+	}
+
+	opts := user.ListOptions{Limit: 2, SortBy: user.SortByName}
+	page, err := repo.List(ctx, user.SearchFilter{}, opts)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, []int64{2, 4}, []int64{page[0].ID, page[1].ID})
+
+	cursor := user.CursorFor(page[1], user.SortByName)
+	opts.After = &cursor
+	page, err = repo.List(ctx, user.SearchFilter{}, opts)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3}, []int64{page[0].ID, page[1].ID})
+}
@@ -20,17 +20,35 @@ type CacheMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mCacheMockDelete
+
 	funcGetByID          func(ctx context.Context, id int64) (up1 *domain.User, err error)
 	inspectFuncGetByID   func(ctx context.Context, id int64)
 	afterGetByIDCounter  uint64
 	beforeGetByIDCounter uint64
 	GetByIDMock          mCacheMockGetByID
 
+	funcGetMany          func(ctx context.Context, ids []int64) (m1 map[int64]*domain.User, err error)
+	inspectFuncGetMany   func(ctx context.Context, ids []int64)
+	afterGetManyCounter  uint64
+	beforeGetManyCounter uint64
+	GetManyMock          mCacheMockGetMany
+
 	funcSave          func(ctx context.Context, up1 *domain.User, d1 time.Duration) (err error)
 	inspectFuncSave   func(ctx context.Context, up1 *domain.User, d1 time.Duration)
 	afterSaveCounter  uint64
 	beforeSaveCounter uint64
 	SaveMock          mCacheMockSave
+
+	funcSaveMany          func(ctx context.Context, upa1 []*domain.User, d1 time.Duration) (err error)
+	inspectFuncSaveMany   func(ctx context.Context, upa1 []*domain.User, d1 time.Duration)
+	afterSaveManyCounter  uint64
+	beforeSaveManyCounter uint64
+	SaveManyMock          mCacheMockSaveMany
 }
 
 // NewCacheMock returns a mock for user.Cache
@@ -41,17 +59,242 @@ func NewCacheMock(t minimock.Tester) *CacheMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.DeleteMock = mCacheMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*CacheMockDeleteParams{}
+
 	m.GetByIDMock = mCacheMockGetByID{mock: m}
 	m.GetByIDMock.callArgs = []*CacheMockGetByIDParams{}
 
+	m.GetManyMock = mCacheMockGetMany{mock: m}
+	m.GetManyMock.callArgs = []*CacheMockGetManyParams{}
+
 	m.SaveMock = mCacheMockSave{mock: m}
 	m.SaveMock.callArgs = []*CacheMockSaveParams{}
 
+	m.SaveManyMock = mCacheMockSaveMany{mock: m}
+	m.SaveManyMock.callArgs = []*CacheMockSaveManyParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mCacheMockDelete struct {
+	mock               *CacheMock
+	defaultExpectation *CacheMockDeleteExpectation
+	expectations       []*CacheMockDeleteExpectation
+
+	callArgs []*CacheMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// CacheMockDeleteExpectation specifies expectation struct of the Cache.Delete
+type CacheMockDeleteExpectation struct {
+	mock    *CacheMock
+	params  *CacheMockDeleteParams
+	results *CacheMockDeleteResults
+	Counter uint64
+}
+
+// CacheMockDeleteParams contains parameters of the Cache.Delete
+type CacheMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// CacheMockDeleteResults contains results of the Cache.Delete
+type CacheMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for Cache.Delete
+func (mmDelete *mCacheMockDelete) Expect(ctx context.Context, id int64) *mCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{}
+	}
+
+	mmDelete.defaultExpectation.params = &CacheMockDeleteParams{ctx, id}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.Delete
+func (mmDelete *mCacheMockDelete) Inspect(f func(ctx context.Context, id int64)) *mCacheMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for CacheMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by Cache.Delete
+func (mmDelete *mCacheMockDelete) Return(err error) *CacheMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &CacheMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the Cache.Delete method
+func (mmDelete *mCacheMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *CacheMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the Cache.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the Cache.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the Cache.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mCacheMockDelete) When(ctx context.Context, id int64) *CacheMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	expectation := &CacheMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &CacheMockDeleteParams{ctx, id},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.Delete return parameters for the expectation previously defined by the When method
+func (e *CacheMockDeleteExpectation) Then(err error) *CacheMock {
+	e.results = &CacheMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements user.Cache
+func (mmDelete *CacheMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := CacheMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_got := CacheMockDeleteParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("CacheMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the CacheMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to CacheMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished CacheMock.Delete invocations
+func (mmDelete *CacheMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of CacheMock.Delete invocations
+func (mmDelete *CacheMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mCacheMockDelete) Calls() []*CacheMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*CacheMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *CacheMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CacheMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to CacheMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to CacheMock.Delete")
+	}
+}
+
 type mCacheMockGetByID struct {
 	mock               *CacheMock
 	defaultExpectation *CacheMockGetByIDExpectation
@@ -269,6 +512,223 @@ func (m *CacheMock) MinimockGetByIDInspect() {
 	}
 }
 
+type mCacheMockGetMany struct {
+	mock               *CacheMock
+	defaultExpectation *CacheMockGetManyExpectation
+	expectations       []*CacheMockGetManyExpectation
+
+	callArgs []*CacheMockGetManyParams
+	mutex    sync.RWMutex
+}
+
+// CacheMockGetManyExpectation specifies expectation struct of the Cache.GetMany
+type CacheMockGetManyExpectation struct {
+	mock    *CacheMock
+	params  *CacheMockGetManyParams
+	results *CacheMockGetManyResults
+	Counter uint64
+}
+
+// CacheMockGetManyParams contains parameters of the Cache.GetMany
+type CacheMockGetManyParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// CacheMockGetManyResults contains results of the Cache.GetMany
+type CacheMockGetManyResults struct {
+	m1  map[int64]*domain.User
+	err error
+}
+
+// Expect sets up expected params for Cache.GetMany
+func (mmGetMany *mCacheMockGetMany) Expect(ctx context.Context, ids []int64) *mCacheMockGetMany {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("CacheMock.GetMany mock is already set by Set")
+	}
+
+	if mmGetMany.defaultExpectation == nil {
+		mmGetMany.defaultExpectation = &CacheMockGetManyExpectation{}
+	}
+
+	mmGetMany.defaultExpectation.params = &CacheMockGetManyParams{ctx, ids}
+	for _, e := range mmGetMany.expectations {
+		if minimock.Equal(e.params, mmGetMany.defaultExpectation.params) {
+			mmGetMany.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMany.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMany
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.GetMany
+func (mmGetMany *mCacheMockGetMany) Inspect(f func(ctx context.Context, ids []int64)) *mCacheMockGetMany {
+	if mmGetMany.mock.inspectFuncGetMany != nil {
+		mmGetMany.mock.t.Fatalf("Inspect function is already set for CacheMock.GetMany")
+	}
+
+	mmGetMany.mock.inspectFuncGetMany = f
+
+	return mmGetMany
+}
+
+// Return sets up results that will be returned by Cache.GetMany
+func (mmGetMany *mCacheMockGetMany) Return(m1 map[int64]*domain.User, err error) *CacheMock {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("CacheMock.GetMany mock is already set by Set")
+	}
+
+	if mmGetMany.defaultExpectation == nil {
+		mmGetMany.defaultExpectation = &CacheMockGetManyExpectation{mock: mmGetMany.mock}
+	}
+	mmGetMany.defaultExpectation.results = &CacheMockGetManyResults{m1, err}
+	return mmGetMany.mock
+}
+
+// Set uses given function f to mock the Cache.GetMany method
+func (mmGetMany *mCacheMockGetMany) Set(f func(ctx context.Context, ids []int64) (m1 map[int64]*domain.User, err error)) *CacheMock {
+	if mmGetMany.defaultExpectation != nil {
+		mmGetMany.mock.t.Fatalf("Default expectation is already set for the Cache.GetMany method")
+	}
+
+	if len(mmGetMany.expectations) > 0 {
+		mmGetMany.mock.t.Fatalf("Some expectations are already set for the Cache.GetMany method")
+	}
+
+	mmGetMany.mock.funcGetMany = f
+	return mmGetMany.mock
+}
+
+// When sets expectation for the Cache.GetMany which will trigger the result defined by the following
+// Then helper
+func (mmGetMany *mCacheMockGetMany) When(ctx context.Context, ids []int64) *CacheMockGetManyExpectation {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("CacheMock.GetMany mock is already set by Set")
+	}
+
+	expectation := &CacheMockGetManyExpectation{
+		mock:   mmGetMany.mock,
+		params: &CacheMockGetManyParams{ctx, ids},
+	}
+	mmGetMany.expectations = append(mmGetMany.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.GetMany return parameters for the expectation previously defined by the When method
+func (e *CacheMockGetManyExpectation) Then(m1 map[int64]*domain.User, err error) *CacheMock {
+	e.results = &CacheMockGetManyResults{m1, err}
+	return e.mock
+}
+
+// GetMany implements user.Cache
+func (mmGetMany *CacheMock) GetMany(ctx context.Context, ids []int64) (m1 map[int64]*domain.User, err error) {
+	mm_atomic.AddUint64(&mmGetMany.beforeGetManyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMany.afterGetManyCounter, 1)
+
+	if mmGetMany.inspectFuncGetMany != nil {
+		mmGetMany.inspectFuncGetMany(ctx, ids)
+	}
+
+	mm_params := CacheMockGetManyParams{ctx, ids}
+
+	// Record call args
+	mmGetMany.GetManyMock.mutex.Lock()
+	mmGetMany.GetManyMock.callArgs = append(mmGetMany.GetManyMock.callArgs, &mm_params)
+	mmGetMany.GetManyMock.mutex.Unlock()
+
+	for _, e := range mmGetMany.GetManyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetMany.GetManyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMany.GetManyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMany.GetManyMock.defaultExpectation.params
+		mm_got := CacheMockGetManyParams{ctx, ids}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMany.t.Errorf("CacheMock.GetMany got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMany.GetManyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMany.t.Fatal("No results are set for the CacheMock.GetMany")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetMany.funcGetMany != nil {
+		return mmGetMany.funcGetMany(ctx, ids)
+	}
+	mmGetMany.t.Fatalf("Unexpected call to CacheMock.GetMany. %v %v", ctx, ids)
+	return
+}
+
+// GetManyAfterCounter returns a count of finished CacheMock.GetMany invocations
+func (mmGetMany *CacheMock) GetManyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMany.afterGetManyCounter)
+}
+
+// GetManyBeforeCounter returns a count of CacheMock.GetMany invocations
+func (mmGetMany *CacheMock) GetManyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMany.beforeGetManyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.GetMany.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMany *mCacheMockGetMany) Calls() []*CacheMockGetManyParams {
+	mmGetMany.mutex.RLock()
+
+	argCopy := make([]*CacheMockGetManyParams, len(mmGetMany.callArgs))
+	copy(argCopy, mmGetMany.callArgs)
+
+	mmGetMany.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetManyDone returns true if the count of the GetMany invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockGetManyDone() bool {
+	for _, e := range m.GetManyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMany != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetManyInspect logs each unmet expectation
+func (m *CacheMock) MinimockGetManyInspect() {
+	for _, e := range m.GetManyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.GetMany with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		if m.GetManyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CacheMock.GetMany")
+		} else {
+			m.t.Errorf("Expected call to CacheMock.GetMany with params: %#v", *m.GetManyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMany != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		m.t.Error("Expected call to CacheMock.GetMany")
+	}
+}
+
 type mCacheMockSave struct {
 	mock               *CacheMock
 	defaultExpectation *CacheMockSaveExpectation
@@ -486,13 +946,236 @@ func (m *CacheMock) MinimockSaveInspect() {
 	}
 }
 
+type mCacheMockSaveMany struct {
+	mock               *CacheMock
+	defaultExpectation *CacheMockSaveManyExpectation
+	expectations       []*CacheMockSaveManyExpectation
+
+	callArgs []*CacheMockSaveManyParams
+	mutex    sync.RWMutex
+}
+
+// CacheMockSaveManyExpectation specifies expectation struct of the Cache.SaveMany
+type CacheMockSaveManyExpectation struct {
+	mock    *CacheMock
+	params  *CacheMockSaveManyParams
+	results *CacheMockSaveManyResults
+	Counter uint64
+}
+
+// CacheMockSaveManyParams contains parameters of the Cache.SaveMany
+type CacheMockSaveManyParams struct {
+	ctx  context.Context
+	upa1 []*domain.User
+	d1   time.Duration
+}
+
+// CacheMockSaveManyResults contains results of the Cache.SaveMany
+type CacheMockSaveManyResults struct {
+	err error
+}
+
+// Expect sets up expected params for Cache.SaveMany
+func (mmSaveMany *mCacheMockSaveMany) Expect(ctx context.Context, upa1 []*domain.User, d1 time.Duration) *mCacheMockSaveMany {
+	if mmSaveMany.mock.funcSaveMany != nil {
+		mmSaveMany.mock.t.Fatalf("CacheMock.SaveMany mock is already set by Set")
+	}
+
+	if mmSaveMany.defaultExpectation == nil {
+		mmSaveMany.defaultExpectation = &CacheMockSaveManyExpectation{}
+	}
+
+	mmSaveMany.defaultExpectation.params = &CacheMockSaveManyParams{ctx, upa1, d1}
+	for _, e := range mmSaveMany.expectations {
+		if minimock.Equal(e.params, mmSaveMany.defaultExpectation.params) {
+			mmSaveMany.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveMany.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveMany
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.SaveMany
+func (mmSaveMany *mCacheMockSaveMany) Inspect(f func(ctx context.Context, upa1 []*domain.User, d1 time.Duration)) *mCacheMockSaveMany {
+	if mmSaveMany.mock.inspectFuncSaveMany != nil {
+		mmSaveMany.mock.t.Fatalf("Inspect function is already set for CacheMock.SaveMany")
+	}
+
+	mmSaveMany.mock.inspectFuncSaveMany = f
+
+	return mmSaveMany
+}
+
+// Return sets up results that will be returned by Cache.SaveMany
+func (mmSaveMany *mCacheMockSaveMany) Return(err error) *CacheMock {
+	if mmSaveMany.mock.funcSaveMany != nil {
+		mmSaveMany.mock.t.Fatalf("CacheMock.SaveMany mock is already set by Set")
+	}
+
+	if mmSaveMany.defaultExpectation == nil {
+		mmSaveMany.defaultExpectation = &CacheMockSaveManyExpectation{mock: mmSaveMany.mock}
+	}
+	mmSaveMany.defaultExpectation.results = &CacheMockSaveManyResults{err}
+	return mmSaveMany.mock
+}
+
+// Set uses given function f to mock the Cache.SaveMany method
+func (mmSaveMany *mCacheMockSaveMany) Set(f func(ctx context.Context, upa1 []*domain.User, d1 time.Duration) (err error)) *CacheMock {
+	if mmSaveMany.defaultExpectation != nil {
+		mmSaveMany.mock.t.Fatalf("Default expectation is already set for the Cache.SaveMany method")
+	}
+
+	if len(mmSaveMany.expectations) > 0 {
+		mmSaveMany.mock.t.Fatalf("Some expectations are already set for the Cache.SaveMany method")
+	}
+
+	mmSaveMany.mock.funcSaveMany = f
+	return mmSaveMany.mock
+}
+
+// When sets expectation for the Cache.SaveMany which will trigger the result defined by the following
+// Then helper
+func (mmSaveMany *mCacheMockSaveMany) When(ctx context.Context, upa1 []*domain.User, d1 time.Duration) *CacheMockSaveManyExpectation {
+	if mmSaveMany.mock.funcSaveMany != nil {
+		mmSaveMany.mock.t.Fatalf("CacheMock.SaveMany mock is already set by Set")
+	}
+
+	expectation := &CacheMockSaveManyExpectation{
+		mock:   mmSaveMany.mock,
+		params: &CacheMockSaveManyParams{ctx, upa1, d1},
+	}
+	mmSaveMany.expectations = append(mmSaveMany.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.SaveMany return parameters for the expectation previously defined by the When method
+func (e *CacheMockSaveManyExpectation) Then(err error) *CacheMock {
+	e.results = &CacheMockSaveManyResults{err}
+	return e.mock
+}
+
+// SaveMany implements user.Cache
+func (mmSaveMany *CacheMock) SaveMany(ctx context.Context, upa1 []*domain.User, d1 time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmSaveMany.beforeSaveManyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveMany.afterSaveManyCounter, 1)
+
+	if mmSaveMany.inspectFuncSaveMany != nil {
+		mmSaveMany.inspectFuncSaveMany(ctx, upa1, d1)
+	}
+
+	mm_params := CacheMockSaveManyParams{ctx, upa1, d1}
+
+	// Record call args
+	mmSaveMany.SaveManyMock.mutex.Lock()
+	mmSaveMany.SaveManyMock.callArgs = append(mmSaveMany.SaveManyMock.callArgs, &mm_params)
+	mmSaveMany.SaveManyMock.mutex.Unlock()
+
+	for _, e := range mmSaveMany.SaveManyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveMany.SaveManyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveMany.SaveManyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveMany.SaveManyMock.defaultExpectation.params
+		mm_got := CacheMockSaveManyParams{ctx, upa1, d1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveMany.t.Errorf("CacheMock.SaveMany got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveMany.SaveManyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveMany.t.Fatal("No results are set for the CacheMock.SaveMany")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveMany.funcSaveMany != nil {
+		return mmSaveMany.funcSaveMany(ctx, upa1, d1)
+	}
+	mmSaveMany.t.Fatalf("Unexpected call to CacheMock.SaveMany. %v %v %v", ctx, upa1, d1)
+	return
+}
+
+// SaveManyAfterCounter returns a count of finished CacheMock.SaveMany invocations
+func (mmSaveMany *CacheMock) SaveManyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveMany.afterSaveManyCounter)
+}
+
+// SaveManyBeforeCounter returns a count of CacheMock.SaveMany invocations
+func (mmSaveMany *CacheMock) SaveManyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveMany.beforeSaveManyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.SaveMany.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveMany *mCacheMockSaveMany) Calls() []*CacheMockSaveManyParams {
+	mmSaveMany.mutex.RLock()
+
+	argCopy := make([]*CacheMockSaveManyParams, len(mmSaveMany.callArgs))
+	copy(argCopy, mmSaveMany.callArgs)
+
+	mmSaveMany.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveManyDone returns true if the count of the SaveMany invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockSaveManyDone() bool {
+	for _, e := range m.SaveManyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveManyCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveMany != nil && mm_atomic.LoadUint64(&m.afterSaveManyCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveManyInspect logs each unmet expectation
+func (m *CacheMock) MinimockSaveManyInspect() {
+	for _, e := range m.SaveManyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.SaveMany with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveManyCounter) < 1 {
+		if m.SaveManyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CacheMock.SaveMany")
+		} else {
+			m.t.Errorf("Expected call to CacheMock.SaveMany with params: %#v", *m.SaveManyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveMany != nil && mm_atomic.LoadUint64(&m.afterSaveManyCounter) < 1 {
+		m.t.Error("Expected call to CacheMock.SaveMany")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *CacheMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
 			m.MinimockGetByIDInspect()
 
+			m.MinimockGetManyInspect()
+
 			m.MinimockSaveInspect()
+
+			m.MinimockSaveManyInspect()
 			m.t.FailNow()
 		}
 	})
@@ -517,6 +1200,9 @@ func (m *CacheMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CacheMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockDeleteDone() &&
 		m.MinimockGetByIDDone() &&
-		m.MinimockSaveDone()
+		m.MinimockGetManyDone() &&
+		m.MinimockSaveDone() &&
+		m.MinimockSaveManyDone()
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -26,18 +27,66 @@ type RepositoryMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mRepositoryMockDelete
 
+	funcErase          func(ctx context.Context, id int64) (err error)
+	inspectFuncErase   func(ctx context.Context, id int64)
+	afterEraseCounter  uint64
+	beforeEraseCounter uint64
+	EraseMock          mRepositoryMockErase
+
 	funcGet          func(ctx context.Context, filter mm_user.SearchFilter) (up1 *domain.User, err error)
 	inspectFuncGet   func(ctx context.Context, filter mm_user.SearchFilter)
 	afterGetCounter  uint64
 	beforeGetCounter uint64
 	GetMock          mRepositoryMockGet
 
+	funcGetMany          func(ctx context.Context, ids []int64) (upa1 []*domain.User, err error)
+	inspectFuncGetMany   func(ctx context.Context, ids []int64)
+	afterGetManyCounter  uint64
+	beforeGetManyCounter uint64
+	GetManyMock          mRepositoryMockGetMany
+
+	funcList          func(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions) (upa1 []*domain.User, err error)
+	inspectFuncList   func(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRepositoryMockList
+
+	funcPurge          func(ctx context.Context, retention time.Duration) (i1 int64, err error)
+	inspectFuncPurge   func(ctx context.Context, retention time.Duration)
+	afterPurgeCounter  uint64
+	beforePurgeCounter uint64
+	PurgeMock          mRepositoryMockPurge
+
+	funcRestore          func(ctx context.Context, id int64) (err error)
+	inspectFuncRestore   func(ctx context.Context, id int64)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mRepositoryMockRestore
+
 	funcSave          func(ctx context.Context, up1 *domain.User) (err error)
 	inspectFuncSave   func(ctx context.Context, up1 *domain.User)
 	afterSaveCounter  uint64
 	beforeSaveCounter uint64
 	SaveMock          mRepositoryMockSave
 
+	funcSaveHashed          func(ctx context.Context, up1 *domain.User) (err error)
+	inspectFuncSaveHashed   func(ctx context.Context, up1 *domain.User)
+	afterSaveHashedCounter  uint64
+	beforeSaveHashedCounter uint64
+	SaveHashedMock          mRepositoryMockSaveHashed
+
+	funcSearch          func(ctx context.Context, query string, opts mm_user.SearchOptions) (sa1 []mm_user.SearchHit, err error)
+	inspectFuncSearch   func(ctx context.Context, query string, opts mm_user.SearchOptions)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mRepositoryMockSearch
+
+	funcTouchActivity          func(ctx context.Context, id int64, at time.Time) (err error)
+	inspectFuncTouchActivity   func(ctx context.Context, id int64, at time.Time)
+	afterTouchActivityCounter  uint64
+	beforeTouchActivityCounter uint64
+	TouchActivityMock          mRepositoryMockTouchActivity
+
 	funcUpdate          func(ctx context.Context, up1 *domain.User) (err error)
 	inspectFuncUpdate   func(ctx context.Context, up1 *domain.User)
 	afterUpdateCounter  uint64
@@ -56,12 +105,36 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.DeleteMock = mRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*RepositoryMockDeleteParams{}
 
+	m.EraseMock = mRepositoryMockErase{mock: m}
+	m.EraseMock.callArgs = []*RepositoryMockEraseParams{}
+
 	m.GetMock = mRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*RepositoryMockGetParams{}
 
+	m.GetManyMock = mRepositoryMockGetMany{mock: m}
+	m.GetManyMock.callArgs = []*RepositoryMockGetManyParams{}
+
+	m.ListMock = mRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RepositoryMockListParams{}
+
+	m.PurgeMock = mRepositoryMockPurge{mock: m}
+	m.PurgeMock.callArgs = []*RepositoryMockPurgeParams{}
+
+	m.RestoreMock = mRepositoryMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*RepositoryMockRestoreParams{}
+
 	m.SaveMock = mRepositoryMockSave{mock: m}
 	m.SaveMock.callArgs = []*RepositoryMockSaveParams{}
 
+	m.SaveHashedMock = mRepositoryMockSaveHashed{mock: m}
+	m.SaveHashedMock.callArgs = []*RepositoryMockSaveHashedParams{}
+
+	m.SearchMock = mRepositoryMockSearch{mock: m}
+	m.SearchMock.callArgs = []*RepositoryMockSearchParams{}
+
+	m.TouchActivityMock = mRepositoryMockTouchActivity{mock: m}
+	m.TouchActivityMock.callArgs = []*RepositoryMockTouchActivityParams{}
+
 	m.UpdateMock = mRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*RepositoryMockUpdateParams{}
 
@@ -286,6 +359,222 @@ func (m *RepositoryMock) MinimockDeleteInspect() {
 	}
 }
 
+type mRepositoryMockErase struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockEraseExpectation
+	expectations       []*RepositoryMockEraseExpectation
+
+	callArgs []*RepositoryMockEraseParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockEraseExpectation specifies expectation struct of the Repository.Erase
+type RepositoryMockEraseExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockEraseParams
+	results *RepositoryMockEraseResults
+	Counter uint64
+}
+
+// RepositoryMockEraseParams contains parameters of the Repository.Erase
+type RepositoryMockEraseParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// RepositoryMockEraseResults contains results of the Repository.Erase
+type RepositoryMockEraseResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.Erase
+func (mmErase *mRepositoryMockErase) Expect(ctx context.Context, id int64) *mRepositoryMockErase {
+	if mmErase.mock.funcErase != nil {
+		mmErase.mock.t.Fatalf("RepositoryMock.Erase mock is already set by Set")
+	}
+
+	if mmErase.defaultExpectation == nil {
+		mmErase.defaultExpectation = &RepositoryMockEraseExpectation{}
+	}
+
+	mmErase.defaultExpectation.params = &RepositoryMockEraseParams{ctx, id}
+	for _, e := range mmErase.expectations {
+		if minimock.Equal(e.params, mmErase.defaultExpectation.params) {
+			mmErase.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmErase.defaultExpectation.params)
+		}
+	}
+
+	return mmErase
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Erase
+func (mmErase *mRepositoryMockErase) Inspect(f func(ctx context.Context, id int64)) *mRepositoryMockErase {
+	if mmErase.mock.inspectFuncErase != nil {
+		mmErase.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Erase")
+	}
+
+	mmErase.mock.inspectFuncErase = f
+
+	return mmErase
+}
+
+// Return sets up results that will be returned by Repository.Erase
+func (mmErase *mRepositoryMockErase) Return(err error) *RepositoryMock {
+	if mmErase.mock.funcErase != nil {
+		mmErase.mock.t.Fatalf("RepositoryMock.Erase mock is already set by Set")
+	}
+
+	if mmErase.defaultExpectation == nil {
+		mmErase.defaultExpectation = &RepositoryMockEraseExpectation{mock: mmErase.mock}
+	}
+	mmErase.defaultExpectation.results = &RepositoryMockEraseResults{err}
+	return mmErase.mock
+}
+
+// Set uses given function f to mock the Repository.Erase method
+func (mmErase *mRepositoryMockErase) Set(f func(ctx context.Context, id int64) (err error)) *RepositoryMock {
+	if mmErase.defaultExpectation != nil {
+		mmErase.mock.t.Fatalf("Default expectation is already set for the Repository.Erase method")
+	}
+
+	if len(mmErase.expectations) > 0 {
+		mmErase.mock.t.Fatalf("Some expectations are already set for the Repository.Erase method")
+	}
+
+	mmErase.mock.funcErase = f
+	return mmErase.mock
+}
+
+// When sets expectation for the Repository.Erase which will trigger the result defined by the following
+// Then helper
+func (mmErase *mRepositoryMockErase) When(ctx context.Context, id int64) *RepositoryMockEraseExpectation {
+	if mmErase.mock.funcErase != nil {
+		mmErase.mock.t.Fatalf("RepositoryMock.Erase mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockEraseExpectation{
+		mock:   mmErase.mock,
+		params: &RepositoryMockEraseParams{ctx, id},
+	}
+	mmErase.expectations = append(mmErase.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Erase return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockEraseExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockEraseResults{err}
+	return e.mock
+}
+
+// Erase implements user.Repository
+func (mmErase *RepositoryMock) Erase(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmErase.beforeEraseCounter, 1)
+	defer mm_atomic.AddUint64(&mmErase.afterEraseCounter, 1)
+
+	if mmErase.inspectFuncErase != nil {
+		mmErase.inspectFuncErase(ctx, id)
+	}
+
+	mm_params := RepositoryMockEraseParams{ctx, id}
+
+	// Record call args
+	mmErase.EraseMock.mutex.Lock()
+	mmErase.EraseMock.callArgs = append(mmErase.EraseMock.callArgs, &mm_params)
+	mmErase.EraseMock.mutex.Unlock()
+
+	for _, e := range mmErase.EraseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmErase.EraseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmErase.EraseMock.defaultExpectation.Counter, 1)
+		mm_want := mmErase.EraseMock.defaultExpectation.params
+		mm_got := RepositoryMockEraseParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmErase.t.Errorf("RepositoryMock.Erase got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmErase.EraseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmErase.t.Fatal("No results are set for the RepositoryMock.Erase")
+		}
+		return (*mm_results).err
+	}
+	if mmErase.funcErase != nil {
+		return mmErase.funcErase(ctx, id)
+	}
+	mmErase.t.Fatalf("Unexpected call to RepositoryMock.Erase. %v %v", ctx, id)
+	return
+}
+
+// EraseAfterCounter returns a count of finished RepositoryMock.Erase invocations
+func (mmErase *RepositoryMock) EraseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmErase.afterEraseCounter)
+}
+
+// EraseBeforeCounter returns a count of RepositoryMock.Erase invocations
+func (mmErase *RepositoryMock) EraseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmErase.beforeEraseCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Erase.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmErase *mRepositoryMockErase) Calls() []*RepositoryMockEraseParams {
+	mmErase.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockEraseParams, len(mmErase.callArgs))
+	copy(argCopy, mmErase.callArgs)
+
+	mmErase.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEraseDone returns true if the count of the Erase invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockEraseDone() bool {
+	for _, e := range m.EraseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EraseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEraseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcErase != nil && mm_atomic.LoadUint64(&m.afterEraseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEraseInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockEraseInspect() {
+	for _, e := range m.EraseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Erase with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EraseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEraseCounter) < 1 {
+		if m.EraseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.Erase")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Erase with params: %#v", *m.EraseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcErase != nil && mm_atomic.LoadUint64(&m.afterEraseCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.Erase")
+	}
+}
+
 type mRepositoryMockGet struct {
 	mock               *RepositoryMock
 	defaultExpectation *RepositoryMockGetExpectation
@@ -503,258 +792,1777 @@ func (m *RepositoryMock) MinimockGetInspect() {
 	}
 }
 
-type mRepositoryMockSave struct {
+type mRepositoryMockGetMany struct {
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockSaveExpectation
-	expectations       []*RepositoryMockSaveExpectation
+	defaultExpectation *RepositoryMockGetManyExpectation
+	expectations       []*RepositoryMockGetManyExpectation
 
-	callArgs []*RepositoryMockSaveParams
+	callArgs []*RepositoryMockGetManyParams
 	mutex    sync.RWMutex
 }
 
-// RepositoryMockSaveExpectation specifies expectation struct of the Repository.Save
-type RepositoryMockSaveExpectation struct {
+// RepositoryMockGetManyExpectation specifies expectation struct of the Repository.GetMany
+type RepositoryMockGetManyExpectation struct {
 	mock    *RepositoryMock
-	params  *RepositoryMockSaveParams
-	results *RepositoryMockSaveResults
+	params  *RepositoryMockGetManyParams
+	results *RepositoryMockGetManyResults
 	Counter uint64
 }
 
-// RepositoryMockSaveParams contains parameters of the Repository.Save
-type RepositoryMockSaveParams struct {
+// RepositoryMockGetManyParams contains parameters of the Repository.GetMany
+type RepositoryMockGetManyParams struct {
 	ctx context.Context
-	up1 *domain.User
+	ids []int64
 }
 
-// RepositoryMockSaveResults contains results of the Repository.Save
-type RepositoryMockSaveResults struct {
-	err error
+// RepositoryMockGetManyResults contains results of the Repository.GetMany
+type RepositoryMockGetManyResults struct {
+	upa1 []*domain.User
+	err  error
 }
 
-// Expect sets up expected params for Repository.Save
-func (mmSave *mRepositoryMockSave) Expect(ctx context.Context, up1 *domain.User) *mRepositoryMockSave {
-	if mmSave.mock.funcSave != nil {
-		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+// Expect sets up expected params for Repository.GetMany
+func (mmGetMany *mRepositoryMockGetMany) Expect(ctx context.Context, ids []int64) *mRepositoryMockGetMany {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("RepositoryMock.GetMany mock is already set by Set")
 	}
 
-	if mmSave.defaultExpectation == nil {
-		mmSave.defaultExpectation = &RepositoryMockSaveExpectation{}
+	if mmGetMany.defaultExpectation == nil {
+		mmGetMany.defaultExpectation = &RepositoryMockGetManyExpectation{}
 	}
 
-	mmSave.defaultExpectation.params = &RepositoryMockSaveParams{ctx, up1}
-	for _, e := range mmSave.expectations {
-		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
-			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+	mmGetMany.defaultExpectation.params = &RepositoryMockGetManyParams{ctx, ids}
+	for _, e := range mmGetMany.expectations {
+		if minimock.Equal(e.params, mmGetMany.defaultExpectation.params) {
+			mmGetMany.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMany.defaultExpectation.params)
 		}
 	}
 
-	return mmSave
+	return mmGetMany
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.Save
-func (mmSave *mRepositoryMockSave) Inspect(f func(ctx context.Context, up1 *domain.User)) *mRepositoryMockSave {
-	if mmSave.mock.inspectFuncSave != nil {
-		mmSave.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Save")
+// Inspect accepts an inspector function that has same arguments as the Repository.GetMany
+func (mmGetMany *mRepositoryMockGetMany) Inspect(f func(ctx context.Context, ids []int64)) *mRepositoryMockGetMany {
+	if mmGetMany.mock.inspectFuncGetMany != nil {
+		mmGetMany.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetMany")
 	}
 
-	mmSave.mock.inspectFuncSave = f
+	mmGetMany.mock.inspectFuncGetMany = f
 
-	return mmSave
+	return mmGetMany
 }
 
-// Return sets up results that will be returned by Repository.Save
-func (mmSave *mRepositoryMockSave) Return(err error) *RepositoryMock {
-	if mmSave.mock.funcSave != nil {
-		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+// Return sets up results that will be returned by Repository.GetMany
+func (mmGetMany *mRepositoryMockGetMany) Return(upa1 []*domain.User, err error) *RepositoryMock {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("RepositoryMock.GetMany mock is already set by Set")
 	}
 
-	if mmSave.defaultExpectation == nil {
-		mmSave.defaultExpectation = &RepositoryMockSaveExpectation{mock: mmSave.mock}
+	if mmGetMany.defaultExpectation == nil {
+		mmGetMany.defaultExpectation = &RepositoryMockGetManyExpectation{mock: mmGetMany.mock}
 	}
-	mmSave.defaultExpectation.results = &RepositoryMockSaveResults{err}
-	return mmSave.mock
+	mmGetMany.defaultExpectation.results = &RepositoryMockGetManyResults{upa1, err}
+	return mmGetMany.mock
 }
 
-// Set uses given function f to mock the Repository.Save method
-func (mmSave *mRepositoryMockSave) Set(f func(ctx context.Context, up1 *domain.User) (err error)) *RepositoryMock {
-	if mmSave.defaultExpectation != nil {
-		mmSave.mock.t.Fatalf("Default expectation is already set for the Repository.Save method")
+// Set uses given function f to mock the Repository.GetMany method
+func (mmGetMany *mRepositoryMockGetMany) Set(f func(ctx context.Context, ids []int64) (upa1 []*domain.User, err error)) *RepositoryMock {
+	if mmGetMany.defaultExpectation != nil {
+		mmGetMany.mock.t.Fatalf("Default expectation is already set for the Repository.GetMany method")
 	}
 
-	if len(mmSave.expectations) > 0 {
-		mmSave.mock.t.Fatalf("Some expectations are already set for the Repository.Save method")
+	if len(mmGetMany.expectations) > 0 {
+		mmGetMany.mock.t.Fatalf("Some expectations are already set for the Repository.GetMany method")
 	}
 
-	mmSave.mock.funcSave = f
-	return mmSave.mock
+	mmGetMany.mock.funcGetMany = f
+	return mmGetMany.mock
 }
 
-// When sets expectation for the Repository.Save which will trigger the result defined by the following
+// When sets expectation for the Repository.GetMany which will trigger the result defined by the following
 // Then helper
-func (mmSave *mRepositoryMockSave) When(ctx context.Context, up1 *domain.User) *RepositoryMockSaveExpectation {
-	if mmSave.mock.funcSave != nil {
-		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+func (mmGetMany *mRepositoryMockGetMany) When(ctx context.Context, ids []int64) *RepositoryMockGetManyExpectation {
+	if mmGetMany.mock.funcGetMany != nil {
+		mmGetMany.mock.t.Fatalf("RepositoryMock.GetMany mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockSaveExpectation{
-		mock:   mmSave.mock,
-		params: &RepositoryMockSaveParams{ctx, up1},
+	expectation := &RepositoryMockGetManyExpectation{
+		mock:   mmGetMany.mock,
+		params: &RepositoryMockGetManyParams{ctx, ids},
 	}
-	mmSave.expectations = append(mmSave.expectations, expectation)
+	mmGetMany.expectations = append(mmGetMany.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.Save return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockSaveExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockSaveResults{err}
+// Then sets up Repository.GetMany return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetManyExpectation) Then(upa1 []*domain.User, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetManyResults{upa1, err}
 	return e.mock
 }
 
-// Save implements user.Repository
-func (mmSave *RepositoryMock) Save(ctx context.Context, up1 *domain.User) (err error) {
-	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
-	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+// GetMany implements user.Repository
+func (mmGetMany *RepositoryMock) GetMany(ctx context.Context, ids []int64) (upa1 []*domain.User, err error) {
+	mm_atomic.AddUint64(&mmGetMany.beforeGetManyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMany.afterGetManyCounter, 1)
 
-	if mmSave.inspectFuncSave != nil {
-		mmSave.inspectFuncSave(ctx, up1)
+	if mmGetMany.inspectFuncGetMany != nil {
+		mmGetMany.inspectFuncGetMany(ctx, ids)
 	}
 
-	mm_params := RepositoryMockSaveParams{ctx, up1}
+	mm_params := RepositoryMockGetManyParams{ctx, ids}
 
 	// Record call args
-	mmSave.SaveMock.mutex.Lock()
-	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
-	mmSave.SaveMock.mutex.Unlock()
+	mmGetMany.GetManyMock.mutex.Lock()
+	mmGetMany.GetManyMock.callArgs = append(mmGetMany.GetManyMock.callArgs, &mm_params)
+	mmGetMany.GetManyMock.mutex.Unlock()
 
-	for _, e := range mmSave.SaveMock.expectations {
+	for _, e := range mmGetMany.GetManyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.upa1, e.results.err
 		}
 	}
 
-	if mmSave.SaveMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
-		mm_want := mmSave.SaveMock.defaultExpectation.params
-		mm_got := RepositoryMockSaveParams{ctx, up1}
+	if mmGetMany.GetManyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMany.GetManyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMany.GetManyMock.defaultExpectation.params
+		mm_got := RepositoryMockGetManyParams{ctx, ids}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSave.t.Errorf("RepositoryMock.Save got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetMany.t.Errorf("RepositoryMock.GetMany got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSave.SaveMock.defaultExpectation.results
+		mm_results := mmGetMany.GetManyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSave.t.Fatal("No results are set for the RepositoryMock.Save")
+			mmGetMany.t.Fatal("No results are set for the RepositoryMock.GetMany")
 		}
-		return (*mm_results).err
+		return (*mm_results).upa1, (*mm_results).err
 	}
-	if mmSave.funcSave != nil {
-		return mmSave.funcSave(ctx, up1)
+	if mmGetMany.funcGetMany != nil {
+		return mmGetMany.funcGetMany(ctx, ids)
 	}
-	mmSave.t.Fatalf("Unexpected call to RepositoryMock.Save. %v %v", ctx, up1)
+	mmGetMany.t.Fatalf("Unexpected call to RepositoryMock.GetMany. %v %v", ctx, ids)
 	return
 }
 
-// SaveAfterCounter returns a count of finished RepositoryMock.Save invocations
-func (mmSave *RepositoryMock) SaveAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+// GetManyAfterCounter returns a count of finished RepositoryMock.GetMany invocations
+func (mmGetMany *RepositoryMock) GetManyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMany.afterGetManyCounter)
 }
 
-// SaveBeforeCounter returns a count of RepositoryMock.Save invocations
-func (mmSave *RepositoryMock) SaveBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+// GetManyBeforeCounter returns a count of RepositoryMock.GetMany invocations
+func (mmGetMany *RepositoryMock) GetManyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMany.beforeGetManyCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.Save.
+// Calls returns a list of arguments used in each call to RepositoryMock.GetMany.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSave *mRepositoryMockSave) Calls() []*RepositoryMockSaveParams {
-	mmSave.mutex.RLock()
+func (mmGetMany *mRepositoryMockGetMany) Calls() []*RepositoryMockGetManyParams {
+	mmGetMany.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockSaveParams, len(mmSave.callArgs))
-	copy(argCopy, mmSave.callArgs)
+	argCopy := make([]*RepositoryMockGetManyParams, len(mmGetMany.callArgs))
+	copy(argCopy, mmGetMany.callArgs)
 
-	mmSave.mutex.RUnlock()
+	mmGetMany.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// MinimockGetManyDone returns true if the count of the GetMany invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockSaveDone() bool {
-	for _, e := range m.SaveMock.expectations {
+func (m *RepositoryMock) MinimockGetManyDone() bool {
+	for _, e := range m.GetManyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+	if m.GetManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSave != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+	if m.funcGetMany != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSaveInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockSaveInspect() {
-	for _, e := range m.SaveMock.expectations {
+// MinimockGetManyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetManyInspect() {
+	for _, e := range m.GetManyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.Save with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetMany with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
-		if m.SaveMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.Save")
+	if m.GetManyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		if m.GetManyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetMany")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.Save with params: %#v", *m.SaveMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetMany with params: %#v", *m.GetManyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSave != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
-		m.t.Error("Expected call to RepositoryMock.Save")
+	if m.funcGetMany != nil && mm_atomic.LoadUint64(&m.afterGetManyCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetMany")
 	}
 }
 
-type mRepositoryMockUpdate struct {
+type mRepositoryMockList struct {
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateExpectation
-	expectations       []*RepositoryMockUpdateExpectation
+	defaultExpectation *RepositoryMockListExpectation
+	expectations       []*RepositoryMockListExpectation
 
-	callArgs []*RepositoryMockUpdateParams
+	callArgs []*RepositoryMockListParams
 	mutex    sync.RWMutex
 }
 
-// RepositoryMockUpdateExpectation specifies expectation struct of the Repository.Update
-type RepositoryMockUpdateExpectation struct {
+// RepositoryMockListExpectation specifies expectation struct of the Repository.List
+type RepositoryMockListExpectation struct {
 	mock    *RepositoryMock
-	params  *RepositoryMockUpdateParams
-	results *RepositoryMockUpdateResults
+	params  *RepositoryMockListParams
+	results *RepositoryMockListResults
 	Counter uint64
 }
 
-// RepositoryMockUpdateParams contains parameters of the Repository.Update
-type RepositoryMockUpdateParams struct {
-	ctx context.Context
-	up1 *domain.User
+// RepositoryMockListParams contains parameters of the Repository.List
+type RepositoryMockListParams struct {
+	ctx    context.Context
+	filter mm_user.SearchFilter
+	opts   mm_user.ListOptions
 }
 
-// RepositoryMockUpdateResults contains results of the Repository.Update
-type RepositoryMockUpdateResults struct {
-	err error
+// RepositoryMockListResults contains results of the Repository.List
+type RepositoryMockListResults struct {
+	upa1 []*domain.User
+	err  error
 }
 
-// Expect sets up expected params for Repository.Update
-func (mmUpdate *mRepositoryMockUpdate) Expect(ctx context.Context, up1 *domain.User) *mRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("RepositoryMock.Update mock is already set by Set")
+// Expect sets up expected params for Repository.List
+func (mmList *mRepositoryMockList) Expect(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &RepositoryMockUpdateExpectation{}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	mmList.defaultExpectation.params = &RepositoryMockListParams{ctx, filter, opts}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.List
+func (mmList *mRepositoryMockList) Inspect(f func(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions)) *mRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Repository.List
+func (mmList *mRepositoryMockList) Return(upa1 []*domain.User, err error) *RepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RepositoryMockListResults{upa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Repository.List method
+func (mmList *mRepositoryMockList) Set(f func(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions) (upa1 []*domain.User, err error)) *RepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Repository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Repository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the Repository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRepositoryMockList) When(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions) *RepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &RepositoryMockListParams{ctx, filter, opts},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.List return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListExpectation) Then(upa1 []*domain.User, err error) *RepositoryMock {
+	e.results = &RepositoryMockListResults{upa1, err}
+	return e.mock
+}
+
+// List implements user.Repository
+func (mmList *RepositoryMock) List(ctx context.Context, filter mm_user.SearchFilter, opts mm_user.ListOptions) (upa1 []*domain.User, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter, opts)
+	}
+
+	mm_params := RepositoryMockListParams{ctx, filter, opts}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_got := RepositoryMockListParams{ctx, filter, opts}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RepositoryMock.List")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter, opts)
+	}
+	mmList.t.Fatalf("Unexpected call to RepositoryMock.List. %v %v %v", ctx, filter, opts)
+	return
+}
+
+// ListAfterCounter returns a count of finished RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRepositoryMockList) Calls() []*RepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDone() bool {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.List")
+	}
+}
+
+type mRepositoryMockPurge struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockPurgeExpectation
+	expectations       []*RepositoryMockPurgeExpectation
+
+	callArgs []*RepositoryMockPurgeParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockPurgeExpectation specifies expectation struct of the Repository.Purge
+type RepositoryMockPurgeExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockPurgeParams
+	results *RepositoryMockPurgeResults
+	Counter uint64
+}
+
+// RepositoryMockPurgeParams contains parameters of the Repository.Purge
+type RepositoryMockPurgeParams struct {
+	ctx       context.Context
+	retention time.Duration
+}
+
+// RepositoryMockPurgeResults contains results of the Repository.Purge
+type RepositoryMockPurgeResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Repository.Purge
+func (mmPurge *mRepositoryMockPurge) Expect(ctx context.Context, retention time.Duration) *mRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("RepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &RepositoryMockPurgeExpectation{}
+	}
+
+	mmPurge.defaultExpectation.params = &RepositoryMockPurgeParams{ctx, retention}
+	for _, e := range mmPurge.expectations {
+		if minimock.Equal(e.params, mmPurge.defaultExpectation.params) {
+			mmPurge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurge.defaultExpectation.params)
+		}
+	}
+
+	return mmPurge
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Purge
+func (mmPurge *mRepositoryMockPurge) Inspect(f func(ctx context.Context, retention time.Duration)) *mRepositoryMockPurge {
+	if mmPurge.mock.inspectFuncPurge != nil {
+		mmPurge.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Purge")
+	}
+
+	mmPurge.mock.inspectFuncPurge = f
+
+	return mmPurge
+}
+
+// Return sets up results that will be returned by Repository.Purge
+func (mmPurge *mRepositoryMockPurge) Return(i1 int64, err error) *RepositoryMock {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("RepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &RepositoryMockPurgeExpectation{mock: mmPurge.mock}
+	}
+	mmPurge.defaultExpectation.results = &RepositoryMockPurgeResults{i1, err}
+	return mmPurge.mock
+}
+
+// Set uses given function f to mock the Repository.Purge method
+func (mmPurge *mRepositoryMockPurge) Set(f func(ctx context.Context, retention time.Duration) (i1 int64, err error)) *RepositoryMock {
+	if mmPurge.defaultExpectation != nil {
+		mmPurge.mock.t.Fatalf("Default expectation is already set for the Repository.Purge method")
+	}
+
+	if len(mmPurge.expectations) > 0 {
+		mmPurge.mock.t.Fatalf("Some expectations are already set for the Repository.Purge method")
+	}
+
+	mmPurge.mock.funcPurge = f
+	return mmPurge.mock
+}
+
+// When sets expectation for the Repository.Purge which will trigger the result defined by the following
+// Then helper
+func (mmPurge *mRepositoryMockPurge) When(ctx context.Context, retention time.Duration) *RepositoryMockPurgeExpectation {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("RepositoryMock.Purge mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockPurgeExpectation{
+		mock:   mmPurge.mock,
+		params: &RepositoryMockPurgeParams{ctx, retention},
+	}
+	mmPurge.expectations = append(mmPurge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Purge return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockPurgeExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockPurgeResults{i1, err}
+	return e.mock
+}
+
+// Purge implements user.Repository
+func (mmPurge *RepositoryMock) Purge(ctx context.Context, retention time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurge.beforePurgeCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurge.afterPurgeCounter, 1)
+
+	if mmPurge.inspectFuncPurge != nil {
+		mmPurge.inspectFuncPurge(ctx, retention)
+	}
+
+	mm_params := RepositoryMockPurgeParams{ctx, retention}
+
+	// Record call args
+	mmPurge.PurgeMock.mutex.Lock()
+	mmPurge.PurgeMock.callArgs = append(mmPurge.PurgeMock.callArgs, &mm_params)
+	mmPurge.PurgeMock.mutex.Unlock()
+
+	for _, e := range mmPurge.PurgeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurge.PurgeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurge.PurgeMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurge.PurgeMock.defaultExpectation.params
+		mm_got := RepositoryMockPurgeParams{ctx, retention}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurge.t.Errorf("RepositoryMock.Purge got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurge.PurgeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurge.t.Fatal("No results are set for the RepositoryMock.Purge")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurge.funcPurge != nil {
+		return mmPurge.funcPurge(ctx, retention)
+	}
+	mmPurge.t.Fatalf("Unexpected call to RepositoryMock.Purge. %v %v", ctx, retention)
+	return
+}
+
+// PurgeAfterCounter returns a count of finished RepositoryMock.Purge invocations
+func (mmPurge *RepositoryMock) PurgeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.afterPurgeCounter)
+}
+
+// PurgeBeforeCounter returns a count of RepositoryMock.Purge invocations
+func (mmPurge *RepositoryMock) PurgeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.beforePurgeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Purge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurge *mRepositoryMockPurge) Calls() []*RepositoryMockPurgeParams {
+	mmPurge.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockPurgeParams, len(mmPurge.callArgs))
+	copy(argCopy, mmPurge.callArgs)
+
+	mmPurge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDone returns true if the count of the Purge invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockPurgeDone() bool {
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPurgeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurge != nil && mm_atomic.LoadUint64(&m.afterPurgeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPurgeInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockPurgeInspect() {
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Purge with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPurgeCounter) < 1 {
+		if m.PurgeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.Purge")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Purge with params: %#v", *m.PurgeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurge != nil && mm_atomic.LoadUint64(&m.afterPurgeCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.Purge")
+	}
+}
+
+type mRepositoryMockRestore struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockRestoreExpectation
+	expectations       []*RepositoryMockRestoreExpectation
+
+	callArgs []*RepositoryMockRestoreParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockRestoreExpectation specifies expectation struct of the Repository.Restore
+type RepositoryMockRestoreExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockRestoreParams
+	results *RepositoryMockRestoreResults
+	Counter uint64
+}
+
+// RepositoryMockRestoreParams contains parameters of the Repository.Restore
+type RepositoryMockRestoreParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// RepositoryMockRestoreResults contains results of the Repository.Restore
+type RepositoryMockRestoreResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.Restore
+func (mmRestore *mRepositoryMockRestore) Expect(ctx context.Context, id int64) *mRepositoryMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("RepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &RepositoryMockRestoreExpectation{}
+	}
+
+	mmRestore.defaultExpectation.params = &RepositoryMockRestoreParams{ctx, id}
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Restore
+func (mmRestore *mRepositoryMockRestore) Inspect(f func(ctx context.Context, id int64)) *mRepositoryMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by Repository.Restore
+func (mmRestore *mRepositoryMockRestore) Return(err error) *RepositoryMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("RepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &RepositoryMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &RepositoryMockRestoreResults{err}
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the Repository.Restore method
+func (mmRestore *mRepositoryMockRestore) Set(f func(ctx context.Context, id int64) (err error)) *RepositoryMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the Repository.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the Repository.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	return mmRestore.mock
+}
+
+// When sets expectation for the Repository.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mRepositoryMockRestore) When(ctx context.Context, id int64) *RepositoryMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("RepositoryMock.Restore mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockRestoreExpectation{
+		mock:   mmRestore.mock,
+		params: &RepositoryMockRestoreParams{ctx, id},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Restore return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockRestoreExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockRestoreResults{err}
+	return e.mock
+}
+
+// Restore implements user.Repository
+func (mmRestore *RepositoryMock) Restore(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, id)
+	}
+
+	mm_params := RepositoryMockRestoreParams{ctx, id}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_got := RepositoryMockRestoreParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("RepositoryMock.Restore got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the RepositoryMock.Restore")
+		}
+		return (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, id)
+	}
+	mmRestore.t.Fatalf("Unexpected call to RepositoryMock.Restore. %v %v", ctx, id)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished RepositoryMock.Restore invocations
+func (mmRestore *RepositoryMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of RepositoryMock.Restore invocations
+func (mmRestore *RepositoryMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mRepositoryMockRestore) Calls() []*RepositoryMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockRestoreDone() bool {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Restore with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.Restore")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Restore with params: %#v", *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.Restore")
+	}
+}
+
+type mRepositoryMockSave struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSaveExpectation
+	expectations       []*RepositoryMockSaveExpectation
+
+	callArgs []*RepositoryMockSaveParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockSaveExpectation specifies expectation struct of the Repository.Save
+type RepositoryMockSaveExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockSaveParams
+	results *RepositoryMockSaveResults
+	Counter uint64
+}
+
+// RepositoryMockSaveParams contains parameters of the Repository.Save
+type RepositoryMockSaveParams struct {
+	ctx context.Context
+	up1 *domain.User
+}
+
+// RepositoryMockSaveResults contains results of the Repository.Save
+type RepositoryMockSaveResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.Save
+func (mmSave *mRepositoryMockSave) Expect(ctx context.Context, up1 *domain.User) *mRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &RepositoryMockSaveExpectation{}
+	}
+
+	mmSave.defaultExpectation.params = &RepositoryMockSaveParams{ctx, up1}
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Save
+func (mmSave *mRepositoryMockSave) Inspect(f func(ctx context.Context, up1 *domain.User)) *mRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by Repository.Save
+func (mmSave *mRepositoryMockSave) Return(err error) *RepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &RepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &RepositoryMockSaveResults{err}
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the Repository.Save method
+func (mmSave *mRepositoryMockSave) Set(f func(ctx context.Context, up1 *domain.User) (err error)) *RepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the Repository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the Repository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	return mmSave.mock
+}
+
+// When sets expectation for the Repository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mRepositoryMockSave) When(ctx context.Context, up1 *domain.User) *RepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("RepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSaveExpectation{
+		mock:   mmSave.mock,
+		params: &RepositoryMockSaveParams{ctx, up1},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Save return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSaveExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Save implements user.Repository
+func (mmSave *RepositoryMock) Save(ctx context.Context, up1 *domain.User) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, up1)
+	}
+
+	mm_params := RepositoryMockSaveParams{ctx, up1}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_got := RepositoryMockSaveParams{ctx, up1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("RepositoryMock.Save got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the RepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, up1)
+	}
+	mmSave.t.Fatalf("Unexpected call to RepositoryMock.Save. %v %v", ctx, up1)
+	return
+}
+
+// SaveAfterCounter returns a count of finished RepositoryMock.Save invocations
+func (mmSave *RepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of RepositoryMock.Save invocations
+func (mmSave *RepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mRepositoryMockSave) Calls() []*RepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSaveDone() bool {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Save with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.Save")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Save with params: %#v", *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && mm_atomic.LoadUint64(&m.afterSaveCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.Save")
+	}
+}
+
+type mRepositoryMockSaveHashed struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSaveHashedExpectation
+	expectations       []*RepositoryMockSaveHashedExpectation
+
+	callArgs []*RepositoryMockSaveHashedParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockSaveHashedExpectation specifies expectation struct of the Repository.SaveHashed
+type RepositoryMockSaveHashedExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockSaveHashedParams
+	results *RepositoryMockSaveHashedResults
+	Counter uint64
+}
+
+// RepositoryMockSaveHashedParams contains parameters of the Repository.SaveHashed
+type RepositoryMockSaveHashedParams struct {
+	ctx context.Context
+	up1 *domain.User
+}
+
+// RepositoryMockSaveHashedResults contains results of the Repository.SaveHashed
+type RepositoryMockSaveHashedResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.SaveHashed
+func (mmSaveHashed *mRepositoryMockSaveHashed) Expect(ctx context.Context, up1 *domain.User) *mRepositoryMockSaveHashed {
+	if mmSaveHashed.mock.funcSaveHashed != nil {
+		mmSaveHashed.mock.t.Fatalf("RepositoryMock.SaveHashed mock is already set by Set")
+	}
+
+	if mmSaveHashed.defaultExpectation == nil {
+		mmSaveHashed.defaultExpectation = &RepositoryMockSaveHashedExpectation{}
+	}
+
+	mmSaveHashed.defaultExpectation.params = &RepositoryMockSaveHashedParams{ctx, up1}
+	for _, e := range mmSaveHashed.expectations {
+		if minimock.Equal(e.params, mmSaveHashed.defaultExpectation.params) {
+			mmSaveHashed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveHashed.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveHashed
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SaveHashed
+func (mmSaveHashed *mRepositoryMockSaveHashed) Inspect(f func(ctx context.Context, up1 *domain.User)) *mRepositoryMockSaveHashed {
+	if mmSaveHashed.mock.inspectFuncSaveHashed != nil {
+		mmSaveHashed.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SaveHashed")
+	}
+
+	mmSaveHashed.mock.inspectFuncSaveHashed = f
+
+	return mmSaveHashed
+}
+
+// Return sets up results that will be returned by Repository.SaveHashed
+func (mmSaveHashed *mRepositoryMockSaveHashed) Return(err error) *RepositoryMock {
+	if mmSaveHashed.mock.funcSaveHashed != nil {
+		mmSaveHashed.mock.t.Fatalf("RepositoryMock.SaveHashed mock is already set by Set")
+	}
+
+	if mmSaveHashed.defaultExpectation == nil {
+		mmSaveHashed.defaultExpectation = &RepositoryMockSaveHashedExpectation{mock: mmSaveHashed.mock}
+	}
+	mmSaveHashed.defaultExpectation.results = &RepositoryMockSaveHashedResults{err}
+	return mmSaveHashed.mock
+}
+
+// Set uses given function f to mock the Repository.SaveHashed method
+func (mmSaveHashed *mRepositoryMockSaveHashed) Set(f func(ctx context.Context, up1 *domain.User) (err error)) *RepositoryMock {
+	if mmSaveHashed.defaultExpectation != nil {
+		mmSaveHashed.mock.t.Fatalf("Default expectation is already set for the Repository.SaveHashed method")
+	}
+
+	if len(mmSaveHashed.expectations) > 0 {
+		mmSaveHashed.mock.t.Fatalf("Some expectations are already set for the Repository.SaveHashed method")
+	}
+
+	mmSaveHashed.mock.funcSaveHashed = f
+	return mmSaveHashed.mock
+}
+
+// When sets expectation for the Repository.SaveHashed which will trigger the result defined by the following
+// Then helper
+func (mmSaveHashed *mRepositoryMockSaveHashed) When(ctx context.Context, up1 *domain.User) *RepositoryMockSaveHashedExpectation {
+	if mmSaveHashed.mock.funcSaveHashed != nil {
+		mmSaveHashed.mock.t.Fatalf("RepositoryMock.SaveHashed mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSaveHashedExpectation{
+		mock:   mmSaveHashed.mock,
+		params: &RepositoryMockSaveHashedParams{ctx, up1},
+	}
+	mmSaveHashed.expectations = append(mmSaveHashed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SaveHashed return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSaveHashedExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSaveHashedResults{err}
+	return e.mock
+}
+
+// SaveHashed implements user.Repository
+func (mmSaveHashed *RepositoryMock) SaveHashed(ctx context.Context, up1 *domain.User) (err error) {
+	mm_atomic.AddUint64(&mmSaveHashed.beforeSaveHashedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveHashed.afterSaveHashedCounter, 1)
+
+	if mmSaveHashed.inspectFuncSaveHashed != nil {
+		mmSaveHashed.inspectFuncSaveHashed(ctx, up1)
+	}
+
+	mm_params := RepositoryMockSaveHashedParams{ctx, up1}
+
+	// Record call args
+	mmSaveHashed.SaveHashedMock.mutex.Lock()
+	mmSaveHashed.SaveHashedMock.callArgs = append(mmSaveHashed.SaveHashedMock.callArgs, &mm_params)
+	mmSaveHashed.SaveHashedMock.mutex.Unlock()
+
+	for _, e := range mmSaveHashed.SaveHashedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveHashed.SaveHashedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveHashed.SaveHashedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveHashed.SaveHashedMock.defaultExpectation.params
+		mm_got := RepositoryMockSaveHashedParams{ctx, up1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveHashed.t.Errorf("RepositoryMock.SaveHashed got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveHashed.SaveHashedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveHashed.t.Fatal("No results are set for the RepositoryMock.SaveHashed")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveHashed.funcSaveHashed != nil {
+		return mmSaveHashed.funcSaveHashed(ctx, up1)
+	}
+	mmSaveHashed.t.Fatalf("Unexpected call to RepositoryMock.SaveHashed. %v %v", ctx, up1)
+	return
+}
+
+// SaveHashedAfterCounter returns a count of finished RepositoryMock.SaveHashed invocations
+func (mmSaveHashed *RepositoryMock) SaveHashedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveHashed.afterSaveHashedCounter)
+}
+
+// SaveHashedBeforeCounter returns a count of RepositoryMock.SaveHashed invocations
+func (mmSaveHashed *RepositoryMock) SaveHashedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveHashed.beforeSaveHashedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SaveHashed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveHashed *mRepositoryMockSaveHashed) Calls() []*RepositoryMockSaveHashedParams {
+	mmSaveHashed.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSaveHashedParams, len(mmSaveHashed.callArgs))
+	copy(argCopy, mmSaveHashed.callArgs)
+
+	mmSaveHashed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveHashedDone returns true if the count of the SaveHashed invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSaveHashedDone() bool {
+	for _, e := range m.SaveHashedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveHashedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveHashedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveHashed != nil && mm_atomic.LoadUint64(&m.afterSaveHashedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveHashedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSaveHashedInspect() {
+	for _, e := range m.SaveHashedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SaveHashed with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveHashedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveHashedCounter) < 1 {
+		if m.SaveHashedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.SaveHashed")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SaveHashed with params: %#v", *m.SaveHashedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveHashed != nil && mm_atomic.LoadUint64(&m.afterSaveHashedCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.SaveHashed")
+	}
+}
+
+type mRepositoryMockSearch struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSearchExpectation
+	expectations       []*RepositoryMockSearchExpectation
+
+	callArgs []*RepositoryMockSearchParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockSearchExpectation specifies expectation struct of the Repository.Search
+type RepositoryMockSearchExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockSearchParams
+	results *RepositoryMockSearchResults
+	Counter uint64
+}
+
+// RepositoryMockSearchParams contains parameters of the Repository.Search
+type RepositoryMockSearchParams struct {
+	ctx   context.Context
+	query string
+	opts  mm_user.SearchOptions
+}
+
+// RepositoryMockSearchResults contains results of the Repository.Search
+type RepositoryMockSearchResults struct {
+	sa1 []mm_user.SearchHit
+	err error
+}
+
+// Expect sets up expected params for Repository.Search
+func (mmSearch *mRepositoryMockSearch) Expect(ctx context.Context, query string, opts mm_user.SearchOptions) *mRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("RepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &RepositoryMockSearchExpectation{}
+	}
+
+	mmSearch.defaultExpectation.params = &RepositoryMockSearchParams{ctx, query, opts}
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Search
+func (mmSearch *mRepositoryMockSearch) Inspect(f func(ctx context.Context, query string, opts mm_user.SearchOptions)) *mRepositoryMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by Repository.Search
+func (mmSearch *mRepositoryMockSearch) Return(sa1 []mm_user.SearchHit, err error) *RepositoryMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("RepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &RepositoryMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &RepositoryMockSearchResults{sa1, err}
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the Repository.Search method
+func (mmSearch *mRepositoryMockSearch) Set(f func(ctx context.Context, query string, opts mm_user.SearchOptions) (sa1 []mm_user.SearchHit, err error)) *RepositoryMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the Repository.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the Repository.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	return mmSearch.mock
+}
+
+// When sets expectation for the Repository.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mRepositoryMockSearch) When(ctx context.Context, query string, opts mm_user.SearchOptions) *RepositoryMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("RepositoryMock.Search mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSearchExpectation{
+		mock:   mmSearch.mock,
+		params: &RepositoryMockSearchParams{ctx, query, opts},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Search return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSearchExpectation) Then(sa1 []mm_user.SearchHit, err error) *RepositoryMock {
+	e.results = &RepositoryMockSearchResults{sa1, err}
+	return e.mock
+}
+
+// Search implements user.Repository
+func (mmSearch *RepositoryMock) Search(ctx context.Context, query string, opts mm_user.SearchOptions) (sa1 []mm_user.SearchHit, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, query, opts)
+	}
+
+	mm_params := RepositoryMockSearchParams{ctx, query, opts}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_got := RepositoryMockSearchParams{ctx, query, opts}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("RepositoryMock.Search got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the RepositoryMock.Search")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, query, opts)
+	}
+	mmSearch.t.Fatalf("Unexpected call to RepositoryMock.Search. %v %v %v", ctx, query, opts)
+	return
+}
+
+// SearchAfterCounter returns a count of finished RepositoryMock.Search invocations
+func (mmSearch *RepositoryMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
+
+// SearchBeforeCounter returns a count of RepositoryMock.Search invocations
+func (mmSearch *RepositoryMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mRepositoryMockSearch) Calls() []*RepositoryMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSearchDone() bool {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSearchCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && mm_atomic.LoadUint64(&m.afterSearchCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSearchInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Search with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSearchCounter) < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.Search")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Search with params: %#v", *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && mm_atomic.LoadUint64(&m.afterSearchCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.Search")
+	}
+}
+
+type mRepositoryMockTouchActivity struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockTouchActivityExpectation
+	expectations       []*RepositoryMockTouchActivityExpectation
+
+	callArgs []*RepositoryMockTouchActivityParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockTouchActivityExpectation specifies expectation struct of the Repository.TouchActivity
+type RepositoryMockTouchActivityExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockTouchActivityParams
+	results *RepositoryMockTouchActivityResults
+	Counter uint64
+}
+
+// RepositoryMockTouchActivityParams contains parameters of the Repository.TouchActivity
+type RepositoryMockTouchActivityParams struct {
+	ctx context.Context
+	id  int64
+	at  time.Time
+}
+
+// RepositoryMockTouchActivityResults contains results of the Repository.TouchActivity
+type RepositoryMockTouchActivityResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.TouchActivity
+func (mmTouchActivity *mRepositoryMockTouchActivity) Expect(ctx context.Context, id int64, at time.Time) *mRepositoryMockTouchActivity {
+	if mmTouchActivity.mock.funcTouchActivity != nil {
+		mmTouchActivity.mock.t.Fatalf("RepositoryMock.TouchActivity mock is already set by Set")
+	}
+
+	if mmTouchActivity.defaultExpectation == nil {
+		mmTouchActivity.defaultExpectation = &RepositoryMockTouchActivityExpectation{}
+	}
+
+	mmTouchActivity.defaultExpectation.params = &RepositoryMockTouchActivityParams{ctx, id, at}
+	for _, e := range mmTouchActivity.expectations {
+		if minimock.Equal(e.params, mmTouchActivity.defaultExpectation.params) {
+			mmTouchActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchActivity.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchActivity
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.TouchActivity
+func (mmTouchActivity *mRepositoryMockTouchActivity) Inspect(f func(ctx context.Context, id int64, at time.Time)) *mRepositoryMockTouchActivity {
+	if mmTouchActivity.mock.inspectFuncTouchActivity != nil {
+		mmTouchActivity.mock.t.Fatalf("Inspect function is already set for RepositoryMock.TouchActivity")
+	}
+
+	mmTouchActivity.mock.inspectFuncTouchActivity = f
+
+	return mmTouchActivity
+}
+
+// Return sets up results that will be returned by Repository.TouchActivity
+func (mmTouchActivity *mRepositoryMockTouchActivity) Return(err error) *RepositoryMock {
+	if mmTouchActivity.mock.funcTouchActivity != nil {
+		mmTouchActivity.mock.t.Fatalf("RepositoryMock.TouchActivity mock is already set by Set")
+	}
+
+	if mmTouchActivity.defaultExpectation == nil {
+		mmTouchActivity.defaultExpectation = &RepositoryMockTouchActivityExpectation{mock: mmTouchActivity.mock}
+	}
+	mmTouchActivity.defaultExpectation.results = &RepositoryMockTouchActivityResults{err}
+	return mmTouchActivity.mock
+}
+
+// Set uses given function f to mock the Repository.TouchActivity method
+func (mmTouchActivity *mRepositoryMockTouchActivity) Set(f func(ctx context.Context, id int64, at time.Time) (err error)) *RepositoryMock {
+	if mmTouchActivity.defaultExpectation != nil {
+		mmTouchActivity.mock.t.Fatalf("Default expectation is already set for the Repository.TouchActivity method")
+	}
+
+	if len(mmTouchActivity.expectations) > 0 {
+		mmTouchActivity.mock.t.Fatalf("Some expectations are already set for the Repository.TouchActivity method")
+	}
+
+	mmTouchActivity.mock.funcTouchActivity = f
+	return mmTouchActivity.mock
+}
+
+// When sets expectation for the Repository.TouchActivity which will trigger the result defined by the following
+// Then helper
+func (mmTouchActivity *mRepositoryMockTouchActivity) When(ctx context.Context, id int64, at time.Time) *RepositoryMockTouchActivityExpectation {
+	if mmTouchActivity.mock.funcTouchActivity != nil {
+		mmTouchActivity.mock.t.Fatalf("RepositoryMock.TouchActivity mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockTouchActivityExpectation{
+		mock:   mmTouchActivity.mock,
+		params: &RepositoryMockTouchActivityParams{ctx, id, at},
+	}
+	mmTouchActivity.expectations = append(mmTouchActivity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.TouchActivity return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockTouchActivityExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockTouchActivityResults{err}
+	return e.mock
+}
+
+// TouchActivity implements user.Repository
+func (mmTouchActivity *RepositoryMock) TouchActivity(ctx context.Context, id int64, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouchActivity.beforeTouchActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchActivity.afterTouchActivityCounter, 1)
+
+	if mmTouchActivity.inspectFuncTouchActivity != nil {
+		mmTouchActivity.inspectFuncTouchActivity(ctx, id, at)
+	}
+
+	mm_params := RepositoryMockTouchActivityParams{ctx, id, at}
+
+	// Record call args
+	mmTouchActivity.TouchActivityMock.mutex.Lock()
+	mmTouchActivity.TouchActivityMock.callArgs = append(mmTouchActivity.TouchActivityMock.callArgs, &mm_params)
+	mmTouchActivity.TouchActivityMock.mutex.Unlock()
+
+	for _, e := range mmTouchActivity.TouchActivityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouchActivity.TouchActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchActivity.TouchActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchActivity.TouchActivityMock.defaultExpectation.params
+		mm_got := RepositoryMockTouchActivityParams{ctx, id, at}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouchActivity.t.Errorf("RepositoryMock.TouchActivity got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouchActivity.TouchActivityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouchActivity.t.Fatal("No results are set for the RepositoryMock.TouchActivity")
+		}
+		return (*mm_results).err
+	}
+	if mmTouchActivity.funcTouchActivity != nil {
+		return mmTouchActivity.funcTouchActivity(ctx, id, at)
+	}
+	mmTouchActivity.t.Fatalf("Unexpected call to RepositoryMock.TouchActivity. %v %v %v", ctx, id, at)
+	return
+}
+
+// TouchActivityAfterCounter returns a count of finished RepositoryMock.TouchActivity invocations
+func (mmTouchActivity *RepositoryMock) TouchActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchActivity.afterTouchActivityCounter)
+}
+
+// TouchActivityBeforeCounter returns a count of RepositoryMock.TouchActivity invocations
+func (mmTouchActivity *RepositoryMock) TouchActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchActivity.beforeTouchActivityCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.TouchActivity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouchActivity *mRepositoryMockTouchActivity) Calls() []*RepositoryMockTouchActivityParams {
+	mmTouchActivity.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockTouchActivityParams, len(mmTouchActivity.callArgs))
+	copy(argCopy, mmTouchActivity.callArgs)
+
+	mmTouchActivity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchActivityDone returns true if the count of the TouchActivity invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockTouchActivityDone() bool {
+	for _, e := range m.TouchActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchActivityMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTouchActivityCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchActivity != nil && mm_atomic.LoadUint64(&m.afterTouchActivityCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockTouchActivityInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockTouchActivityInspect() {
+	for _, e := range m.TouchActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.TouchActivity with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchActivityMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTouchActivityCounter) < 1 {
+		if m.TouchActivityMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.TouchActivity")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.TouchActivity with params: %#v", *m.TouchActivityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchActivity != nil && mm_atomic.LoadUint64(&m.afterTouchActivityCounter) < 1 {
+		m.t.Error("Expected call to RepositoryMock.TouchActivity")
+	}
+}
+
+type mRepositoryMockUpdate struct {
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateExpectation
+	expectations       []*RepositoryMockUpdateExpectation
+
+	callArgs []*RepositoryMockUpdateParams
+	mutex    sync.RWMutex
+}
+
+// RepositoryMockUpdateExpectation specifies expectation struct of the Repository.Update
+type RepositoryMockUpdateExpectation struct {
+	mock    *RepositoryMock
+	params  *RepositoryMockUpdateParams
+	results *RepositoryMockUpdateResults
+	Counter uint64
+}
+
+// RepositoryMockUpdateParams contains parameters of the Repository.Update
+type RepositoryMockUpdateParams struct {
+	ctx context.Context
+	up1 *domain.User
+}
+
+// RepositoryMockUpdateResults contains results of the Repository.Update
+type RepositoryMockUpdateResults struct {
+	err error
+}
+
+// Expect sets up expected params for Repository.Update
+func (mmUpdate *mRepositoryMockUpdate) Expect(ctx context.Context, up1 *domain.User) *mRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RepositoryMockUpdateExpectation{}
 	}
 
 	mmUpdate.defaultExpectation.params = &RepositoryMockUpdateParams{ctx, up1}
@@ -941,10 +2749,26 @@ func (m *RepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockDeleteInspect()
 
+			m.MinimockEraseInspect()
+
 			m.MinimockGetInspect()
 
+			m.MinimockGetManyInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockPurgeInspect()
+
+			m.MinimockRestoreInspect()
+
 			m.MinimockSaveInspect()
 
+			m.MinimockSaveHashedInspect()
+
+			m.MinimockSearchInspect()
+
+			m.MinimockTouchActivityInspect()
+
 			m.MinimockUpdateInspect()
 			m.t.FailNow()
 		}
@@ -971,7 +2795,15 @@ func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockDeleteDone() &&
+		m.MinimockEraseDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockGetManyDone() &&
+		m.MinimockListDone() &&
+		m.MinimockPurgeDone() &&
+		m.MinimockRestoreDone() &&
 		m.MinimockSaveDone() &&
+		m.MinimockSaveHashedDone() &&
+		m.MinimockSearchDone() &&
+		m.MinimockTouchActivityDone() &&
 		m.MinimockUpdateDone()
 }
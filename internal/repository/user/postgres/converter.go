@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"database/sql"
+
 	domain "github.com/neracastle/auth/internal/domain/user"
 	pg_repo "github.com/neracastle/auth/internal/repository/user/postgres/model"
 )
@@ -12,6 +14,24 @@ func FromDomainToRepo(user *domain.User) pg_repo.UserDTO {
 		Email:    user.Email,
 		Password: user.Password,
 		IsAdmin:  0,
+		Version:  user.Version,
+
+		SessionVersion: user.SessionVersion,
+		Status:         string(user.Status),
+	}
+
+	//новый пользователь, собранный не через domain.NewUser, активен, как и по умолчанию в бд
+	if dto.Status == "" {
+		dto.Status = string(domain.StatusActive)
+	}
+
+	if user.StatusReason != "" {
+		_ = dto.StatusReason.Scan(user.StatusReason)
+	}
+
+	//колонка без часового пояса, время хранится в utc
+	if !user.SuspendedUntil.IsZero() {
+		dto.SuspendedUntil = sql.NullTime{Time: user.SuspendedUntil.UTC(), Valid: true}
 	}
 
 	if user.IsAdmin {
@@ -34,5 +54,11 @@ func FromRepoToDomain(dto pg_repo.UserDTO) *domain.User {
 		Name:     dto.Name.String,
 		RegDate:  dto.CreatedAt,
 		IsAdmin:  dto.IsAdmin > 0,
+		Version:  dto.Version,
+
+		SessionVersion: dto.SessionVersion,
+		Status:         domain.Status(dto.Status),
+		StatusReason:   dto.StatusReason.String,
+		SuspendedUntil: dto.SuspendedUntil.Time,
 	}
 }
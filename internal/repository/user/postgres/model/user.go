@@ -7,10 +7,24 @@ import (
 
 // UserDTO модель для представления в pg
 type UserDTO struct {
+	ID             int64          `db:"id"`
+	Email          string         `db:"email"`
+	Password       string         `db:"password"`
+	Name           sql.NullString `db:"name"`
+	IsAdmin        int8           `db:"role"`
+	CreatedAt      time.Time      `db:"created_at"`
+	Version        int64          `db:"version"`
+	SessionVersion int64          `db:"sessions_version"`
+	Status         string         `db:"status"`
+	StatusReason   sql.NullString `db:"status_reason"`
+	SuspendedUntil sql.NullTime   `db:"suspended_until"`
+}
+
+// SearchHitDTO строка результата полнотекстового поиска
+type SearchHitDTO struct {
 	ID        int64          `db:"id"`
 	Email     string         `db:"email"`
-	Password  string         `db:"password"`
 	Name      sql.NullString `db:"name"`
-	IsAdmin   int8           `db:"role"`
-	CreatedAt time.Time      `db:"created_at"`
+	Rank      float32        `db:"rank"`
+	Highlight string         `db:"highlight"`
 }
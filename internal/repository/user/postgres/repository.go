@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/neracastle/go-libs/pkg/db"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"golang.org/x/crypto/bcrypt"
@@ -25,17 +28,40 @@ const (
 	roleColumn     = "role"
 	createdColumn  = "created_at"
 	updateColumn   = "updated_at"
+	deletedColumn  = "deleted_at"
+	versionColumn  = "version"
+	sessionColumn  = "sessions_version"
+	statusColumn   = "status"
+	reasonColumn   = "status_reason"
+	untilColumn    = "suspended_until"
 )
 
+// userColumns поля пользователя, которые читаются в pgmodel.UserDTO
+var userColumns = []string{
+	idColumn, emailColumn, passwordColumn, nameColumn, roleColumn, createdColumn, versionColumn, sessionColumn,
+	statusColumn, reasonColumn, untilColumn,
+}
+
 const (
-	saveMethod   = "repository.user.postgres.Save"
-	updateMethod = "repository.user.postgres.Update"
-	deleteMethod = "repository.user.postgres.Delete"
-	getMethod    = "repository.user.postgres.Get"
+	saveMethod    = "repository.user.postgres.Save"
+	updateMethod  = "repository.user.postgres.Update"
+	deleteMethod  = "repository.user.postgres.Delete"
+	restoreMethod = "repository.user.postgres.Restore"
+	purgeMethod   = "repository.user.postgres.Purge"
+	eraseMethod   = "repository.user.postgres.Erase"
+	getMethod     = "repository.user.postgres.Get"
+	listMethod    = "repository.user.postgres.List"
+	manyMethod    = "repository.user.postgres.GetMany"
+	touchMethod   = "repository.user.postgres.TouchActivity"
 )
 
+// uniqueViolationCode код ошибки pg при нарушении уникального индекса
+const uniqueViolationCode = "23505"
+
 var _ user.Repository = (*repo)(nil)
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type repo struct {
 	conn db.Client
 }
@@ -49,7 +75,6 @@ func New(conn db.Client) user.Repository {
 
 func (r *repo) Save(ctx context.Context, user *domain.User) error {
 	log := logger.GetLogger(ctx).With(slog.String("method", saveMethod))
-	dto := FromDomainToRepo(user)
 
 	pwdHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -57,11 +82,22 @@ func (r *repo) Save(ctx context.Context, user *domain.User) error {
 		return err
 	}
 
+	return r.insert(ctx, user, string(pwdHash))
+}
+
+func (r *repo) SaveHashed(ctx context.Context, u *domain.User) error {
+	return r.insert(ctx, u, u.Password)
+}
+
+func (r *repo) insert(ctx context.Context, u *domain.User, pwdHash string) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", saveMethod))
+	dto := FromDomainToRepo(u)
+
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	query, args, err := psql.Insert("auth.users").
-		Columns(emailColumn, passwordColumn, nameColumn, roleColumn).
-		Values(dto.Email, pwdHash, dto.Name, dto.IsAdmin).
-		Suffix(fmt.Sprintf("RETURNING %s", idColumn)).
+		Columns(emailColumn, passwordColumn, nameColumn, roleColumn, statusColumn).
+		Values(dto.Email, pwdHash, dto.Name, dto.IsAdmin, dto.Status).
+		Suffix(fmt.Sprintf("RETURNING %s, %s", idColumn, versionColumn)).
 		ToSql()
 	if err != nil {
 		log.Error("failed to build update query", slog.String("error", err.Error()))
@@ -69,20 +105,27 @@ func (r *repo) Save(ctx context.Context, user *domain.User) error {
 	}
 
 	q := db.Query{Name: saveMethod, QueryRaw: query}
-	err = r.conn.DB().QueryRow(ctx, q, args...).Scan(&user.ID)
+	err = r.conn.DB().QueryRow(ctx, q, args...).Scan(&u.ID, &u.Version)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return user.ErrEmailTaken
+		}
+
 		log.Error("failed to save user in db", slog.String("error", err.Error()))
 		return err
 	}
 
-	log.Debug("saved user in db", slog.Int64("id", user.ID))
+	log.Debug("saved user in db", slog.Int64("id", u.ID))
 
 	return nil
 }
 
-func (r *repo) Update(ctx context.Context, user *domain.User) error {
+// Update сохраняет пользователя, если его версия в бд совпадает с user.Version, и увеличивает версию.
+// Если пользователя успели изменить, вернется user.ErrVersionConflict
+func (r *repo) Update(ctx context.Context, u *domain.User) error {
 	log := logger.GetLogger(ctx).With(slog.String("method", updateMethod))
-	dto := FromDomainToRepo(user)
+	dto := FromDomainToRepo(u)
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	query, args, err := psql.Update("auth.users").
@@ -90,8 +133,14 @@ func (r *repo) Update(ctx context.Context, user *domain.User) error {
 		Set(nameColumn, dto.Name).
 		Set(passwordColumn, dto.Password).
 		Set(roleColumn, dto.IsAdmin).
+		Set(sessionColumn, dto.SessionVersion).
+		Set(statusColumn, dto.Status).
+		Set(reasonColumn, dto.StatusReason).
+		Set(untilColumn, dto.SuspendedUntil).
 		Set(updateColumn, sq.Expr("now()")).
-		Where(sq.Eq{idColumn: dto.ID}).
+		Set(versionColumn, sq.Expr(versionColumn+" + 1")).
+		Where(sq.Eq{idColumn: dto.ID, deletedColumn: nil, versionColumn: dto.Version}).
+		Suffix("RETURNING " + versionColumn).
 		ToSql()
 	if err != nil {
 		log.Error("failed to build update query", slog.String("error", err.Error()))
@@ -99,8 +148,17 @@ func (r *repo) Update(ctx context.Context, user *domain.User) error {
 	}
 
 	q := db.Query{Name: updateMethod, QueryRaw: query}
-	_, err = r.conn.DB().Exec(ctx, q, args...)
+	err = r.conn.DB().QueryRow(ctx, q, args...).Scan(&u.Version)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.updateMissReason(ctx, dto.ID)
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return user.ErrEmailTaken
+		}
+
 		log.Error("failed to update user in db", slog.String("error", err.Error()))
 		return err
 	}
@@ -108,9 +166,27 @@ func (r *repo) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
+// updateMissReason отличает конфликт версий от отсутствия пользователя
+func (r *repo) updateMissReason(ctx context.Context, id int64) error {
+	q := db.Query{Name: updateMethod, QueryRaw: "SELECT EXISTS(SELECT 1 FROM auth.users WHERE id = $1 AND deleted_at IS NULL)"}
+
+	var exists bool
+	err := r.conn.DB().QueryRow(ctx, q, id).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return user.ErrVersionConflict
+	}
+
+	return user.ErrUserNotFound
+}
+
 func (r *repo) Delete(ctx context.Context, id int64) error {
 	log := logger.GetLogger(ctx).With(slog.String("method", deleteMethod))
-	q := db.Query{Name: deleteMethod, QueryRaw: "DELETE FROM auth.users WHERE id = $1"}
+	//удаление мягкое, окончательно запись удалит Purge по истечении срока хранения
+	q := db.Query{Name: deleteMethod, QueryRaw: "UPDATE auth.users SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL"}
 	qr, err := r.conn.DB().Exec(ctx, q, id)
 	if err != nil {
 		log.Error("failed to delete user", slog.String("error", err.Error()))
@@ -124,20 +200,65 @@ func (r *repo) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *repo) Get(ctx context.Context, filter user.SearchFilter) (*domain.User, error) {
-	log := logger.GetLogger(ctx).With(slog.String("method", getMethod), slog.Int64("user_id", filter.ID), slog.String("email", filter.Email))
+func (r *repo) Restore(ctx context.Context, id int64) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", restoreMethod))
+	q := db.Query{Name: restoreMethod, QueryRaw: "UPDATE auth.users SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL AND erased_at IS NULL"}
+	qr, err := r.conn.DB().Exec(ctx, q, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return user.ErrEmailTaken
+		}
 
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-	selQuery := psql.Select(idColumn, emailColumn, passwordColumn, nameColumn, roleColumn, createdColumn).From("auth.users")
+		log.Error("failed to restore user", slog.String("error", err.Error()))
+		return err
+	}
 
-	if filter.ID > 0 {
-		selQuery = selQuery.Where(sq.Eq{idColumn: filter.ID})
+	if qr.RowsAffected() == 0 {
+		return user.ErrUserNotFound
 	}
 
-	if filter.Email != "" {
-		selQuery = selQuery.Where(sq.Eq{emailColumn: filter.Email})
+	return nil
+}
+
+func (r *repo) Erase(ctx context.Context, id int64) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", eraseMethod))
+	q := db.Query{Name: eraseMethod, QueryRaw: `UPDATE auth.users SET email = $2, name = NULL, password = '', last_activity_at = NULL,
+			sessions_version = sessions_version + 1, version = version + 1, updated_at = now(),
+			deleted_at = coalesce(deleted_at, now()), erased_at = now()
+		WHERE id = $1 AND erased_at IS NULL`}
+	qr, err := r.conn.DB().Exec(ctx, q, id, domain.ErasedEmail(id))
+	if err != nil {
+		log.Error("failed to erase user", slog.String("error", err.Error()))
+		return err
+	}
+
+	if qr.RowsAffected() == 0 {
+		return user.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", purgeMethod))
+	q := db.Query{Name: purgeMethod, QueryRaw: "DELETE FROM auth.users WHERE deleted_at < now() - make_interval(secs => $1) AND erased_at IS NULL"}
+	qr, err := r.conn.DB().Exec(ctx, q, retention.Seconds())
+	if err != nil {
+		log.Error("failed to purge deleted users", slog.String("error", err.Error()))
+		return 0, err
 	}
 
+	return qr.RowsAffected(), nil
+}
+
+func (r *repo) Get(ctx context.Context, filter user.SearchFilter) (*domain.User, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", getMethod), slog.Int64("user_id", filter.ID), slog.String("email", filter.Email))
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	selQuery := psql.Select(userColumns...).From("auth.users")
+	selQuery = applyFilter(selQuery, filter)
+
 	queryStr, args, err := selQuery.ToSql()
 	if err != nil {
 		return nil, err
@@ -158,6 +279,10 @@ func (r *repo) Get(ctx context.Context, filter user.SearchFilter) (*domain.User,
 
 	dto, err := pgx.CollectOneRow(res, pgx.RowToStructByName[pgmodel.UserDTO])
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrUserNotFound
+		}
+
 		return nil, err
 	}
 
@@ -165,3 +290,161 @@ func (r *repo) Get(ctx context.Context, filter user.SearchFilter) (*domain.User,
 
 	return userAggr, nil
 }
+
+func (r *repo) GetMany(ctx context.Context, ids []int64) ([]*domain.User, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", manyMethod))
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	queryStr, args, err := psql.Select(userColumns...).
+		From("auth.users").
+		Where(sq.Expr(idColumn+" = ANY(?)", ids)).
+		Where(sq.Eq{deletedColumn: nil}).
+		ToSql()
+	if err != nil {
+		log.Error("failed to build query", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	q := db.Query{Name: manyMethod, QueryRaw: queryStr}
+	res, err := r.conn.DB().Query(ctx, q, args...)
+	if err != nil {
+		log.Error("failed to get users from db", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	dtos, err := pgx.CollectRows(res, pgx.RowToStructByName[pgmodel.UserDTO])
+	if err != nil {
+		log.Error("failed to scan users", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	users := make([]*domain.User, 0, len(dtos))
+	for _, dto := range dtos {
+		users = append(users, FromRepoToDomain(dto))
+	}
+
+	return users, nil
+}
+
+func (r *repo) List(ctx context.Context, filter user.SearchFilter, opts user.ListOptions) ([]*domain.User, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", listMethod))
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	selQuery := psql.Select(userColumns...).From("auth.users")
+	selQuery = applyFilter(selQuery, filter)
+
+	sortColumn, cast := sortExpr(opts.SortBy)
+	cmp, dir := ">", "ASC"
+	if opts.Desc {
+		cmp, dir = "<", "DESC"
+	}
+
+	//keyset-пагинация: id добавляется в ключ, чтобы порядок был однозначным при одинаковых значениях поля
+	if opts.After != nil {
+		if sortColumn == idColumn {
+			selQuery = selQuery.Where(sq.Expr(fmt.Sprintf("%s %s ?", idColumn, cmp), opts.After.ID))
+		} else {
+			selQuery = selQuery.Where(sq.Expr(fmt.Sprintf("(%s, %s) %s (?%s, ?)", sortColumn, idColumn, cmp, cast), opts.After.Value, opts.After.ID))
+		}
+	}
+
+	if sortColumn == idColumn {
+		selQuery = selQuery.OrderBy(fmt.Sprintf("%s %s", idColumn, dir))
+	} else {
+		selQuery = selQuery.OrderBy(fmt.Sprintf("%s %s", sortColumn, dir), fmt.Sprintf("%s %s", idColumn, dir))
+	}
+
+	queryStr, args, err := selQuery.Limit(opts.Limit).ToSql()
+	if err != nil {
+		log.Error("failed to build list query", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	q := db.Query{Name: listMethod, QueryRaw: queryStr}
+	res, err := r.conn.DB().Query(ctx, q, args...)
+	if err != nil {
+		log.Error("failed to list users from db", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	dtos, err := pgx.CollectRows(res, pgx.RowToStructByName[pgmodel.UserDTO])
+	if err != nil {
+		log.Error("failed to scan users", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	users := make([]*domain.User, 0, len(dtos))
+	for _, dto := range dtos {
+		users = append(users, FromRepoToDomain(dto))
+	}
+
+	return users, nil
+}
+
+// applyFilter добавляет в запрос условия фильтра
+func applyFilter(selQuery sq.SelectBuilder, filter user.SearchFilter) sq.SelectBuilder {
+	selQuery = selQuery.Where(sq.Eq{deletedColumn: nil})
+
+	if filter.ID > 0 {
+		selQuery = selQuery.Where(sq.Eq{idColumn: filter.ID})
+	}
+
+	if filter.Email != "" {
+		selQuery = selQuery.Where(sq.Eq{emailColumn: filter.Email})
+	}
+
+	if filter.IsAdmin != nil {
+		if *filter.IsAdmin {
+			selQuery = selQuery.Where(sq.Gt{roleColumn: 0})
+		} else {
+			selQuery = selQuery.Where(sq.Eq{roleColumn: 0})
+		}
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		selQuery = selQuery.Where(sq.GtOrEq{createdColumn: filter.CreatedFrom})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		selQuery = selQuery.Where(sq.Lt{createdColumn: filter.CreatedTo})
+	}
+
+	if filter.Query != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Query) + "%"
+		selQuery = selQuery.Where(sq.Or{
+			sq.ILike{emailColumn: pattern},
+			sq.ILike{nameColumn: pattern},
+		})
+	}
+
+	return selQuery
+}
+
+// sortExpr выражение для сортировки и приведение типа для значения курсора
+func sortExpr(sortBy user.SortField) (string, string) {
+	switch sortBy {
+	case user.SortByEmail:
+		return emailColumn, ""
+	case user.SortByName:
+		return fmt.Sprintf("coalesce(%s, '')", nameColumn), ""
+	case user.SortByCreatedAt:
+		return createdColumn, "::timestamp"
+	default:
+		return idColumn, ""
+	}
+}
+
+func (r *repo) TouchActivity(ctx context.Context, id int64, at time.Time) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", touchMethod))
+	//события могут прийти не по порядку, поэтому время только увеличивается
+	q := db.Query{Name: touchMethod, QueryRaw: `UPDATE auth.users
+		SET last_activity_at = greatest(coalesce(last_activity_at, $2), $2)
+		WHERE id = $1 AND deleted_at IS NULL`}
+	_, err := r.conn.DB().Exec(ctx, q, id, at.UTC())
+	if err != nil {
+		log.Error("failed to update last activity", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
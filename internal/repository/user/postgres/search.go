@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	pgmodel "github.com/neracastle/auth/internal/repository/user/postgres/model"
+)
+
+const searchMethod = "repository.user.postgres.Search"
+
+// выражения совпадают с индексами из миграции users_search, иначе индексы не будут использоваться
+const (
+	nameExpr = "coalesce(name, '')"
+	nameTsv  = "to_tsvector('simple', coalesce(name, ''))"
+	emailTsv = "to_tsvector('simple', email)"
+)
+
+// поиск по имени: префиксы слов, подстрока и нечеткое совпадение по триграммам для опечаток
+const searchByNameQuery = `SELECT id, email, name,
+	greatest(ts_rank(` + nameTsv + `, to_tsquery('simple', $2)), word_similarity($1, ` + nameExpr + `))::real AS rank,
+	ts_headline('simple', ` + nameExpr + `, to_tsquery('simple', $2), 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS highlight
+FROM auth.users
+WHERE deleted_at IS NULL AND (` + nameTsv + ` @@ to_tsquery('simple', $2)
+	OR ` + nameExpr + ` ILIKE $3
+	OR $1 <% ` + nameExpr + `)
+ORDER BY rank DESC, id
+LIMIT $4`
+
+// поиск для администраторов: дополнительно ищет по почте
+const searchByNameAndEmailQuery = `SELECT id, email, name,
+	greatest(ts_rank(` + nameTsv + `, to_tsquery('simple', $2)), ts_rank(` + emailTsv + `, to_tsquery('simple', $2)),
+		word_similarity($1, ` + nameExpr + `), word_similarity($1, email))::real AS rank,
+	ts_headline('simple', ` + nameExpr + ` || ' ' || email, to_tsquery('simple', $2), 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS highlight
+FROM auth.users
+WHERE deleted_at IS NULL AND (` + nameTsv + ` @@ to_tsquery('simple', $2)
+	OR ` + emailTsv + ` @@ to_tsquery('simple', $2)
+	OR ` + nameExpr + ` ILIKE $3
+	OR email ILIKE $3
+	OR $1 <% ` + nameExpr + `
+	OR $1 <% email)
+ORDER BY rank DESC, id
+LIMIT $4`
+
+func (r *repo) Search(ctx context.Context, query string, opts user.SearchOptions) ([]user.SearchHit, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", searchMethod))
+
+	queryRaw := searchByNameQuery
+	if opts.WithEmail {
+		queryRaw = searchByNameAndEmailQuery
+	}
+
+	q := db.Query{Name: searchMethod, QueryRaw: queryRaw}
+	res, err := r.conn.DB().Query(ctx, q,
+		query,
+		toPrefixTsQuery(query),
+		"%"+likeEscaper.Replace(query)+"%",
+		opts.Limit)
+	if err != nil {
+		log.Error("failed to search users", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	dtos, err := pgx.CollectRows(res, pgx.RowToStructByName[pgmodel.SearchHitDTO])
+	if err != nil {
+		log.Error("failed to scan search results", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	hits := make([]user.SearchHit, 0, len(dtos))
+	for _, dto := range dtos {
+		hits = append(hits, user.SearchHit{
+			User: &domain.User{
+				ID:    dto.ID,
+				Email: dto.Email,
+				Name:  dto.Name.String,
+			},
+			Rank:      dto.Rank,
+			Highlight: dto.Highlight,
+		})
+	}
+
+	return hits, nil
+}
+
+// toPrefixTsQuery строит tsquery вида "слово1:* & слово2:*" для поиска по началу слов.
+// Спецсимволы tsquery вырезаются, чтобы пользовательский ввод не ломал синтаксис
+func toPrefixTsQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, w := range words {
+		words[i] = strings.ToLower(w) + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
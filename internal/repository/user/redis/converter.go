@@ -7,7 +7,7 @@ import (
 	"github.com/neracastle/auth/internal/repository/user/redis/model"
 )
 
-// FromDomainToRepo преобразует доменную сущность в дто хранилища
+// FromDomainToRepo преобразует доменную сущность в дто хранилища. Пароль не переносится
 func FromDomainToRepo(user *domain.User) model.UserDTO {
 	dto := model.UserDTO{
 		ID:        user.ID,
@@ -15,6 +15,13 @@ func FromDomainToRepo(user *domain.User) model.UserDTO {
 		Name:      user.Name,
 		IsAdmin:   0,
 		CreatedAt: user.RegDate.Unix(),
+		Version:   user.Version,
+		Status:    string(user.Status),
+		Reason:    user.StatusReason,
+	}
+
+	if !user.SuspendedUntil.IsZero() {
+		dto.SuspendedUntil = user.SuspendedUntil.Unix()
 	}
 
 	if user.IsAdmin {
@@ -26,11 +33,25 @@ func FromDomainToRepo(user *domain.User) model.UserDTO {
 
 // FromRepoToDomain преобразует дто хранилища в доменную сущность
 func FromRepoToDomain(dto model.UserDTO) *domain.User {
-	return &domain.User{
-		ID:      dto.ID,
-		Email:   dto.Email,
-		Name:    dto.Name,
-		RegDate: time.Unix(dto.CreatedAt, 0),
-		IsAdmin: dto.IsAdmin > 0,
+	u := &domain.User{
+		ID:           dto.ID,
+		Email:        dto.Email,
+		Name:         dto.Name,
+		RegDate:      time.Unix(dto.CreatedAt, 0),
+		IsAdmin:      dto.IsAdmin > 0,
+		Version:      dto.Version,
+		Status:       domain.Status(dto.Status),
+		StatusReason: dto.Reason,
+	}
+
+	//записи, закэшированные до появления статусов
+	if u.Status == "" {
+		u.Status = domain.StatusActive
 	}
+
+	if dto.SuspendedUntil != 0 {
+		u.SuspendedUntil = time.Unix(dto.SuspendedUntil, 0)
+	}
+
+	return u
 }
@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/neracastle/auth/internal/repository/user"
+)
+
+var _ user.Invalidator = (*invalidator)(nil)
+
+type invalidator struct {
+	pool    *redigo.Pool
+	channel string
+	// instance отличает свои сообщения от чужих: свой кэш публикующий экземпляр уже обновил
+	instance string
+}
+
+// NewInvalidator оповещения об устаревших записях через redis pub/sub
+func NewInvalidator(pool *redigo.Pool, channel string) user.Invalidator {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+
+	return &invalidator{
+		pool:     pool,
+		channel:  channel,
+		instance: hex.EncodeToString(buf),
+	}
+}
+
+// Publish отправляет сообщение вида "<instance>:<id>"
+func (i *invalidator) Publish(ctx context.Context, id int64) error {
+	conn, err := i.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("PUBLISH", i.channel, fmt.Sprintf("%s:%d", i.instance, id))
+
+	return err
+}
+
+func (i *invalidator) Subscribe(ctx context.Context, fn func(id int64)) error {
+	conn, err := i.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	psc := redigo.PubSubConn{Conn: conn}
+	defer psc.Close()
+
+	err = psc.Subscribe(i.channel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		switch msg := psc.ReceiveContext(ctx).(type) {
+		case redigo.Message:
+			id, ok := i.parse(string(msg.Data))
+			if ok {
+				fn(id)
+			}
+		case error:
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return msg
+		}
+	}
+}
+
+// parse возвращает id из чужого сообщения, свои и некорректные пропускаются
+func (i *invalidator) parse(data string) (int64, bool) {
+	instance, rawID, found := strings.Cut(data, ":")
+	if !found || instance == i.instance {
+		return 0, false
+	}
+
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
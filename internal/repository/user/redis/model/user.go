@@ -7,4 +7,9 @@ type UserDTO struct {
 	Name      string `redis:"name"`
 	IsAdmin   int8   `redis:"is_admin"`
 	CreatedAt int64  `redis:"created_at"`
+	Version   int64  `redis:"version"`
+	Status    string `redis:"status"`
+	Reason    string `redis:"status_reason"`
+	// SuspendedUntil unix-время окончания приостановки, 0 - бессрочно
+	SuspendedUntil int64 `redis:"suspended_until"`
 }
@@ -5,29 +5,41 @@ import (
 	"fmt"
 	"time"
 
+	redigo "github.com/gomodule/redigo/redis"
 	"github.com/neracastle/go-libs/pkg/redis"
 
 	domain "github.com/neracastle/auth/internal/domain/user"
 	"github.com/neracastle/auth/internal/repository/user"
 	"github.com/neracastle/auth/internal/repository/user/redis/model"
+	"github.com/neracastle/auth/internal/sealer"
 )
 
 var _ user.Cache = (*repo)(nil)
 
 type repo struct {
 	client redis.Client
+	// pool нужен для пакетных операций, которых нет в redis.Client
+	pool *redigo.Pool
+	// sealer шифрует персональные данные в кэше, nil - хранить открыто
+	sealer *sealer.Sealer
 }
 
-// New новый экземпляр клиента
-func New(client redis.Client) user.Cache {
+// New новый экземпляр клиента. Кэшируются только публичные поля профиля, пароль в redis не попадает
+func New(client redis.Client, pool *redigo.Pool, sealer *sealer.Sealer) user.Cache {
 	return &repo{
 		client: client,
+		pool:   pool,
+		sealer: sealer,
 	}
 }
 
 func (r *repo) Save(ctx context.Context, d *domain.User, ttl time.Duration) error {
-	dto := FromDomainToRepo(d)
-	err := r.client.HSetMap(ctx, r.getKey(dto.ID), dto)
+	dto, err := r.toRepo(d)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.HSetMap(ctx, r.getKey(dto.ID), dto)
 	if err != nil {
 		return err
 	}
@@ -58,9 +70,137 @@ func (r *repo) GetByID(ctx context.Context, id int64) (*domain.User, error) {
 		return nil, err
 	}
 
+	return r.toDomain(dto)
+}
+
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	return r.client.Del(ctx, r.getKey(id))
+}
+
+// GetMany читает пользователей одним пайплайном HGETALL
+func (r *repo) GetMany(ctx context.Context, ids []int64) (map[int64]*domain.User, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	for _, id := range ids {
+		err = conn.Send("HGETALL", r.getKey(id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = conn.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]*domain.User, len(ids))
+	for _, id := range ids {
+		vals, err := redigo.Values(conn.Receive())
+		if err != nil {
+			return nil, err
+		}
+
+		//для отсутствующего ключа HGETALL возвращает пустой список
+		if len(vals) == 0 {
+			continue
+		}
+
+		var dto model.UserDTO
+		err = redigo.ScanStruct(vals, &dto)
+		if err != nil {
+			return nil, err
+		}
+
+		u, err := r.toDomain(dto)
+		if err != nil {
+			continue
+		}
+
+		res[id] = u
+	}
+
+	return res, nil
+}
+
+// SaveMany сохраняет пользователей одной транзакцией MULTI/EXEC
+func (r *repo) SaveMany(ctx context.Context, users []*domain.User, ttl time.Duration) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.Send("MULTI")
+	if err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		dto, err := r.toRepo(u)
+		if err != nil {
+			return err
+		}
+		key := r.getKey(dto.ID)
+
+		err = conn.Send("HSET", redigo.Args{key}.AddFlat(dto)...)
+		if err != nil {
+			return err
+		}
+
+		err = conn.Send("EXPIRE", key, int(ttl.Seconds()))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+// toRepo готовит запись кэша, шифруя почту
+func (r *repo) toRepo(u *domain.User) (model.UserDTO, error) {
+	dto := FromDomainToRepo(u)
+	if r.sealer == nil {
+		return dto, nil
+	}
+
+	email, err := r.sealer.SealString(dto.Email, r.emailAD(dto.ID))
+	if err != nil {
+		return model.UserDTO{}, err
+	}
+	dto.Email = email
+
+	return dto, nil
+}
+
+// toDomain расшифровывает запись кэша. Запись, которую не удалось расшифровать (например, сохраненную
+// до смены ключа или до включения шифрования), считается отсутствующей и будет перечитана из бд
+func (r *repo) toDomain(dto model.UserDTO) (*domain.User, error) {
+	if r.sealer != nil {
+		email, err := r.sealer.OpenString(dto.Email, r.emailAD(dto.ID))
+		if err != nil {
+			return nil, user.ErrUserNotCached
+		}
+		dto.Email = email
+	}
+
 	return FromRepoToDomain(dto), nil
 }
 
+// emailAD привязывает шифротекст почты к ключу пользователя
+func (r *repo) emailAD(id int64) string {
+	return r.getKey(id) + ":email"
+}
+
 func (r *repo) getKey(id int64) string {
 	return fmt.Sprintf("user:%d", id)
 }
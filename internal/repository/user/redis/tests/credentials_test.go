@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/neracastle/go-libs/pkg/redis"
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	usersRedis "github.com/neracastle/auth/internal/repository/user/redis"
+	"github.com/neracastle/auth/internal/repository/user/redis/model"
+	"github.com/neracastle/auth/internal/sealer"
+)
+
+const bcryptHash = "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+
+// fakeRedis хранит хэши как есть, чтобы проверить, что именно уходит в redis
+type fakeRedis struct {
+	redis.Client
+	hashes map[string]model.UserDTO
+}
+
+func (f *fakeRedis) HSetMap(_ context.Context, key string, values interface{}) error {
+	f.hashes[key] = values.(model.UserDTO)
+	return nil
+}
+
+func (f *fakeRedis) Expire(context.Context, string, time.Duration) error { return nil }
+
+func (f *fakeRedis) Exist(_ context.Context, key string) (bool, error) {
+	_, ok := f.hashes[key]
+	return ok, nil
+}
+
+func (f *fakeRedis) HGetAll(_ context.Context, key string, dest interface{}) error {
+	*dest.(*model.UserDTO) = f.hashes[key]
+	return nil
+}
+
+// stored все значения записи в том виде, в котором их получит redis
+func (f *fakeRedis) stored(key string) string {
+	return fmt.Sprint(redigo.Args{}.AddFlat(f.hashes[key])...)
+}
+
+func newSealer(t *testing.T) *sealer.Sealer {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	s, err := sealer.New(key)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestCacheStoresNoCredentials(t *testing.T) {
+	ctx := context.Background()
+	u := &domain.User{ID: 7, Email: "user@example.com", Name: "user", Password: bcryptHash, RegDate: time.Now()}
+
+	client := &fakeRedis{hashes: map[string]model.UserDTO{}}
+	cache := usersRedis.New(client, nil, newSealer(t))
+
+	require.NoError(t, cache.Save(ctx, u, time.Minute))
+
+	stored := client.stored("user:7")
+	require.NotContains(t, stored, bcryptHash)
+	require.NotContains(t, strings.ToLower(stored), "password")
+	require.NotContains(t, stored, u.Email)
+
+	got, err := cache.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	require.Equal(t, u.Email, got.Email)
+	require.Empty(t, got.Password)
+}
+
+func TestCacheEntryOfOtherKeyIsMiss(t *testing.T) {
+	ctx := context.Background()
+	u := &domain.User{ID: 7, Email: "user@example.com"}
+
+	client := &fakeRedis{hashes: map[string]model.UserDTO{}}
+	require.NoError(t, usersRedis.New(client, nil, newSealer(t)).Save(ctx, u, time.Minute))
+
+	//после смены ключа старые записи перечитываются из бд, а не отдаются мусором
+	_, err := usersRedis.New(client, nil, newSealer(t)).GetByID(ctx, u.ID)
+	require.ErrorIs(t, err, user.ErrUserNotCached)
+}
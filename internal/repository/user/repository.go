@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"errors"
+	"time"
 
 	domain "github.com/neracastle/auth/internal/domain/user"
 )
@@ -11,17 +12,45 @@ import (
 type SearchFilter struct {
 	ID    int64
 	Email string
+	// IsAdmin если задан, фильтрует по роли
+	IsAdmin *bool
+	// CreatedFrom, CreatedTo диапазон даты регистрации [from, to)
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Query подстрока почты или имени
+	Query string
 }
 
 // Repository репозитарий пользователей
 type Repository interface {
+	// Save сохраняет нового пользователя, пароль хэшируется
 	Save(context.Context, *domain.User) error
+	// SaveHashed сохраняет нового пользователя, пароль которого уже bcrypt-хэш, например при импорте
+	SaveHashed(context.Context, *domain.User) error
+	// Update сохраняет изменения, если версия пользователя не менялась с момента чтения
 	Update(context.Context, *domain.User) error
+	// Delete мягко удаляет пользователя, после чего он не возвращается остальными методами
 	Delete(ctx context.Context, id int64) error
+	// Restore восстанавливает мягко удаленного пользователя
+	Restore(ctx context.Context, id int64) error
+	// Erase стирает персональные данные пользователя (domain.User.Erase) и мягко удаляет его, если он еще не удален.
+	// Строка остается, чтобы ссылки на id не повисли, восстановить и окончательно удалить ее уже нельзя
+	Erase(ctx context.Context, id int64) error
+	// Purge окончательно удаляет пользователей, удаленных раньше чем retention назад
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
 	Get(ctx context.Context, filter SearchFilter) (*domain.User, error)
+	GetMany(ctx context.Context, ids []int64) ([]*domain.User, error)
+	List(ctx context.Context, filter SearchFilter, opts ListOptions) ([]*domain.User, error)
+	Search(ctx context.Context, query string, opts SearchOptions) ([]SearchHit, error)
+	// TouchActivity сдвигает время последней активности, если at позже сохраненного
+	TouchActivity(ctx context.Context, id int64, at time.Time) error
 }
 
 var (
 	// ErrUserNotFound пользователь отсутствует в хранилище
 	ErrUserNotFound = errors.New("пользователь не найден")
+	// ErrEmailTaken почта уже занята другим пользователем
+	ErrEmailTaken = errors.New("почта уже используется")
+	// ErrVersionConflict пользователя изменили после того, как его прочитали
+	ErrVersionConflict = errors.New("версия пользователя устарела")
 )
@@ -0,0 +1,17 @@
+package user
+
+import domain "github.com/neracastle/auth/internal/domain/user"
+
+// SearchOptions параметры полнотекстового поиска
+type SearchOptions struct {
+	Limit uint64
+	// WithEmail искать и подсвечивать совпадения также по почте
+	WithEmail bool
+}
+
+// SearchHit найденный пользователь с релевантностью и подсвеченным фрагментом
+type SearchHit struct {
+	User      *domain.User
+	Rank      float32
+	Highlight string
+}
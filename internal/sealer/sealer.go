@@ -0,0 +1,100 @@
+package sealer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// ErrMalformed данные повреждены, зашифрованы другим ключом или привязаны к другому контексту
+var ErrMalformed = errors.New("не удалось расшифровать данные")
+
+// Sealer шифрует значения AES-GCM. Результат: случайный nonce и шифротекст с тегом
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// New создает шифратор по ключу длиной 16, 24 или 32 байта
+func New(key []byte) (*Sealer, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sealer{aead: aead}, nil
+}
+
+// ParseKey декодирует ключ из base64, в котором он задается в конфиге
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("ключ должен быть в base64: %w", err)
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("длина ключа %d байт, нужно 16, 24 или 32", len(key))
+}
+
+// Seal шифрует plaintext. ad не шифруется, но должен совпасть при расшифровке:
+// так шифротекст нельзя подставить в чужую запись
+func (s *Sealer) Seal(plaintext, ad []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.aead.Seal(nonce, nonce, plaintext, ad), nil
+}
+
+// Open расшифровывает результат Seal
+func (s *Sealer) Open(data, ad []byte) ([]byte, error) {
+	size := s.aead.NonceSize()
+	if len(data) < size+s.aead.Overhead() {
+		return nil, ErrMalformed
+	}
+
+	plaintext, err := s.aead.Open(nil, data[:size], data[size:], ad)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	return plaintext, nil
+}
+
+// SealString шифрует строку в base64, удобный для хранения в redis вид
+func (s *Sealer) SealString(plaintext, ad string) (string, error) {
+	sealed, err := s.Seal([]byte(plaintext), []byte(ad))
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenString расшифровывает результат SealString
+func (s *Sealer) OpenString(sealed, ad string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", ErrMalformed
+	}
+
+	plaintext, err := s.Open(data, []byte(ad))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+)
+
+// TrackActivity запоминает время последней активности пользователя (например, по событиям чат-сервера)
+func (s *Service) TrackActivity(ctx context.Context, userID int64, at time.Time) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.TrackActivity"))
+	log.Debug("called", slog.Int64("user_id", userID))
+
+	return s.usersRepo.TouchActivity(ctx, userID, at)
+}
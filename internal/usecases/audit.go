@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/audit"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Названия действий в журнале аудита, кроме изменений полей и сессий
+const (
+	actionCreate           = "Create"
+	actionDelete           = "Delete"
+	actionRestore          = "Restore"
+	actionPurge            = "Purge"
+	actionLogin            = "Login"
+	actionLoginFailed      = "LoginFailed"
+	actionRequestLoginLink = "RequestLoginLink"
+	actionErase            = "Erase"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 100
+)
+
+// newAction запись журнала о действии над userID. Инициатор и обстоятельства запроса берутся из контекста
+func newAction(ctx context.Context, userID int64, name string) model.ActionDTO {
+	meta := audit.MetaFromContext(ctx)
+	dto := model.ActionDTO{
+		UserID:    userID,
+		Name:      name,
+		RequestID: meta.RequestID,
+		TraceID:   meta.TraceID,
+		IP:        meta.IP,
+	}
+
+	if tokenUser, ok := auth.LookupUser(ctx); ok {
+		dto.ActorID = tokenUser.ID
+	}
+
+	return dto
+}
+
+// recordLogin пишет вход в журнал и сохраняет событие входа.
+// Ошибка только логируется, чтобы сбой журнала или outbox не мешал входу
+func (s *Service) recordLogin(ctx context.Context, userID int64, method string) {
+	dto := newAction(ctx, userID, actionLogin)
+	dto.ActorID = userID
+	dto.Details = map[string]string{"method": method}
+
+	err := s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.actionsRepo.Save(ctx, dto)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.publish(ctx, events.UserLoggedIn, userID, events.LoggedIn{UserID: userID, Method: method})
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to record login", slog.String("error", err.Error()))
+	}
+}
+
+// recordLoginFailed пишет в журнал неудачную попытку входа в существующий аккаунт
+func (s *Service) recordLoginFailed(ctx context.Context, userID int64, method string) {
+	dto := newAction(ctx, userID, actionLoginFailed)
+	dto.Details = map[string]string{"method": method}
+
+	err := s.actionsRepo.Save(ctx, dto)
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to record failed login", slog.String("error", err.Error()))
+	}
+}
+
+// ListAuditEvents возвращает страницу журнала аудита, сначала последние записи. Доступно только администраторам
+func (s *Service) ListAuditEvents(ctx context.Context, req def.AuditListDTO) (def.AuditPage, error) {
+	const method = "usecases.ListAuditEvents"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method)
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("auth_user_id", tokenUser.ID))
+
+	if !tokenUser.IsAdmin {
+		return def.AuditPage{}, ErrUserPermissionDenied
+	}
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+
+	if limit > maxAuditLimit {
+		limit = maxAuditLimit
+	}
+
+	filter := action.Filter{
+		UserID:  req.UserID,
+		ActorID: req.ActorID,
+		Names:   req.Actions,
+		Since:   req.From,
+		Until:   req.To,
+		//берем на одну запись больше, чтобы понять, есть ли следующая страница
+		Limit: limit + 1,
+		Desc:  true,
+	}
+
+	if req.Cursor != "" {
+		beforeID, err := decodeAuditCursor(req.Cursor)
+		if err != nil {
+			return def.AuditPage{}, ErrInvalidCursor
+		}
+
+		filter.BeforeID = beforeID
+	}
+
+	actions, err := s.actionsRepo.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return def.AuditPage{}, syserr.New("Не удалось получить журнал аудита", syserr.Internal)
+	}
+
+	page := def.AuditPage{Events: make([]def.AuditEventDTO, 0, len(actions))}
+	if uint64(len(actions)) > limit {
+		actions = actions[:limit]
+		page.NextCursor = encodeAuditCursor(actions[len(actions)-1].ID)
+	}
+
+	for _, a := range actions {
+		page.Events = append(page.Events, toAuditEvent(a))
+	}
+
+	return page, nil
+}
+
+func toAuditEvent(a model.ActionDTO) def.AuditEventDTO {
+	return def.AuditEventDTO{
+		ID:        a.ID,
+		UserID:    a.UserID,
+		ActorID:   a.ActorID,
+		Action:    a.Name,
+		OldValue:  a.OldValue,
+		NewValue:  a.NewValue,
+		RequestID: a.RequestID,
+		TraceID:   a.TraceID,
+		IP:        a.IP,
+		Details:   a.Details,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+// курсор журнала - id последней отданной записи
+func encodeAuditCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeAuditCursor(s string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return id, nil
+}
@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"strconv"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// verifyBatch по сколько записей журнала читается при проверке цепочки
+const verifyBatch = 1000
+
+// ErrAuditKeyNotSet не задан ключ подписи контрольных точек
+var ErrAuditKeyNotSet = errors.New("audit signing key is not set")
+
+// CheckpointAudit подписывает ключом аудита хэш последней записи журнала.
+// Подделать цепочку до контрольной точки, пересчитав хэши, без ключа нельзя
+func (s *Service) CheckpointAudit(ctx context.Context) error {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.CheckpointAudit"))
+
+	if s.Config.AuditSigningKey == "" {
+		return ErrAuditKeyNotSet
+	}
+
+	last, err := s.actionsRepo.List(ctx, action.Filter{Limit: 1, Desc: true})
+	if err != nil {
+		return err
+	}
+	if len(last) == 0 || last[0].Hash == nil {
+		return nil
+	}
+
+	checkpoints, err := s.actionsRepo.Checkpoints(ctx)
+	if err != nil {
+		return err
+	}
+	//с прошлой точки журнал не пополнялся
+	if len(checkpoints) > 0 && checkpoints[len(checkpoints)-1].ActionID == last[0].ID {
+		return nil
+	}
+
+	err = s.actionsRepo.SaveCheckpoint(ctx, model.CheckpointDTO{
+		ActionID:  last[0].ID,
+		Hash:      last[0].Hash,
+		Signature: signCheckpoint([]byte(s.Config.AuditSigningKey), last[0].ID, last[0].Hash),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("audit checkpoint saved", slog.Int64("action_id", last[0].ID))
+
+	return nil
+}
+
+// VerifyAuditLog проходит цепочку журнала и контрольные точки и сообщает о первом разрыве.
+// Обезличенная запись должна совпасть с хэшем своего обезличенного вида (после стирания того, над кем действие,
+// или инициатора), а дальше в цепочке должна быть запись о стирании этого пользователя. Доступно только администраторам
+func (s *Service) VerifyAuditLog(ctx context.Context) (def.AuditVerification, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.VerifyAuditLog"))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("auth_user_id", tokenUser.ID))
+
+	if !tokenUser.IsAdmin {
+		return def.AuditVerification{}, ErrUserPermissionDenied
+	}
+
+	checkpoints, err := s.actionsRepo.Checkpoints(ctx)
+	if err != nil {
+		log.Error("failed to get audit checkpoints", slog.String("error", err.Error()))
+		return def.AuditVerification{}, syserr.New("Не удалось проверить журнал аудита", syserr.Internal)
+	}
+
+	res := def.AuditVerification{}
+	var prevHash []byte
+	started := false
+	//обезличенные записи, для которых еще не встретилась запись о стирании, по id пользователя
+	unerased := make(map[int64][]int64)
+	covered := make(map[int64]bool)
+	filter := action.Filter{Limit: verifyBatch}
+
+	for {
+		batch, err := s.actionsRepo.List(ctx, filter)
+		if err != nil {
+			log.Error("failed to read audit log", slog.String("error", err.Error()))
+			return def.AuditVerification{}, syserr.New("Не удалось проверить журнал аудита", syserr.Internal)
+		}
+
+		for _, a := range batch {
+			reason := ""
+			switch {
+			case a.Hash == nil && !started:
+				//записи до введения цепочки
+				continue
+			case a.Hash == nil:
+				reason = "запись без хэша внутри цепочки"
+			case !bytes.Equal(a.PrevHash, prevHash):
+				reason = "prev_hash не совпадает с хэшем предыдущей записи: запись удалена или вставлена"
+			case a.RedactedAt == nil && !bytes.Equal(action.ContentHash(a), a.ContentHash):
+				reason = "содержимое записи изменено"
+			case a.RedactedAt != nil && len(redactedBy(a)) == 0:
+				reason = "содержимое обезличенной записи изменено"
+			case !bytes.Equal(action.ChainHash(a.PrevHash, a.ContentHash, a.UserRedactedHash, a.ActorRedactedHash), a.Hash):
+				reason = "хэш записи не сходится"
+			}
+
+			for reason == "" && len(checkpoints) > 0 && checkpoints[0].ActionID <= a.ID {
+				reason = s.verifyCheckpoint(checkpoints[0], a)
+				checkpoints = checkpoints[1:]
+				if reason == "" {
+					res.Checkpoints++
+				}
+			}
+
+			if reason != "" {
+				res.BrokenID = a.ID
+				res.Reason = reason
+				return res, nil
+			}
+
+			if a.RedactedAt != nil {
+				for _, id := range redactedBy(a) {
+					unerased[id] = append(unerased[id], a.ID)
+				}
+			} else if a.Name == actionErase {
+				for _, id := range unerased[a.UserID] {
+					covered[id] = true
+				}
+				delete(unerased, a.UserID)
+			}
+
+			started = true
+			prevHash = a.Hash
+			res.Checked++
+		}
+
+		if uint64(len(batch)) < filter.Limit {
+			break
+		}
+		filter.AfterID = batch[len(batch)-1].ID
+	}
+
+	//точка указывает на запись, которой в журнале нет: хвост журнала удален
+	if len(checkpoints) > 0 {
+		res.BrokenID = checkpoints[0].ActionID
+		res.Reason = "запись контрольной точки отсутствует: конец журнала удален"
+		return res, nil
+	}
+
+	if id := firstUncovered(unerased, covered); id != 0 {
+		res.BrokenID = id
+		res.Reason = "запись обезличена, но пользователь не стирался"
+	}
+
+	return res, nil
+}
+
+// redactedBy чье стирание оставляет запись в ее текущем виде: пользователя, над которым действие,
+// и/или инициатора. Пусто - содержимое не совпадает ни с одним обезличенным видом
+func redactedBy(a model.ActionDTO) []int64 {
+	contentHash := action.ContentHash(a)
+	var ids []int64
+	if a.UserID != 0 && bytes.Equal(contentHash, a.UserRedactedHash) {
+		ids = append(ids, a.UserID)
+	}
+	if a.ActorID != 0 && a.ActorID != a.UserID && bytes.Equal(contentHash, a.ActorRedactedHash) {
+		ids = append(ids, a.ActorID)
+	}
+
+	return ids
+}
+
+// firstUncovered наименьший id обезличенной записи, ни один пользователь которой не стирался
+func firstUncovered(unerased map[int64][]int64, covered map[int64]bool) int64 {
+	var first int64
+	for _, ids := range unerased {
+		for _, id := range ids {
+			if !covered[id] && (first == 0 || id < first) {
+				first = id
+			}
+		}
+	}
+
+	return first
+}
+
+// verifyCheckpoint сверяет точку с записью a, возвращает причину расхождения
+func (s *Service) verifyCheckpoint(cp model.CheckpointDTO, a model.ActionDTO) string {
+	if cp.ActionID != a.ID {
+		return "запись контрольной точки " + strconv.FormatInt(cp.ActionID, 10) + " отсутствует"
+	}
+
+	if !bytes.Equal(cp.Hash, a.Hash) {
+		return "хэш записи не совпадает с подписанной контрольной точкой"
+	}
+
+	//точки, подписанные до ротации, проверяются прежними ключами
+	keys := append([]string{s.Config.AuditSigningKey}, s.Config.AuditVerifyKeys...)
+	for _, key := range keys {
+		if key != "" && hmac.Equal(cp.Signature, signCheckpoint([]byte(key), cp.ActionID, cp.Hash)) {
+			return ""
+		}
+	}
+
+	return "подпись контрольной точки не подходит ни к одному ключу аудита"
+}
+
+func signCheckpoint(key []byte, actionID int64, hash []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("audit-checkpoint:" + strconv.FormatInt(actionID, 10) + ":"))
+	mac.Write(hash)
+
+	return mac.Sum(nil)
+}
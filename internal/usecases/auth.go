@@ -11,6 +11,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/exp/slog"
 
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
 	"github.com/neracastle/auth/internal/repository/user"
 	"github.com/neracastle/auth/internal/usecases/models"
 	"github.com/neracastle/auth/pkg/user_v1/auth"
@@ -29,6 +31,7 @@ func (s *Service) Auth(ctx context.Context, login string, pwd string) (models.Au
 	log := logger.GetLogger(ctx)
 	log.Debug("called", slog.String("method", method))
 
+	login = domain.NormalizeEmail(login)
 	if login == "" || pwd == "" {
 		return models.AuthTokens{}, syserr.NewFromError(ErrWrongLoginOrPwd, syserr.Unauthenticated)
 	}
@@ -44,10 +47,24 @@ func (s *Service) Auth(ctx context.Context, login string, pwd string) (models.Au
 
 	err = bcrypt.CompareHashAndPassword([]byte(dbUser.Password), []byte(pwd))
 	if err != nil {
+		s.recordLoginFailed(ctx, dbUser.ID, events.LoginPassword)
 		return models.AuthTokens{}, syserr.NewFromError(ErrWrongLoginOrPwd, syserr.Unauthenticated)
 	}
 
+	//статус проверяется после пароля, чтобы по ответу нельзя было узнать о блокировке чужого аккаунта
+	err = s.checkSignIn(ctx, dbUser, events.LoginPassword)
+	if err != nil {
+		return models.AuthTokens{}, err
+	}
+
 	span.AddEvent("generate tokens")
+	s.recordLogin(ctx, dbUser.ID, events.LoginPassword)
+
+	return s.issueTokens(dbUser)
+}
+
+// issueTokens выпускает пару access/refresh токенов для пользователя
+func (s *Service) issueTokens(dbUser *domain.User) (models.AuthTokens, error) {
 	jwtUser := models.FromDomainToJWT(dbUser)
 
 	accessToken, err := auth.GenerateToken(jwtUser, []byte(s.Config.SecretKey), s.Config.AccessDuration)
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// MaxBatchGetIDs максимальное кол-во id в одном запросе BatchGet
+const MaxBatchGetIDs = 100
+
+// ErrBatchTooLarge в запросе больше MaxBatchGetIDs id
+var ErrBatchTooLarge = syserr.New("Слишком много id в запросе", syserr.InvalidArgument)
+
+// BatchGet возвращает пользователей по списку id: сначала из кэша, затем недостающих из бд одним запросом.
+// Права те же, что и у Get: пользователь может запросить только себя, админ любого.
+// Возвращает найденных пользователей в порядке запроса и id тех, кого нет
+func (s *Service) BatchGet(ctx context.Context, userIDs []int64) ([]models.UserDTO, []int64, error) {
+	const method = "usecases.BatchGet"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method, trace.WithAttributes(attribute.Int("count", len(userIDs))))
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("auth_user_id", tokenUser.ID), slog.Int("count", len(userIDs)))
+
+	ids := uniqueIDs(userIDs)
+	if len(ids) > MaxBatchGetIDs {
+		return nil, nil, ErrBatchTooLarge
+	}
+
+	//получить данные пользователь может только по себе, а админ по всем
+	if !tokenUser.IsAdmin {
+		for _, id := range ids {
+			if id != tokenUser.ID {
+				return nil, nil, ErrUserPermissionDenied
+			}
+		}
+	}
+
+	found, err := s.usersCache.GetMany(ctx, ids)
+	if err != nil {
+		//кэш недоступен - идем за всеми в бд
+		span.RecordError(err)
+		log.Error("failed to get users from redis cache", slog.String("error", err.Error()))
+		found = make(map[int64]*domain.User, len(ids))
+	}
+
+	misses := make([]int64, 0, len(ids)-len(found))
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			misses = append(misses, id)
+		}
+	}
+
+	log.Debug("cache lookup done", slog.Int("hits", len(found)), slog.Int("misses", len(misses)))
+
+	if len(misses) > 0 {
+		dbUsers, err := s.usersRepo.GetMany(ctx, misses)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.Error("failed to get users from db", slog.String("error", err.Error()))
+			return nil, nil, syserr.New("Не удалось получить пользователей", syserr.Internal)
+		}
+
+		for _, u := range dbUsers {
+			found[u.ID] = u
+		}
+
+		//ошибка сохранения в кэш не влияет на выдачу результата, просто залогируем
+		err = s.usersCache.SaveMany(ctx, dbUsers, s.Config.CacheTTL)
+		if err != nil {
+			span.RecordError(err)
+			log.Error("failed to save users to redis cache", slog.String("error", err.Error()))
+		}
+	}
+
+	users := make([]models.UserDTO, 0, len(found))
+	notFound := make([]int64, 0)
+	for _, id := range ids {
+		u, ok := found[id]
+		if !ok {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		users = append(users, models.FromDomainToUsecase(u))
+	}
+
+	return users, notFound, nil
+}
+
+// uniqueIDs убирает дубли, сохраняя порядок
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+
+	return res
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"sync"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// setupToken одноразовый токен создания первого администратора
+type setupToken struct {
+	mu    sync.Mutex
+	value string
+}
+
+// take забирает токен, если он совпал с переданным: второй раз тем же токеном не воспользоваться
+func (t *setupToken) take(token string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.value == "" || subtle.ConstantTimeCompare([]byte(t.value), []byte(token)) != 1 {
+		return false
+	}
+	t.value = ""
+
+	return true
+}
+
+// put возвращает токен, если создание администратора не удалось
+func (t *setupToken) put(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.value = token
+}
+
+// BootstrapAdmin создает администратора из конфига, если администраторов еще нет. Возвращает его id или 0
+func (s *Service) BootstrapAdmin(ctx context.Context, req def.CreateDTO) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.BootstrapAdmin"))
+	log.Debug("called")
+
+	exists, err := s.hasAdmin(ctx)
+	if err != nil || exists {
+		return 0, err
+	}
+
+	req.IsAdmin = true
+	req.PasswordConfirm = req.Password
+
+	id, err := s.Create(auth.AddUserToContext(ctx, auth.JWTUser{IsAdmin: true}), req)
+	if err != nil {
+		//реплики, стартующие одновременно, создают администратора наперегонки: проигравшей хватит того,
+		//что администратор появился, например ее почта уже занята им
+		exists, errCheck := s.hasAdmin(ctx)
+		if errCheck == nil && exists {
+			log.Info("initial admin was created by another instance")
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ArmSetupToken включает одноразовый токен создания первого администратора, если администраторов еще нет.
+// Пустой token генерируется. Возвращает действующий токен или пустую строку, если администратор уже есть
+func (s *Service) ArmSetupToken(ctx context.Context, token string) (string, error) {
+	exists, err := s.hasAdmin(ctx)
+	if err != nil || exists {
+		return "", err
+	}
+
+	if token == "" {
+		raw := make([]byte, 24)
+		if _, err = rand.Read(raw); err != nil {
+			return "", err
+		}
+		token = base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	s.setup.put(token)
+
+	return token, nil
+}
+
+// allowAdminCreate администратора создает администратор, а первого - владелец токена настройки.
+// Возвращает функцию, которую нужно вызвать, если создать пользователя не удалось
+func (s *Service) allowAdminCreate(ctx context.Context, token string) (func(), error) {
+	if tokenUser, ok := auth.LookupUser(ctx); ok && tokenUser.IsAdmin {
+		return func() {}, nil
+	}
+
+	if token == "" || !s.setup.take(token) {
+		return nil, ErrUserPermissionDenied
+	}
+
+	exists, err := s.hasAdmin(ctx)
+	if err != nil {
+		s.setup.put(token)
+		return nil, syserr.New("Не удалось создать пользователя", syserr.Internal)
+	}
+	//администратора уже создали, например другой репликой: токен больше не нужен
+	if exists {
+		return nil, ErrUserPermissionDenied
+	}
+
+	return func() { s.setup.put(token) }, nil
+}
+
+// hasAdmin есть ли хотя бы один администратор
+func (s *Service) hasAdmin(ctx context.Context) (bool, error) {
+	isAdmin := true
+	admins, err := s.usersRepo.List(ctx, userRepo.SearchFilter{IsAdmin: &isAdmin}, userRepo.ListOptions{Limit: 1})
+	if err != nil {
+		return false, err
+	}
+
+	return len(admins) > 0, nil
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Действие журнала о выгрузке пользователей
+const actionExportUsers = "ExportUsers"
+
+// exportPage по сколько пользователей читается из бд при выгрузке
+const exportPage = 500
+
+// exportColumns колонки csv выгрузки. Импорт лишние колонки пропускает, так что выгрузку можно загрузить обратно,
+// дописав пароли
+var exportColumns = []string{"id", "email", "name", "role", "created_at"}
+
+// exportSink запись строк выгрузки в выбранном формате
+type exportSink interface {
+	write(row def.ExportRow) error
+	flush() error
+}
+
+// ExportUsers выгружает всех пользователей в csv или jsonl в порядке id. Паролей в выгрузке нет.
+// Возвращает кол-во выгруженных пользователей. Доступно только администраторам
+func (s *Service) ExportUsers(ctx context.Context, format string, w io.Writer) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.ExportUsers"))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("format", format), slog.Int64("auth_user_id", tokenUser.ID))
+
+	if !tokenUser.IsAdmin {
+		return 0, ErrUserPermissionDenied
+	}
+
+	sink, err := newExportSink(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	var exported int64
+	opts := userRepo.ListOptions{Limit: exportPage, SortBy: userRepo.SortByID}
+	for {
+		users, err := s.usersRepo.List(ctx, userRepo.SearchFilter{}, opts)
+		if err != nil {
+			log.Error("failed to list users", slog.String("error", err.Error()))
+			return exported, syserr.New("Не удалось выгрузить пользователей", syserr.Internal)
+		}
+
+		for _, u := range users {
+			err = sink.write(def.ExportRow{
+				ID:        u.ID,
+				Email:     u.Email,
+				Name:      u.Name,
+				Role:      roleName(u.IsAdmin),
+				CreatedAt: u.RegDate.UTC(),
+			})
+			if err != nil {
+				return exported, err
+			}
+			exported++
+		}
+
+		if len(users) < exportPage {
+			break
+		}
+		cursor := userRepo.CursorFor(users[len(users)-1], userRepo.SortByID)
+		opts.After = &cursor
+	}
+
+	if err = sink.flush(); err != nil {
+		return exported, err
+	}
+
+	//выгрузка отдает персональные данные всех пользователей, поэтому попадает в журнал
+	dto := newAction(ctx, 0, actionExportUsers)
+	dto.Details = map[string]string{"format": format, "count": strconv.FormatInt(exported, 10)}
+	if err = s.actionsRepo.Save(ctx, dto); err != nil {
+		log.Error("failed to record users export", slog.String("error", err.Error()))
+	}
+
+	return exported, nil
+}
+
+func newExportSink(format string, w io.Writer) (exportSink, error) {
+	switch format {
+	case def.BulkCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(exportColumns); err != nil {
+			return nil, err
+		}
+
+		return csvSink{w: cw}, nil
+	case def.BulkJSONL:
+		return jsonlSink{enc: json.NewEncoder(w)}, nil
+	}
+
+	return nil, ErrUnknownBulkFormat
+}
+
+type csvSink struct {
+	w *csv.Writer
+}
+
+func (s csvSink) write(row def.ExportRow) error {
+	return s.w.Write([]string{
+		strconv.FormatInt(row.ID, 10),
+		row.Email,
+		row.Name,
+		row.Role,
+		row.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+func (s csvSink) flush() error {
+	s.w.Flush()
+
+	return s.w.Error()
+}
+
+type jsonlSink struct {
+	enc *json.Encoder
+}
+
+// write json.Encoder завершает каждый объект переводом строки
+func (s jsonlSink) write(row def.ExportRow) error {
+	return s.enc.Encode(row)
+}
+
+func (s jsonlSink) flush() error {
+	return nil
+}
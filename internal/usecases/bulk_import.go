@@ -0,0 +1,371 @@
+package usecases
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/exp/slog"
+	"golang.org/x/sync/errgroup"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+const (
+	// importBatch сколько строк импорта пишется одной транзакцией
+	importBatch = 500
+	// maxImportErrors сколько ошибок строк возвращается, остальные только считаются
+	maxImportErrors = 1000
+)
+
+var (
+	// ErrUnknownBulkFormat формат импорта или выгрузки не поддерживается
+	ErrUnknownBulkFormat = syserr.New("Поддерживаются форматы csv и jsonl", syserr.InvalidArgument)
+	// ErrImportRead файл импорта не удалось дочитать из-за сбоя ввода-вывода
+	ErrImportRead = syserr.New("Не удалось прочитать файл импорта", syserr.Internal)
+)
+
+// rowError ошибка отдельной строки: импорт ее пропускает и продолжает
+type rowError struct {
+	msg string
+}
+
+func (e rowError) Error() string {
+	return e.msg
+}
+
+// importSource построчное чтение файла импорта
+type importSource interface {
+	// next очередная строка и ее номер в файле. rowError - строку не разобрать, io.EOF - строки кончились
+	next() (def.ImportRow, int64, error)
+}
+
+// importItem проверенная строка импорта
+type importItem struct {
+	line int64
+	user *domain.User
+	// plain пароль еще не хэширован
+	plain bool
+}
+
+// ImportUsers создает пользователей из csv или jsonl. Каждая строка проверяется по правилам домена отдельно,
+// ошибки возвращаются с номером строки, а корректные строки пишутся пачками, каждая в своей транзакции.
+// Пароль задается открытым или готовым bcrypt-хэшем. Пользователь со статусом pending войти не может,
+// пока администратор не активирует его через UnblockUser. Доступно только администраторам
+func (s *Service) ImportUsers(ctx context.Context, format string, r io.Reader) (def.ImportResult, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.ImportUsers"))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("format", format), slog.Int64("auth_user_id", tokenUser.ID))
+
+	if !tokenUser.IsAdmin {
+		return def.ImportResult{}, ErrUserPermissionDenied
+	}
+
+	src, err := newImportSource(format, r)
+	if err != nil {
+		return def.ImportResult{}, err
+	}
+
+	res := def.ImportResult{}
+	seen := make(map[string]int64)
+	batch := make([]importItem, 0, importBatch)
+
+	for {
+		row, line, err := src.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		//ошибки разбора приходят как rowError, все остальное - сбой чтения потока, а не вина файла
+		var rowErr rowError
+		if err != nil && !errors.As(err, &rowErr) {
+			if ctx.Err() != nil {
+				return res, ctx.Err()
+			}
+			log.Error("failed to read import", slog.String("error", err.Error()))
+			return res, ErrImportRead
+		}
+
+		res.Total++
+		if err != nil {
+			addImportError(&res, line, row.Email, err.Error())
+			continue
+		}
+
+		item, err := toImportItem(row)
+		if err != nil {
+			addImportError(&res, line, row.Email, err.Error())
+			continue
+		}
+
+		//почта уже нормализована доменом, поэтому A@x и a@x считаются одной
+		if first, ok := seen[item.user.Email]; ok {
+			addImportError(&res, line, row.Email, fmt.Sprintf("почта уже встречалась в строке %d", first))
+			continue
+		}
+		seen[item.user.Email] = line
+
+		item.line = line
+		batch = append(batch, item)
+		if len(batch) == importBatch {
+			if err = s.importBatch(ctx, batch, &res); err != nil {
+				return res, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if err = s.importBatch(ctx, batch, &res); err != nil {
+			return res, err
+		}
+	}
+
+	log.Info("users imported", slog.Int64("total", res.Total), slog.Int64("imported", res.Imported), slog.Int64("failed", res.Failed))
+
+	return res, nil
+}
+
+// importBatch хэширует открытые пароли и пишет пачку одной транзакцией. Если транзакция не прошла,
+// строки пишутся по одной, чтобы найти виноватые и сохранить остальные
+func (s *Service) importBatch(ctx context.Context, batch []importItem, res *def.ImportResult) error {
+	log := logger.GetLogger(ctx)
+
+	//bcrypt намеренно медленный, поэтому пароли пачки хэшируются параллельно
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range batch {
+		if !batch[i].plain {
+			continue
+		}
+
+		item := &batch[i]
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+
+			hash, err := bcrypt.GenerateFromPassword([]byte(item.user.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return err
+			}
+			item.user.Password = string(hash)
+			item.plain = false
+
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		log.Error("failed to hash imported passwords", slog.String("error", err.Error()))
+		return syserr.New("Не удалось импортировать пользователей", syserr.Internal)
+	}
+
+	details := map[string]string{"source": "import"}
+	err := s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, item := range batch {
+			if errTx := s.storeNew(ctx, item.user, s.usersRepo.SaveHashed, details); errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err == nil {
+		res.Imported += int64(len(batch))
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	log.Warn("import batch failed, retrying rows one by one", slog.String("error", err.Error()))
+	for _, item := range batch {
+		err = s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+			return s.storeNew(ctx, item.user, s.usersRepo.SaveHashed, details)
+		})
+
+		switch {
+		case err == nil:
+			res.Imported++
+		case errors.Is(err, userRepo.ErrEmailTaken):
+			addImportError(res, item.line, item.user.Email, "почта уже используется")
+		case ctx.Err() != nil:
+			return ctx.Err()
+		default:
+			log.Error("failed to import user", slog.Int64("line", item.line), slog.String("error", err.Error()))
+			addImportError(res, item.line, item.user.Email, "не удалось сохранить пользователя")
+		}
+	}
+
+	return nil
+}
+
+// toImportItem проверяет строку по правилам домена
+func toImportItem(row def.ImportRow) (importItem, error) {
+	var isAdmin bool
+	switch row.Role {
+	case "", "user":
+	case "admin":
+		isAdmin = true
+	default:
+		return importItem{}, fmt.Errorf("неизвестная роль %q: ожидается user или admin", row.Role)
+	}
+
+	status := domain.Status(row.Status)
+	switch status {
+	case "":
+		status = domain.StatusActive
+	case domain.StatusActive, domain.StatusPending:
+	default:
+		return importItem{}, fmt.Errorf("неизвестный статус %q: ожидается active или pending", row.Status)
+	}
+
+	password, plain := row.Password, true
+	switch {
+	case row.Password != "" && row.PasswordHash != "":
+		return importItem{}, errors.New("задайте что-то одно: password или password_hash")
+	case row.PasswordHash != "":
+		if _, err := bcrypt.Cost([]byte(row.PasswordHash)); err != nil {
+			return importItem{}, errors.New("password_hash не является bcrypt-хэшем")
+		}
+		password, plain = row.PasswordHash, false
+	}
+
+	var u *domain.User
+	var err error
+	if isAdmin {
+		u, err = domain.NewAdmin(row.Email, password, row.Name)
+	} else {
+		u, err = domain.NewUser(row.Email, password, row.Name)
+	}
+	if err != nil {
+		return importItem{}, err
+	}
+	u.Status = status
+
+	return importItem{user: u, plain: plain}, nil
+}
+
+// addImportError считает ошибку строки и запоминает первые maxImportErrors из них
+func addImportError(res *def.ImportResult, line int64, email, msg string) {
+	res.Failed++
+	if len(res.Errors) < maxImportErrors {
+		res.Errors = append(res.Errors, def.ImportError{Line: line, Email: email, Error: msg})
+	}
+}
+
+func newImportSource(format string, r io.Reader) (importSource, error) {
+	switch format {
+	case def.BulkCSV:
+		return newCSVSource(r)
+	case def.BulkJSONL:
+		return &jsonlSource{r: bufio.NewReader(r)}, nil
+	}
+
+	return nil, ErrUnknownBulkFormat
+}
+
+// csvSource csv с заголовком. Колонки ищутся по имени, лишние колонки (например id из выгрузки) пропускаются
+type csvSource struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVSource(r io.Reader) (*csvSource, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	var parseErr *csv.ParseError
+	switch {
+	case errors.Is(err, io.EOF), errors.As(err, &parseErr):
+		return nil, syserr.New("В csv нет заголовка с названиями колонок", syserr.InvalidArgument)
+	case err != nil:
+		return nil, ErrImportRead
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, syserr.New("В заголовке csv нет колонки email", syserr.InvalidArgument)
+	}
+
+	//строки той же длины, что заголовок
+	cr.FieldsPerRecord = len(header)
+
+	return &csvSource{r: cr, columns: columns}, nil
+}
+
+func (s *csvSource) next() (def.ImportRow, int64, error) {
+	record, err := s.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return def.ImportRow{}, int64(parseErr.StartLine), rowError{msg: parseErr.Err.Error()}
+		}
+
+		return def.ImportRow{}, 0, err
+	}
+
+	line, _ := s.r.FieldPos(0)
+	field := func(name string) string {
+		if i, ok := s.columns[name]; ok {
+			return record[i]
+		}
+
+		return ""
+	}
+
+	return def.ImportRow{
+		Email:        field("email"),
+		Name:         field("name"),
+		Role:         field("role"),
+		Password:     field("password"),
+		PasswordHash: field("password_hash"),
+		Status:       field("status"),
+	}, int64(line), nil
+}
+
+// jsonlSource по одному json-объекту на строку, пустые строки пропускаются
+type jsonlSource struct {
+	r    *bufio.Reader
+	line int64
+}
+
+func (s *jsonlSource) next() (def.ImportRow, int64, error) {
+	for {
+		raw, err := s.r.ReadBytes('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || len(raw) == 0) {
+			return def.ImportRow{}, 0, err
+		}
+		s.line++
+
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 {
+			continue
+		}
+
+		var row def.ImportRow
+		if err = json.Unmarshal(raw, &row); err != nil {
+			return def.ImportRow{}, s.line, rowError{msg: "некорректный json: " + err.Error()}
+		}
+
+		return row, s.line, nil
+	}
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+)
+
+// refreshCached записывает в кэш актуальные данные пользователя после изменения
+// и оповещает остальные экземпляры. Ошибки кэша на результат изменения не влияют, только логируются
+func (s *Service) refreshCached(ctx context.Context, u *domain.User) {
+	log := logger.GetLogger(ctx).With(slog.Int64("user_id", u.ID))
+
+	err := s.usersCache.Save(ctx, u, s.Config.CacheTTL)
+	if err != nil {
+		log.Error("failed to save user to redis cache", slog.String("error", err.Error()))
+		//в кэше могла остаться старая версия, ее нельзя отдавать
+		s.evictCached(ctx, u.ID)
+		return
+	}
+
+	s.notifyInvalidated(ctx, u.ID)
+}
+
+// evictCached удаляет пользователя из кэша и оповещает остальные экземпляры
+func (s *Service) evictCached(ctx context.Context, id int64) {
+	err := s.usersCache.Delete(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to delete user from redis cache",
+			slog.Int64("user_id", id), slog.String("error", err.Error()))
+	}
+
+	s.notifyInvalidated(ctx, id)
+}
+
+func (s *Service) notifyInvalidated(ctx context.Context, id int64) {
+	err := s.invalidator.Publish(ctx, id)
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to publish cache invalidation",
+			slog.Int64("user_id", id), slog.String("error", err.Error()))
+	}
+}
@@ -2,18 +2,20 @@ package usecases
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 
-	"github.com/IBM/sarama"
 	syserr "github.com/neracastle/go-libs/pkg/sys/error"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"golang.org/x/exp/slog"
 
 	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	userRepo "github.com/neracastle/auth/internal/repository/user"
 	def "github.com/neracastle/auth/internal/usecases/models"
 )
 
-// Create создает нового пользователя
+// Create создает нового пользователя. Администратора может создать только администратор,
+// первого - владелец одноразового токена настройки
 func (s *Service) Create(ctx context.Context, req def.CreateDTO) (int64, error) {
 	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.Create"))
 	log.Debug("called")
@@ -35,27 +37,53 @@ func (s *Service) Create(ctx context.Context, req def.CreateDTO) (int64, error)
 		return 0, syserr.NewFromError(err, syserr.InvalidArgument)
 	}
 
-	err = s.usersRepo.Save(ctx, newUser)
+	if req.IsAdmin {
+		restore, errAllow := s.allowAdminCreate(ctx, req.SetupToken)
+		if errAllow != nil {
+			return 0, errAllow
+		}
+		defer func() {
+			if err != nil {
+				restore()
+			}
+		}()
+	}
+
+	//пользователь и событие о нем сохраняются атомарно, в kafka событие отправит relay из outbox
+	err = s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.storeNew(ctx, newUser, s.usersRepo.Save, nil)
+	})
 	if err != nil {
+		if errors.Is(err, userRepo.ErrEmailTaken) {
+			return 0, ErrEmailTaken
+		}
+
 		log.Error("failed to create user", slog.String("error", err.Error()))
 		return 0, syserr.New("Не удалось создать пользователя", syserr.Internal)
 	}
 
-	jsonStr, err := json.Marshal(newUser)
+	return newUser.ID, nil
+}
+
+// storeNew сохраняет нового пользователя через save, пишет его создание в журнал и событие user.created.
+// details дополняют запись журнала. Вызывается в транзакции
+func (s *Service) storeNew(ctx context.Context, newUser *domain.User, save func(context.Context, *domain.User) error, details map[string]string) error {
+	err := save(ctx, newUser)
 	if err != nil {
-		log.Error("failed to marshal user", slog.String("error", err.Error()))
-		return 0, syserr.New("Не удалось создать пользователя", syserr.Internal)
+		return err
 	}
 
-	partition, offset, err := s.producer.SendMessage(&sarama.ProducerMessage{
-		Topic: s.Config.NewUserTopic,
-		Value: sarama.ByteEncoder(jsonStr),
-	})
+	dto := newAction(ctx, newUser.ID, actionCreate)
+	dto.NewValue = newUser.Email
+	dto.Details = map[string]string{"role": roleName(newUser.IsAdmin)}
+	for k, v := range details {
+		dto.Details[k] = v
+	}
+
+	err = s.actionsRepo.Save(ctx, dto)
 	if err != nil {
-		log.Error("failed to send message to kafka", slog.String("error", err.Error()))
-	} else {
-		log.Debug("message sent to kafka", slog.Int("partition", int(partition)), slog.Int64("offset", offset))
+		return err
 	}
 
-	return newUser.ID, nil
+	return s.publish(ctx, events.UserCreated, newUser.ID, events.FromDomainUser(newUser))
 }
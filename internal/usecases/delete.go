@@ -8,11 +8,12 @@ import (
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"golang.org/x/exp/slog"
 
+	"github.com/neracastle/auth/internal/events"
 	"github.com/neracastle/auth/internal/repository/user"
 	"github.com/neracastle/auth/pkg/user_v1/auth"
 )
 
-// Delete удаляет пользователя
+// Delete удаляет пользователя и отзывает его сессии
 func (s *Service) Delete(ctx context.Context, userID int64) error {
 	log := logger.GetLogger(ctx)
 	tokenUser := auth.UserFromContext(ctx)
@@ -22,14 +23,45 @@ func (s *Service) Delete(ctx context.Context, userID int64) error {
 		return ErrUserPermissionDenied
 	}
 
-	err := s.usersRepo.Delete(ctx, userID)
+	err := s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		//сессии отзываются, иначе Restore вернет в строй все выданные до удаления refresh-токены
+		dbUser, errTx := s.usersRepo.Get(ctx, user.SearchFilter{ID: userID})
+		if errTx != nil {
+			return errTx
+		}
+
+		dbUser.RevokeSessions()
+		errTx = s.usersRepo.Update(ctx, dbUser)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.usersRepo.Delete(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.actionsRepo.Save(ctx, newAction(ctx, userID, actionDelete))
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.publish(ctx, events.UserDeleted, userID, events.Deleted{UserID: userID})
+	})
 	if err != nil {
 		if errors.Is(err, user.ErrUserNotFound) {
 			return ErrUserNotFound
 		}
 
+		if errors.Is(err, user.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
+
 		return syserr.New("Не удалось удалить пользователя", syserr.Internal)
 	}
 
+	//иначе удаленный пользователь будет отдаваться из кэша до истечения ttl
+	s.evictCached(ctx, userID)
+
 	return nil
 }
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/neracastle/auth/internal/events"
+	outboxModel "github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// eventTypeHeader заголовок с типом события, чтобы получатели могли отфильтровать сообщения без разбора тела
+const eventTypeHeader = "event_type"
+
+// publish сохраняет событие в outbox. Чтобы событие не разошлось с данными, вызывается в той же транзакции.
+// Ключ партиционирования - id пользователя, так события одного пользователя приходят по порядку
+func (s *Service) publish(ctx context.Context, eventType string, userID int64, payload any) error {
+	topic := s.Config.EventTopics[eventType]
+	if topic == "" {
+		return fmt.Errorf("topic for event %s is not configured", eventType)
+	}
+
+	env, err := events.New(eventType, actorFromContext(ctx), payload)
+	if err != nil {
+		return err
+	}
+
+	raw, err := events.Marshal(env, s.Config.EventsFormat)
+	if err != nil {
+		return err
+	}
+
+	contentType, err := events.ContentType(s.Config.EventsFormat)
+	if err != nil {
+		return err
+	}
+
+	return s.outboxRepo.Save(ctx, outboxModel.MessageDTO{
+		ID:      env.ID,
+		Topic:   topic,
+		Key:     strconv.FormatInt(userID, 10),
+		Payload: raw,
+		Headers: map[string]string{
+			eventTypeHeader:          eventType,
+			events.ContentTypeHeader: contentType,
+		},
+	})
+}
+
+// actorFromContext инициатор действия из access-токена, nil для неавторизованных запросов
+func actorFromContext(ctx context.Context) *events.Actor {
+	tokenUser, ok := auth.LookupUser(ctx)
+	if !ok {
+		return nil
+	}
+
+	return &events.Actor{UserID: tokenUser.ID, IsAdmin: tokenUser.IsAdmin}
+}
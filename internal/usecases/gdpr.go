@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// exportBatch по сколько записей журнала читается при выгрузке данных
+const exportBatch = 1000
+
+// ExportMyData собирает персональные данные пользователя в json-архив: профиль, сессии, журнал действий
+// и способы входа. userID 0 - данные того, кто запрашивает. Чужие данные выгружает только администратор
+func (s *Service) ExportMyData(ctx context.Context, userID int64) ([]byte, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.ExportMyData"))
+	tokenUser := auth.UserFromContext(ctx)
+	if userID == 0 {
+		userID = tokenUser.ID
+	}
+	log.Debug("called", slog.Int64("user_id", userID), slog.Int64("auth_user_id", tokenUser.ID))
+
+	if tokenUser.ID != userID && !tokenUser.IsAdmin {
+		return nil, ErrUserPermissionDenied
+	}
+
+	//мимо кэша: в архив должен попасть профиль из бд, а в кэше нет части полей
+	dbUser, err := s.usersRepo.Get(ctx, userRepo.SearchFilter{ID: userID})
+	if err != nil {
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		log.Error("failed to get user", slog.String("error", err.Error()))
+		return nil, syserr.New("Не удалось выгрузить данные пользователя", syserr.Internal)
+	}
+
+	actions, err := s.subjectActions(ctx, userID)
+	if err != nil {
+		log.Error("failed to read audit log", slog.String("error", err.Error()))
+		return nil, syserr.New("Не удалось выгрузить данные пользователя", syserr.Internal)
+	}
+
+	export := def.DataExport{
+		ExportedAt: time.Now().UTC(),
+		Profile: def.ExportProfile{
+			ID:        dbUser.ID,
+			Email:     dbUser.Email,
+			Name:      dbUser.Name,
+			Role:      roleName(dbUser.IsAdmin),
+			CreatedAt: dbUser.RegDate,
+		},
+		Sessions: def.ExportSessions{Generation: dbUser.SessionVersion, Logins: []def.ExportLogin{}},
+		//войти можно паролем и, если сервис их рассылает, ссылкой на ту же почту. Внешних провайдеров нет
+		LinkedIdentities: []def.ExportIdentity{{Provider: events.LoginPassword, Subject: dbUser.Email}},
+		AuditEvents:      make([]def.ExportEvent, 0, len(actions)),
+	}
+	if s.Config.LoginLinkURL != "" {
+		export.LinkedIdentities = append(export.LinkedIdentities, def.ExportIdentity{Provider: events.LoginMagicLink, Subject: dbUser.Email})
+	}
+
+	for _, a := range actions {
+		if a.Name == actionLogin && a.UserID == userID {
+			export.Sessions.Logins = append(export.Sessions.Logins, def.ExportLogin{At: a.CreatedAt, Method: a.Details["method"], IP: a.IP})
+		}
+
+		export.AuditEvents = append(export.AuditEvents, def.ExportEvent{
+			ID:        a.ID,
+			UserID:    a.UserID,
+			ActorID:   a.ActorID,
+			Action:    a.Name,
+			OldValue:  a.OldValue,
+			NewValue:  a.NewValue,
+			IP:        a.IP,
+			Details:   a.Details,
+			CreatedAt: a.CreatedAt,
+		})
+	}
+
+	return json.Marshal(export)
+}
+
+// subjectActions записи журнала над пользователем и совершенные им, в порядке id
+func (s *Service) subjectActions(ctx context.Context, userID int64) ([]model.ActionDTO, error) {
+	var found []model.ActionDTO
+	for _, filter := range []action.Filter{{UserID: userID}, {ActorID: userID}} {
+		filter.Limit = exportBatch
+		for {
+			batch, err := s.actionsRepo.List(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+
+			found = append(found, batch...)
+			if uint64(len(batch)) < filter.Limit {
+				break
+			}
+			filter.AfterID = batch[len(batch)-1].ID
+		}
+	}
+
+	//действия пользователя над собой попали в обе выборки
+	slices.SortFunc(found, func(a, b model.ActionDTO) int { return cmp.Compare(a.ID, b.ID) })
+
+	return slices.CompactFunc(found, func(a, b model.ActionDTO) bool { return a.ID == b.ID }), nil
+}
+
+// EraseUser стирает персональные данные пользователя по его запросу (право на забвение).
+// Строка пользователя и записи журнала остаются, чтобы ссылки на id не повисли, но почта, имя, пароль,
+// значения полей и ip в них обезличиваются. Отправленные события пользователя удаляются из outbox, в них почта и имя.
+// Другие сервисы стирают свои копии по событию user.erased.
+// Стереть себя может сам пользователь, любого - администратор
+func (s *Service) EraseUser(ctx context.Context, userID int64) (def.Erasure, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.EraseUser"))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("user_id", userID), slog.Int64("auth_user_id", tokenUser.ID))
+
+	if tokenUser.ID != userID && !tokenUser.IsAdmin {
+		return def.Erasure{}, ErrUserPermissionDenied
+	}
+
+	res := def.Erasure{ErasedAt: time.Now().UTC().Truncate(time.Second)}
+	err := s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.usersRepo.Erase(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.loginLinksRepo.DeleteByUser(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		//неотправленные события остаются: получатели должны получить их раньше user.erased
+		_, errTx = s.outboxRepo.DeleteSentByKey(ctx, strconv.FormatInt(userID, 10))
+		if errTx != nil {
+			return errTx
+		}
+
+		res.RedactedActions, errTx = s.actionsRepo.Redact(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		//запись о стирании подтверждает обезличенные записи при проверке цепочки и сама не должна хранить ip субъекта
+		dto := newAction(ctx, userID, actionErase)
+		if dto.ActorID == userID {
+			dto.IP = ""
+		}
+		dto.Details = map[string]string{"redacted_actions": strconv.FormatInt(res.RedactedActions, 10)}
+		errTx = s.actionsRepo.Save(ctx, dto)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.publish(ctx, events.UserErased, userID, events.Erased{UserID: userID, ErasedAt: res.ErasedAt})
+	})
+	if err != nil {
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return def.Erasure{}, ErrUserNotFound
+		}
+
+		log.Error("failed to erase user", slog.String("error", err.Error()))
+		return def.Erasure{}, syserr.New("Не удалось стереть данные пользователя", syserr.Internal)
+	}
+
+	s.evictCached(ctx, userID)
+	log.Info("user erased", slog.Int64("user_id", userID), slog.Int64("redacted_actions", res.RedactedActions))
+
+	return res, nil
+}
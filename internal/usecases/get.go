@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"github.com/neracastle/go-libs/pkg/sys/tracer"
@@ -11,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slog"
 
+	domain "github.com/neracastle/auth/internal/domain/user"
 	"github.com/neracastle/auth/internal/repository/user"
 	"github.com/neracastle/auth/internal/usecases/models"
 	"github.com/neracastle/auth/pkg/user_v1/auth"
@@ -48,7 +50,11 @@ func (s *Service) Get(ctx context.Context, userID int64) (models.UserDTO, error)
 		log.Debug("user not cached")
 	}
 
-	dbUser, err = s.usersRepo.Get(ctx, user.SearchFilter{ID: userID})
+	//одновременные промахи по одному id выполняют один запрос в бд, остальные ждут его результат.
+	//отмена запроса, начавшего загрузку, не должна обрывать ее для остальных
+	loaded, err, _ := s.loads.Do(strconv.FormatInt(userID, 10), func() (interface{}, error) {
+		return s.loadUser(context.WithoutCancel(ctx), userID)
+	})
 	if err != nil {
 		if errors.Is(err, user.ErrUserNotFound) {
 			return models.UserDTO{}, ErrUserNotFound
@@ -60,15 +66,22 @@ func (s *Service) Get(ctx context.Context, userID int64) (models.UserDTO, error)
 		return models.UserDTO{}, err
 	}
 
+	return models.FromDomainToUsecase(loaded.(*domain.User)), nil
+}
+
+// loadUser читает пользователя из бд и кладет в кэш
+func (s *Service) loadUser(ctx context.Context, userID int64) (*domain.User, error) {
+	dbUser, err := s.usersRepo.Get(ctx, user.SearchFilter{ID: userID})
+	if err != nil {
+		return nil, err
+	}
+
 	//ошибка сохранения в кэш не влияет на выдачу результата, просто залогируем
 	err = s.usersCache.Save(ctx, dbUser, s.Config.CacheTTL)
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		log.Error("failed to save user to redis cache", slog.String("error", err.Error()))
-	} else {
-		log.Debug("user saved to cache")
+		logger.GetLogger(ctx).Error("failed to save user to redis cache",
+			slog.Int64("user_id", userID), slog.String("error", err.Error()))
 	}
 
-	return models.FromDomainToUsecase(dbUser), nil
+	return dbUser, nil
 }
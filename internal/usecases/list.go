@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+var (
+	// ErrInvalidCursor курсор поврежден или выдан для другой сортировки
+	ErrInvalidCursor = syserr.New("Некорректный курсор", syserr.InvalidArgument)
+	// ErrInvalidSortField сортировка по неизвестному полю
+	ErrInvalidSortField = syserr.New("Неизвестное поле сортировки", syserr.InvalidArgument)
+)
+
+// listCursor содержимое курсора. Сортировка хранится в нем, чтобы курсор нельзя было применить к другой выборке
+type listCursor struct {
+	SortBy user.SortField `json:"s"`
+	Desc   bool           `json:"d"`
+	Value  string         `json:"v"`
+	ID     int64          `json:"i"`
+}
+
+// ListUsers возвращает страницу списка пользователей. Доступно только администраторам
+func (s *Service) ListUsers(ctx context.Context, req models.ListDTO) (models.UsersPage, error) {
+	const method = "usecases.ListUsers"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method)
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("auth_user_id", tokenUser.ID))
+
+	if !tokenUser.IsAdmin {
+		return models.UsersPage{}, ErrUserPermissionDenied
+	}
+
+	sortBy, err := toSortField(req.SortBy)
+	if err != nil {
+		return models.UsersPage{}, err
+	}
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	opts := user.ListOptions{
+		//берем на одну запись больше, чтобы понять, есть ли следующая страница
+		Limit:  limit + 1,
+		SortBy: sortBy,
+		Desc:   req.Desc,
+	}
+
+	if req.Cursor != "" {
+		cur, err := decodeCursor(req.Cursor)
+		if err != nil || cur.SortBy != sortBy || cur.Desc != req.Desc {
+			return models.UsersPage{}, ErrInvalidCursor
+		}
+
+		opts.After = &user.Cursor{Value: cur.Value, ID: cur.ID}
+	}
+
+	users, err := s.usersRepo.List(ctx, user.SearchFilter{
+		IsAdmin:     req.IsAdmin,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Query:       req.Query,
+	}, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return models.UsersPage{}, syserr.New("Не удалось получить список пользователей", syserr.Internal)
+	}
+
+	page := models.UsersPage{Users: make([]models.UserDTO, 0, len(users))}
+	if uint64(len(users)) > limit {
+		users = users[:limit]
+		last := user.CursorFor(users[len(users)-1], sortBy)
+		page.NextCursor = encodeCursor(listCursor{SortBy: sortBy, Desc: req.Desc, Value: last.Value, ID: last.ID})
+	}
+
+	for _, u := range users {
+		page.Users = append(page.Users, models.FromDomainToUsecase(u))
+	}
+
+	return page, nil
+}
+
+func toSortField(sortBy string) (user.SortField, error) {
+	switch user.SortField(sortBy) {
+	case "", user.SortByID:
+		return user.SortByID, nil
+	case user.SortByEmail, user.SortByName, user.SortByCreatedAt:
+		return user.SortField(sortBy), nil
+	default:
+		return "", ErrInvalidSortField
+	}
+}
+
+func encodeCursor(c listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+
+	err = json.Unmarshal(b, &c)
+
+	return c, err
+}
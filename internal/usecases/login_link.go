@@ -0,0 +1,192 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/mailer"
+	"github.com/neracastle/auth/internal/repository/loginlink"
+	"github.com/neracastle/auth/internal/repository/loginlink/postgres/model"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/usecases/models"
+)
+
+var (
+	// ErrLoginLinkInvalid ссылка для входа подделана, истекла или уже использована
+	ErrLoginLinkInvalid = syserr.New("Ссылка для входа недействительна", syserr.Unauthenticated)
+	// ErrLoginLinkTooMany превышено кол-во запросов ссылки на один адрес
+	ErrLoginLinkTooMany = syserr.New("Слишком много запросов ссылки для входа, попробуйте позже", syserr.ResourceExhausted)
+)
+
+// loginLinkAudience отличает токен ссылки от access/refresh токенов, подписанных тем же ключом
+const loginLinkAudience = "login_link"
+
+type loginLinkClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// RequestLoginLink отправляет на почту одноразовую ссылку для входа без пароля.
+// Если пользователя с такой почтой нет, ошибка не возвращается, чтобы не раскрывать наличие аккаунта
+func (s *Service) RequestLoginLink(ctx context.Context, email string) error {
+	const method = "usecases.RequestLoginLink"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method)
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	log.Debug("called")
+
+	email = domain.NormalizeEmail(email)
+	if email == "" {
+		return syserr.NewFromError(domain.ErrEmptyEmail, syserr.InvalidArgument)
+	}
+
+	cnt, err := s.loginLinksRepo.CountSince(ctx, email, s.Config.LoginLinkRatePeriod)
+	if err != nil {
+		return syserr.New("Не удалось отправить ссылку для входа", syserr.Internal)
+	}
+
+	if cnt >= s.Config.LoginLinkRateLimit {
+		return ErrLoginLinkTooMany
+	}
+
+	dbUser, err := s.usersRepo.Get(ctx, user.SearchFilter{Email: email})
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			log.Debug("login link requested for unknown email")
+			return nil
+		}
+
+		return err
+	}
+
+	linkID := uuid.NewString()
+	token, err := s.signLoginLink(linkID, dbUser.Email)
+	if err != nil {
+		log.Error("failed to sign login link", slog.String("error", err.Error()))
+		return syserr.New("Не удалось отправить ссылку для входа", syserr.Internal)
+	}
+
+	err = s.loginLinksRepo.Save(ctx, model.LinkDTO{
+		ID:     linkID,
+		UserID: dbUser.ID,
+		Email:  dbUser.Email,
+		TTL:    s.Config.LoginLinkTTL,
+	})
+	if err != nil {
+		return syserr.New("Не удалось отправить ссылку для входа", syserr.Internal)
+	}
+
+	err = s.actionsRepo.Save(ctx, newAction(ctx, dbUser.ID, actionRequestLoginLink))
+	if err != nil {
+		log.Error("failed to record login link request", slog.String("error", err.Error()))
+	}
+
+	link := fmt.Sprintf("%s?token=%s", s.Config.LoginLinkURL, url.QueryEscape(token))
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      dbUser.Email,
+		Subject: s.Config.LoginLinkSubject,
+		Body:    fmt.Sprintf("Для входа перейдите по ссылке (действует %s):\n%s", s.Config.LoginLinkTTL, link),
+	})
+	if err != nil {
+		log.Error("failed to send login link", slog.String("error", err.Error()))
+		return syserr.New("Не удалось отправить ссылку для входа", syserr.Internal)
+	}
+
+	return nil
+}
+
+// ConsumeLoginLink проверяет ссылку для входа и выдает токены. Каждая ссылка срабатывает только один раз
+func (s *Service) ConsumeLoginLink(ctx context.Context, token string) (models.AuthTokens, error) {
+	const method = "usecases.ConsumeLoginLink"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method)
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	log.Debug("called")
+
+	claims, err := s.parseLoginLink(token)
+	if err != nil {
+		log.Debug("invalid login link", slog.String("error", err.Error()))
+		return models.AuthTokens{}, ErrLoginLinkInvalid
+	}
+
+	userID, err := s.loginLinksRepo.Consume(ctx, claims.ID)
+	if err != nil {
+		if errors.Is(err, loginlink.ErrLinkNotFound) {
+			return models.AuthTokens{}, ErrLoginLinkInvalid
+		}
+
+		return models.AuthTokens{}, err
+	}
+
+	dbUser, err := s.usersRepo.Get(ctx, user.SearchFilter{ID: userID})
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return models.AuthTokens{}, ErrLoginLinkInvalid
+		}
+
+		return models.AuthTokens{}, err
+	}
+
+	//почту могли сменить после выдачи ссылки, тогда ссылка больше не действует
+	if dbUser.Email != claims.Email {
+		return models.AuthTokens{}, ErrLoginLinkInvalid
+	}
+
+	err = s.checkSignIn(ctx, dbUser, events.LoginMagicLink)
+	if err != nil {
+		return models.AuthTokens{}, err
+	}
+
+	span.AddEvent("generate tokens")
+	s.recordLogin(ctx, dbUser.ID, events.LoginMagicLink)
+
+	return s.issueTokens(dbUser)
+}
+
+func (s *Service) signLoginLink(linkID string, email string) (string, error) {
+	claims := loginLinkClaims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        linkID,
+			Audience:  jwt.ClaimStrings{loginLinkAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.Config.LoginLinkTTL)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.Config.SecretKey))
+}
+
+func (s *Service) parseLoginLink(token string) (*loginLinkClaims, error) {
+	parsed, err := jwt.ParseWithClaims(token, &loginLinkClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(s.Config.SecretKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(loginLinkAudience),
+		jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := parsed.Claims.(*loginLinkClaims)
+	if !ok || claims.ID == "" {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	return claims, nil
+}
@@ -6,8 +6,10 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -19,18 +21,60 @@ type UserServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcArmSetupToken          func(ctx context.Context, token string) (s1 string, err error)
+	inspectFuncArmSetupToken   func(ctx context.Context, token string)
+	afterArmSetupTokenCounter  uint64
+	beforeArmSetupTokenCounter uint64
+	ArmSetupTokenMock          mUserServiceMockArmSetupToken
+
 	funcAuth          func(ctx context.Context, login string, pwd string) (a1 def.AuthTokens, err error)
 	inspectFuncAuth   func(ctx context.Context, login string, pwd string)
 	afterAuthCounter  uint64
 	beforeAuthCounter uint64
 	AuthMock          mUserServiceMockAuth
 
+	funcBatchGet          func(ctx context.Context, userIDs []int64) (ua1 []def.UserDTO, ia1 []int64, err error)
+	inspectFuncBatchGet   func(ctx context.Context, userIDs []int64)
+	afterBatchGetCounter  uint64
+	beforeBatchGetCounter uint64
+	BatchGetMock          mUserServiceMockBatchGet
+
+	funcBlockUser          func(ctx context.Context, userID int64, reason string, until time.Time) (u1 def.UserDTO, err error)
+	inspectFuncBlockUser   func(ctx context.Context, userID int64, reason string, until time.Time)
+	afterBlockUserCounter  uint64
+	beforeBlockUserCounter uint64
+	BlockUserMock          mUserServiceMockBlockUser
+
+	funcBootstrapAdmin          func(ctx context.Context, req def.CreateDTO) (i1 int64, err error)
+	inspectFuncBootstrapAdmin   func(ctx context.Context, req def.CreateDTO)
+	afterBootstrapAdminCounter  uint64
+	beforeBootstrapAdminCounter uint64
+	BootstrapAdminMock          mUserServiceMockBootstrapAdmin
+
 	funcCanDelete          func(ctx context.Context, userID int64) (b1 bool)
 	inspectFuncCanDelete   func(ctx context.Context, userID int64)
 	afterCanDeleteCounter  uint64
 	beforeCanDeleteCounter uint64
 	CanDeleteMock          mUserServiceMockCanDelete
 
+	funcCheckStatus          func(ctx context.Context, userID int64) (err error)
+	inspectFuncCheckStatus   func(ctx context.Context, userID int64)
+	afterCheckStatusCounter  uint64
+	beforeCheckStatusCounter uint64
+	CheckStatusMock          mUserServiceMockCheckStatus
+
+	funcCheckpointAudit          func(ctx context.Context) (err error)
+	inspectFuncCheckpointAudit   func(ctx context.Context)
+	afterCheckpointAuditCounter  uint64
+	beforeCheckpointAuditCounter uint64
+	CheckpointAuditMock          mUserServiceMockCheckpointAudit
+
+	funcConsumeLoginLink          func(ctx context.Context, token string) (a1 def.AuthTokens, err error)
+	inspectFuncConsumeLoginLink   func(ctx context.Context, token string)
+	afterConsumeLoginLinkCounter  uint64
+	beforeConsumeLoginLinkCounter uint64
+	ConsumeLoginLinkMock          mUserServiceMockConsumeLoginLink
+
 	funcCreate          func(ctx context.Context, req def.CreateDTO) (i1 int64, err error)
 	inspectFuncCreate   func(ctx context.Context, req def.CreateDTO)
 	afterCreateCounter  uint64
@@ -43,23 +87,113 @@ type UserServiceMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mUserServiceMockDelete
 
+	funcEraseUser          func(ctx context.Context, userID int64) (e1 def.Erasure, err error)
+	inspectFuncEraseUser   func(ctx context.Context, userID int64)
+	afterEraseUserCounter  uint64
+	beforeEraseUserCounter uint64
+	EraseUserMock          mUserServiceMockEraseUser
+
+	funcExportMyData          func(ctx context.Context, userID int64) (ba1 []byte, err error)
+	inspectFuncExportMyData   func(ctx context.Context, userID int64)
+	afterExportMyDataCounter  uint64
+	beforeExportMyDataCounter uint64
+	ExportMyDataMock          mUserServiceMockExportMyData
+
+	funcExportUsers          func(ctx context.Context, format string, w io.Writer) (i1 int64, err error)
+	inspectFuncExportUsers   func(ctx context.Context, format string, w io.Writer)
+	afterExportUsersCounter  uint64
+	beforeExportUsersCounter uint64
+	ExportUsersMock          mUserServiceMockExportUsers
+
 	funcGet          func(ctx context.Context, userID int64) (u1 def.UserDTO, err error)
 	inspectFuncGet   func(ctx context.Context, userID int64)
 	afterGetCounter  uint64
 	beforeGetCounter uint64
 	GetMock          mUserServiceMockGet
 
+	funcImportUsers          func(ctx context.Context, format string, r io.Reader) (i1 def.ImportResult, err error)
+	inspectFuncImportUsers   func(ctx context.Context, format string, r io.Reader)
+	afterImportUsersCounter  uint64
+	beforeImportUsersCounter uint64
+	ImportUsersMock          mUserServiceMockImportUsers
+
+	funcListAuditEvents          func(ctx context.Context, req def.AuditListDTO) (a1 def.AuditPage, err error)
+	inspectFuncListAuditEvents   func(ctx context.Context, req def.AuditListDTO)
+	afterListAuditEventsCounter  uint64
+	beforeListAuditEventsCounter uint64
+	ListAuditEventsMock          mUserServiceMockListAuditEvents
+
+	funcListUsers          func(ctx context.Context, req def.ListDTO) (u1 def.UsersPage, err error)
+	inspectFuncListUsers   func(ctx context.Context, req def.ListDTO)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserServiceMockListUsers
+
+	funcPurgeDeleted          func(ctx context.Context) (i1 int64, err error)
+	inspectFuncPurgeDeleted   func(ctx context.Context)
+	afterPurgeDeletedCounter  uint64
+	beforePurgeDeletedCounter uint64
+	PurgeDeletedMock          mUserServiceMockPurgeDeleted
+
 	funcRenewal          func(ctx context.Context, refreshToken string, isRenewAccess bool) (s1 string, err error)
 	inspectFuncRenewal   func(ctx context.Context, refreshToken string, isRenewAccess bool)
 	afterRenewalCounter  uint64
 	beforeRenewalCounter uint64
 	RenewalMock          mUserServiceMockRenewal
 
+	funcRequestLoginLink          func(ctx context.Context, email string) (err error)
+	inspectFuncRequestLoginLink   func(ctx context.Context, email string)
+	afterRequestLoginLinkCounter  uint64
+	beforeRequestLoginLinkCounter uint64
+	RequestLoginLinkMock          mUserServiceMockRequestLoginLink
+
+	funcResetPassword          func(ctx context.Context, userID int64, password string, passwordConfirm string) (err error)
+	inspectFuncResetPassword   func(ctx context.Context, userID int64, password string, passwordConfirm string)
+	afterResetPasswordCounter  uint64
+	beforeResetPasswordCounter uint64
+	ResetPasswordMock          mUserServiceMockResetPassword
+
+	funcRestore          func(ctx context.Context, userID int64) (err error)
+	inspectFuncRestore   func(ctx context.Context, userID int64)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mUserServiceMockRestore
+
+	funcRevokeSessions          func(ctx context.Context, userID int64) (err error)
+	inspectFuncRevokeSessions   func(ctx context.Context, userID int64)
+	afterRevokeSessionsCounter  uint64
+	beforeRevokeSessionsCounter uint64
+	RevokeSessionsMock          mUserServiceMockRevokeSessions
+
+	funcSearchUsers          func(ctx context.Context, query string, limit uint32) (sa1 []def.SearchHitDTO, err error)
+	inspectFuncSearchUsers   func(ctx context.Context, query string, limit uint32)
+	afterSearchUsersCounter  uint64
+	beforeSearchUsersCounter uint64
+	SearchUsersMock          mUserServiceMockSearchUsers
+
+	funcTrackActivity          func(ctx context.Context, userID int64, at time.Time) (err error)
+	inspectFuncTrackActivity   func(ctx context.Context, userID int64, at time.Time)
+	afterTrackActivityCounter  uint64
+	beforeTrackActivityCounter uint64
+	TrackActivityMock          mUserServiceMockTrackActivity
+
+	funcUnblockUser          func(ctx context.Context, userID int64, reason string) (u1 def.UserDTO, err error)
+	inspectFuncUnblockUser   func(ctx context.Context, userID int64, reason string)
+	afterUnblockUserCounter  uint64
+	beforeUnblockUserCounter uint64
+	UnblockUserMock          mUserServiceMockUnblockUser
+
 	funcUpdate          func(ctx context.Context, user def.UpdateDTO) (err error)
 	inspectFuncUpdate   func(ctx context.Context, user def.UpdateDTO)
 	afterUpdateCounter  uint64
 	beforeUpdateCounter uint64
 	UpdateMock          mUserServiceMockUpdate
+
+	funcVerifyAuditLog          func(ctx context.Context) (a1 def.AuditVerification, err error)
+	inspectFuncVerifyAuditLog   func(ctx context.Context)
+	afterVerifyAuditLogCounter  uint64
+	beforeVerifyAuditLogCounter uint64
+	VerifyAuditLogMock          mUserServiceMockVerifyAuditLog
 }
 
 // NewUserServiceMock returns a mock for usecases.UserService
@@ -70,32 +204,315 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ArmSetupTokenMock = mUserServiceMockArmSetupToken{mock: m}
+	m.ArmSetupTokenMock.callArgs = []*UserServiceMockArmSetupTokenParams{}
+
 	m.AuthMock = mUserServiceMockAuth{mock: m}
 	m.AuthMock.callArgs = []*UserServiceMockAuthParams{}
 
+	m.BatchGetMock = mUserServiceMockBatchGet{mock: m}
+	m.BatchGetMock.callArgs = []*UserServiceMockBatchGetParams{}
+
+	m.BlockUserMock = mUserServiceMockBlockUser{mock: m}
+	m.BlockUserMock.callArgs = []*UserServiceMockBlockUserParams{}
+
+	m.BootstrapAdminMock = mUserServiceMockBootstrapAdmin{mock: m}
+	m.BootstrapAdminMock.callArgs = []*UserServiceMockBootstrapAdminParams{}
+
 	m.CanDeleteMock = mUserServiceMockCanDelete{mock: m}
 	m.CanDeleteMock.callArgs = []*UserServiceMockCanDeleteParams{}
 
+	m.CheckStatusMock = mUserServiceMockCheckStatus{mock: m}
+	m.CheckStatusMock.callArgs = []*UserServiceMockCheckStatusParams{}
+
+	m.CheckpointAuditMock = mUserServiceMockCheckpointAudit{mock: m}
+	m.CheckpointAuditMock.callArgs = []*UserServiceMockCheckpointAuditParams{}
+
+	m.ConsumeLoginLinkMock = mUserServiceMockConsumeLoginLink{mock: m}
+	m.ConsumeLoginLinkMock.callArgs = []*UserServiceMockConsumeLoginLinkParams{}
+
 	m.CreateMock = mUserServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserServiceMockCreateParams{}
 
 	m.DeleteMock = mUserServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*UserServiceMockDeleteParams{}
 
+	m.EraseUserMock = mUserServiceMockEraseUser{mock: m}
+	m.EraseUserMock.callArgs = []*UserServiceMockEraseUserParams{}
+
+	m.ExportMyDataMock = mUserServiceMockExportMyData{mock: m}
+	m.ExportMyDataMock.callArgs = []*UserServiceMockExportMyDataParams{}
+
+	m.ExportUsersMock = mUserServiceMockExportUsers{mock: m}
+	m.ExportUsersMock.callArgs = []*UserServiceMockExportUsersParams{}
+
 	m.GetMock = mUserServiceMockGet{mock: m}
 	m.GetMock.callArgs = []*UserServiceMockGetParams{}
 
+	m.ImportUsersMock = mUserServiceMockImportUsers{mock: m}
+	m.ImportUsersMock.callArgs = []*UserServiceMockImportUsersParams{}
+
+	m.ListAuditEventsMock = mUserServiceMockListAuditEvents{mock: m}
+	m.ListAuditEventsMock.callArgs = []*UserServiceMockListAuditEventsParams{}
+
+	m.ListUsersMock = mUserServiceMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserServiceMockListUsersParams{}
+
+	m.PurgeDeletedMock = mUserServiceMockPurgeDeleted{mock: m}
+	m.PurgeDeletedMock.callArgs = []*UserServiceMockPurgeDeletedParams{}
+
 	m.RenewalMock = mUserServiceMockRenewal{mock: m}
 	m.RenewalMock.callArgs = []*UserServiceMockRenewalParams{}
 
+	m.RequestLoginLinkMock = mUserServiceMockRequestLoginLink{mock: m}
+	m.RequestLoginLinkMock.callArgs = []*UserServiceMockRequestLoginLinkParams{}
+
+	m.ResetPasswordMock = mUserServiceMockResetPassword{mock: m}
+	m.ResetPasswordMock.callArgs = []*UserServiceMockResetPasswordParams{}
+
+	m.RestoreMock = mUserServiceMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*UserServiceMockRestoreParams{}
+
+	m.RevokeSessionsMock = mUserServiceMockRevokeSessions{mock: m}
+	m.RevokeSessionsMock.callArgs = []*UserServiceMockRevokeSessionsParams{}
+
+	m.SearchUsersMock = mUserServiceMockSearchUsers{mock: m}
+	m.SearchUsersMock.callArgs = []*UserServiceMockSearchUsersParams{}
+
+	m.TrackActivityMock = mUserServiceMockTrackActivity{mock: m}
+	m.TrackActivityMock.callArgs = []*UserServiceMockTrackActivityParams{}
+
+	m.UnblockUserMock = mUserServiceMockUnblockUser{mock: m}
+	m.UnblockUserMock.callArgs = []*UserServiceMockUnblockUserParams{}
+
 	m.UpdateMock = mUserServiceMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserServiceMockUpdateParams{}
 
+	m.VerifyAuditLogMock = mUserServiceMockVerifyAuditLog{mock: m}
+	m.VerifyAuditLogMock.callArgs = []*UserServiceMockVerifyAuditLogParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mUserServiceMockArmSetupToken struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockArmSetupTokenExpectation
+	expectations       []*UserServiceMockArmSetupTokenExpectation
+
+	callArgs []*UserServiceMockArmSetupTokenParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockArmSetupTokenExpectation specifies expectation struct of the UserService.ArmSetupToken
+type UserServiceMockArmSetupTokenExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockArmSetupTokenParams
+	results *UserServiceMockArmSetupTokenResults
+	Counter uint64
+}
+
+// UserServiceMockArmSetupTokenParams contains parameters of the UserService.ArmSetupToken
+type UserServiceMockArmSetupTokenParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UserServiceMockArmSetupTokenResults contains results of the UserService.ArmSetupToken
+type UserServiceMockArmSetupTokenResults struct {
+	s1  string
+	err error
+}
+
+// Expect sets up expected params for UserService.ArmSetupToken
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) Expect(ctx context.Context, token string) *mUserServiceMockArmSetupToken {
+	if mmArmSetupToken.mock.funcArmSetupToken != nil {
+		mmArmSetupToken.mock.t.Fatalf("UserServiceMock.ArmSetupToken mock is already set by Set")
+	}
+
+	if mmArmSetupToken.defaultExpectation == nil {
+		mmArmSetupToken.defaultExpectation = &UserServiceMockArmSetupTokenExpectation{}
+	}
+
+	mmArmSetupToken.defaultExpectation.params = &UserServiceMockArmSetupTokenParams{ctx, token}
+	for _, e := range mmArmSetupToken.expectations {
+		if minimock.Equal(e.params, mmArmSetupToken.defaultExpectation.params) {
+			mmArmSetupToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArmSetupToken.defaultExpectation.params)
+		}
+	}
+
+	return mmArmSetupToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ArmSetupToken
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) Inspect(f func(ctx context.Context, token string)) *mUserServiceMockArmSetupToken {
+	if mmArmSetupToken.mock.inspectFuncArmSetupToken != nil {
+		mmArmSetupToken.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ArmSetupToken")
+	}
+
+	mmArmSetupToken.mock.inspectFuncArmSetupToken = f
+
+	return mmArmSetupToken
+}
+
+// Return sets up results that will be returned by UserService.ArmSetupToken
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) Return(s1 string, err error) *UserServiceMock {
+	if mmArmSetupToken.mock.funcArmSetupToken != nil {
+		mmArmSetupToken.mock.t.Fatalf("UserServiceMock.ArmSetupToken mock is already set by Set")
+	}
+
+	if mmArmSetupToken.defaultExpectation == nil {
+		mmArmSetupToken.defaultExpectation = &UserServiceMockArmSetupTokenExpectation{mock: mmArmSetupToken.mock}
+	}
+	mmArmSetupToken.defaultExpectation.results = &UserServiceMockArmSetupTokenResults{s1, err}
+	return mmArmSetupToken.mock
+}
+
+// Set uses given function f to mock the UserService.ArmSetupToken method
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) Set(f func(ctx context.Context, token string) (s1 string, err error)) *UserServiceMock {
+	if mmArmSetupToken.defaultExpectation != nil {
+		mmArmSetupToken.mock.t.Fatalf("Default expectation is already set for the UserService.ArmSetupToken method")
+	}
+
+	if len(mmArmSetupToken.expectations) > 0 {
+		mmArmSetupToken.mock.t.Fatalf("Some expectations are already set for the UserService.ArmSetupToken method")
+	}
+
+	mmArmSetupToken.mock.funcArmSetupToken = f
+	return mmArmSetupToken.mock
+}
+
+// When sets expectation for the UserService.ArmSetupToken which will trigger the result defined by the following
+// Then helper
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) When(ctx context.Context, token string) *UserServiceMockArmSetupTokenExpectation {
+	if mmArmSetupToken.mock.funcArmSetupToken != nil {
+		mmArmSetupToken.mock.t.Fatalf("UserServiceMock.ArmSetupToken mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockArmSetupTokenExpectation{
+		mock:   mmArmSetupToken.mock,
+		params: &UserServiceMockArmSetupTokenParams{ctx, token},
+	}
+	mmArmSetupToken.expectations = append(mmArmSetupToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ArmSetupToken return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockArmSetupTokenExpectation) Then(s1 string, err error) *UserServiceMock {
+	e.results = &UserServiceMockArmSetupTokenResults{s1, err}
+	return e.mock
+}
+
+// ArmSetupToken implements usecases.UserService
+func (mmArmSetupToken *UserServiceMock) ArmSetupToken(ctx context.Context, token string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmArmSetupToken.beforeArmSetupTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmArmSetupToken.afterArmSetupTokenCounter, 1)
+
+	if mmArmSetupToken.inspectFuncArmSetupToken != nil {
+		mmArmSetupToken.inspectFuncArmSetupToken(ctx, token)
+	}
+
+	mm_params := UserServiceMockArmSetupTokenParams{ctx, token}
+
+	// Record call args
+	mmArmSetupToken.ArmSetupTokenMock.mutex.Lock()
+	mmArmSetupToken.ArmSetupTokenMock.callArgs = append(mmArmSetupToken.ArmSetupTokenMock.callArgs, &mm_params)
+	mmArmSetupToken.ArmSetupTokenMock.mutex.Unlock()
+
+	for _, e := range mmArmSetupToken.ArmSetupTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmArmSetupToken.ArmSetupTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArmSetupToken.ArmSetupTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmArmSetupToken.ArmSetupTokenMock.defaultExpectation.params
+		mm_got := UserServiceMockArmSetupTokenParams{ctx, token}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArmSetupToken.t.Errorf("UserServiceMock.ArmSetupToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArmSetupToken.ArmSetupTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArmSetupToken.t.Fatal("No results are set for the UserServiceMock.ArmSetupToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmArmSetupToken.funcArmSetupToken != nil {
+		return mmArmSetupToken.funcArmSetupToken(ctx, token)
+	}
+	mmArmSetupToken.t.Fatalf("Unexpected call to UserServiceMock.ArmSetupToken. %v %v", ctx, token)
+	return
+}
+
+// ArmSetupTokenAfterCounter returns a count of finished UserServiceMock.ArmSetupToken invocations
+func (mmArmSetupToken *UserServiceMock) ArmSetupTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArmSetupToken.afterArmSetupTokenCounter)
+}
+
+// ArmSetupTokenBeforeCounter returns a count of UserServiceMock.ArmSetupToken invocations
+func (mmArmSetupToken *UserServiceMock) ArmSetupTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArmSetupToken.beforeArmSetupTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ArmSetupToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArmSetupToken *mUserServiceMockArmSetupToken) Calls() []*UserServiceMockArmSetupTokenParams {
+	mmArmSetupToken.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockArmSetupTokenParams, len(mmArmSetupToken.callArgs))
+	copy(argCopy, mmArmSetupToken.callArgs)
+
+	mmArmSetupToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArmSetupTokenDone returns true if the count of the ArmSetupToken invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockArmSetupTokenDone() bool {
+	for _, e := range m.ArmSetupTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArmSetupTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterArmSetupTokenCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArmSetupToken != nil && mm_atomic.LoadUint64(&m.afterArmSetupTokenCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockArmSetupTokenInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockArmSetupTokenInspect() {
+	for _, e := range m.ArmSetupTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ArmSetupToken with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArmSetupTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterArmSetupTokenCounter) < 1 {
+		if m.ArmSetupTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ArmSetupToken")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ArmSetupToken with params: %#v", *m.ArmSetupTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArmSetupToken != nil && mm_atomic.LoadUint64(&m.afterArmSetupTokenCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ArmSetupToken")
+	}
+}
+
 type mUserServiceMockAuth struct {
 	mock               *UserServiceMock
 	defaultExpectation *UserServiceMockAuthExpectation
@@ -314,1087 +731,5428 @@ func (m *UserServiceMock) MinimockAuthInspect() {
 	}
 }
 
-type mUserServiceMockCanDelete struct {
+type mUserServiceMockBatchGet struct {
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockCanDeleteExpectation
-	expectations       []*UserServiceMockCanDeleteExpectation
+	defaultExpectation *UserServiceMockBatchGetExpectation
+	expectations       []*UserServiceMockBatchGetExpectation
 
-	callArgs []*UserServiceMockCanDeleteParams
+	callArgs []*UserServiceMockBatchGetParams
 	mutex    sync.RWMutex
 }
 
-// UserServiceMockCanDeleteExpectation specifies expectation struct of the UserService.CanDelete
-type UserServiceMockCanDeleteExpectation struct {
+// UserServiceMockBatchGetExpectation specifies expectation struct of the UserService.BatchGet
+type UserServiceMockBatchGetExpectation struct {
 	mock    *UserServiceMock
-	params  *UserServiceMockCanDeleteParams
-	results *UserServiceMockCanDeleteResults
+	params  *UserServiceMockBatchGetParams
+	results *UserServiceMockBatchGetResults
 	Counter uint64
 }
 
-// UserServiceMockCanDeleteParams contains parameters of the UserService.CanDelete
-type UserServiceMockCanDeleteParams struct {
-	ctx    context.Context
-	userID int64
+// UserServiceMockBatchGetParams contains parameters of the UserService.BatchGet
+type UserServiceMockBatchGetParams struct {
+	ctx     context.Context
+	userIDs []int64
 }
 
-// UserServiceMockCanDeleteResults contains results of the UserService.CanDelete
-type UserServiceMockCanDeleteResults struct {
-	b1 bool
+// UserServiceMockBatchGetResults contains results of the UserService.BatchGet
+type UserServiceMockBatchGetResults struct {
+	ua1 []def.UserDTO
+	ia1 []int64
+	err error
 }
 
-// Expect sets up expected params for UserService.CanDelete
-func (mmCanDelete *mUserServiceMockCanDelete) Expect(ctx context.Context, userID int64) *mUserServiceMockCanDelete {
-	if mmCanDelete.mock.funcCanDelete != nil {
-		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+// Expect sets up expected params for UserService.BatchGet
+func (mmBatchGet *mUserServiceMockBatchGet) Expect(ctx context.Context, userIDs []int64) *mUserServiceMockBatchGet {
+	if mmBatchGet.mock.funcBatchGet != nil {
+		mmBatchGet.mock.t.Fatalf("UserServiceMock.BatchGet mock is already set by Set")
 	}
 
-	if mmCanDelete.defaultExpectation == nil {
-		mmCanDelete.defaultExpectation = &UserServiceMockCanDeleteExpectation{}
+	if mmBatchGet.defaultExpectation == nil {
+		mmBatchGet.defaultExpectation = &UserServiceMockBatchGetExpectation{}
 	}
 
-	mmCanDelete.defaultExpectation.params = &UserServiceMockCanDeleteParams{ctx, userID}
-	for _, e := range mmCanDelete.expectations {
-		if minimock.Equal(e.params, mmCanDelete.defaultExpectation.params) {
-			mmCanDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCanDelete.defaultExpectation.params)
+	mmBatchGet.defaultExpectation.params = &UserServiceMockBatchGetParams{ctx, userIDs}
+	for _, e := range mmBatchGet.expectations {
+		if minimock.Equal(e.params, mmBatchGet.defaultExpectation.params) {
+			mmBatchGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchGet.defaultExpectation.params)
 		}
 	}
 
-	return mmCanDelete
+	return mmBatchGet
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.CanDelete
-func (mmCanDelete *mUserServiceMockCanDelete) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockCanDelete {
-	if mmCanDelete.mock.inspectFuncCanDelete != nil {
-		mmCanDelete.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CanDelete")
+// Inspect accepts an inspector function that has same arguments as the UserService.BatchGet
+func (mmBatchGet *mUserServiceMockBatchGet) Inspect(f func(ctx context.Context, userIDs []int64)) *mUserServiceMockBatchGet {
+	if mmBatchGet.mock.inspectFuncBatchGet != nil {
+		mmBatchGet.mock.t.Fatalf("Inspect function is already set for UserServiceMock.BatchGet")
 	}
 
-	mmCanDelete.mock.inspectFuncCanDelete = f
+	mmBatchGet.mock.inspectFuncBatchGet = f
 
-	return mmCanDelete
+	return mmBatchGet
 }
 
-// Return sets up results that will be returned by UserService.CanDelete
-func (mmCanDelete *mUserServiceMockCanDelete) Return(b1 bool) *UserServiceMock {
-	if mmCanDelete.mock.funcCanDelete != nil {
-		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+// Return sets up results that will be returned by UserService.BatchGet
+func (mmBatchGet *mUserServiceMockBatchGet) Return(ua1 []def.UserDTO, ia1 []int64, err error) *UserServiceMock {
+	if mmBatchGet.mock.funcBatchGet != nil {
+		mmBatchGet.mock.t.Fatalf("UserServiceMock.BatchGet mock is already set by Set")
 	}
 
-	if mmCanDelete.defaultExpectation == nil {
-		mmCanDelete.defaultExpectation = &UserServiceMockCanDeleteExpectation{mock: mmCanDelete.mock}
+	if mmBatchGet.defaultExpectation == nil {
+		mmBatchGet.defaultExpectation = &UserServiceMockBatchGetExpectation{mock: mmBatchGet.mock}
 	}
-	mmCanDelete.defaultExpectation.results = &UserServiceMockCanDeleteResults{b1}
-	return mmCanDelete.mock
+	mmBatchGet.defaultExpectation.results = &UserServiceMockBatchGetResults{ua1, ia1, err}
+	return mmBatchGet.mock
 }
 
-// Set uses given function f to mock the UserService.CanDelete method
-func (mmCanDelete *mUserServiceMockCanDelete) Set(f func(ctx context.Context, userID int64) (b1 bool)) *UserServiceMock {
-	if mmCanDelete.defaultExpectation != nil {
-		mmCanDelete.mock.t.Fatalf("Default expectation is already set for the UserService.CanDelete method")
+// Set uses given function f to mock the UserService.BatchGet method
+func (mmBatchGet *mUserServiceMockBatchGet) Set(f func(ctx context.Context, userIDs []int64) (ua1 []def.UserDTO, ia1 []int64, err error)) *UserServiceMock {
+	if mmBatchGet.defaultExpectation != nil {
+		mmBatchGet.mock.t.Fatalf("Default expectation is already set for the UserService.BatchGet method")
 	}
 
-	if len(mmCanDelete.expectations) > 0 {
-		mmCanDelete.mock.t.Fatalf("Some expectations are already set for the UserService.CanDelete method")
+	if len(mmBatchGet.expectations) > 0 {
+		mmBatchGet.mock.t.Fatalf("Some expectations are already set for the UserService.BatchGet method")
 	}
 
-	mmCanDelete.mock.funcCanDelete = f
-	return mmCanDelete.mock
+	mmBatchGet.mock.funcBatchGet = f
+	return mmBatchGet.mock
 }
 
-// When sets expectation for the UserService.CanDelete which will trigger the result defined by the following
+// When sets expectation for the UserService.BatchGet which will trigger the result defined by the following
 // Then helper
-func (mmCanDelete *mUserServiceMockCanDelete) When(ctx context.Context, userID int64) *UserServiceMockCanDeleteExpectation {
-	if mmCanDelete.mock.funcCanDelete != nil {
-		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+func (mmBatchGet *mUserServiceMockBatchGet) When(ctx context.Context, userIDs []int64) *UserServiceMockBatchGetExpectation {
+	if mmBatchGet.mock.funcBatchGet != nil {
+		mmBatchGet.mock.t.Fatalf("UserServiceMock.BatchGet mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockCanDeleteExpectation{
-		mock:   mmCanDelete.mock,
-		params: &UserServiceMockCanDeleteParams{ctx, userID},
+	expectation := &UserServiceMockBatchGetExpectation{
+		mock:   mmBatchGet.mock,
+		params: &UserServiceMockBatchGetParams{ctx, userIDs},
 	}
-	mmCanDelete.expectations = append(mmCanDelete.expectations, expectation)
+	mmBatchGet.expectations = append(mmBatchGet.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.CanDelete return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockCanDeleteExpectation) Then(b1 bool) *UserServiceMock {
-	e.results = &UserServiceMockCanDeleteResults{b1}
+// Then sets up UserService.BatchGet return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockBatchGetExpectation) Then(ua1 []def.UserDTO, ia1 []int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockBatchGetResults{ua1, ia1, err}
 	return e.mock
 }
 
-// CanDelete implements usecases.UserService
-func (mmCanDelete *UserServiceMock) CanDelete(ctx context.Context, userID int64) (b1 bool) {
-	mm_atomic.AddUint64(&mmCanDelete.beforeCanDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmCanDelete.afterCanDeleteCounter, 1)
+// BatchGet implements usecases.UserService
+func (mmBatchGet *UserServiceMock) BatchGet(ctx context.Context, userIDs []int64) (ua1 []def.UserDTO, ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmBatchGet.beforeBatchGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchGet.afterBatchGetCounter, 1)
 
-	if mmCanDelete.inspectFuncCanDelete != nil {
-		mmCanDelete.inspectFuncCanDelete(ctx, userID)
+	if mmBatchGet.inspectFuncBatchGet != nil {
+		mmBatchGet.inspectFuncBatchGet(ctx, userIDs)
 	}
 
-	mm_params := UserServiceMockCanDeleteParams{ctx, userID}
+	mm_params := UserServiceMockBatchGetParams{ctx, userIDs}
 
 	// Record call args
-	mmCanDelete.CanDeleteMock.mutex.Lock()
-	mmCanDelete.CanDeleteMock.callArgs = append(mmCanDelete.CanDeleteMock.callArgs, &mm_params)
-	mmCanDelete.CanDeleteMock.mutex.Unlock()
+	mmBatchGet.BatchGetMock.mutex.Lock()
+	mmBatchGet.BatchGetMock.callArgs = append(mmBatchGet.BatchGetMock.callArgs, &mm_params)
+	mmBatchGet.BatchGetMock.mutex.Unlock()
 
-	for _, e := range mmCanDelete.CanDeleteMock.expectations {
+	for _, e := range mmBatchGet.BatchGetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.b1
+			return e.results.ua1, e.results.ia1, e.results.err
 		}
 	}
 
-	if mmCanDelete.CanDeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCanDelete.CanDeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmCanDelete.CanDeleteMock.defaultExpectation.params
-		mm_got := UserServiceMockCanDeleteParams{ctx, userID}
+	if mmBatchGet.BatchGetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchGet.BatchGetMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchGet.BatchGetMock.defaultExpectation.params
+		mm_got := UserServiceMockBatchGetParams{ctx, userIDs}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCanDelete.t.Errorf("UserServiceMock.CanDelete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmBatchGet.t.Errorf("UserServiceMock.BatchGet got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCanDelete.CanDeleteMock.defaultExpectation.results
+		mm_results := mmBatchGet.BatchGetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCanDelete.t.Fatal("No results are set for the UserServiceMock.CanDelete")
+			mmBatchGet.t.Fatal("No results are set for the UserServiceMock.BatchGet")
 		}
-		return (*mm_results).b1
+		return (*mm_results).ua1, (*mm_results).ia1, (*mm_results).err
 	}
-	if mmCanDelete.funcCanDelete != nil {
-		return mmCanDelete.funcCanDelete(ctx, userID)
+	if mmBatchGet.funcBatchGet != nil {
+		return mmBatchGet.funcBatchGet(ctx, userIDs)
 	}
-	mmCanDelete.t.Fatalf("Unexpected call to UserServiceMock.CanDelete. %v %v", ctx, userID)
+	mmBatchGet.t.Fatalf("Unexpected call to UserServiceMock.BatchGet. %v %v", ctx, userIDs)
 	return
 }
 
-// CanDeleteAfterCounter returns a count of finished UserServiceMock.CanDelete invocations
-func (mmCanDelete *UserServiceMock) CanDeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCanDelete.afterCanDeleteCounter)
+// BatchGetAfterCounter returns a count of finished UserServiceMock.BatchGet invocations
+func (mmBatchGet *UserServiceMock) BatchGetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchGet.afterBatchGetCounter)
 }
 
-// CanDeleteBeforeCounter returns a count of UserServiceMock.CanDelete invocations
-func (mmCanDelete *UserServiceMock) CanDeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCanDelete.beforeCanDeleteCounter)
+// BatchGetBeforeCounter returns a count of UserServiceMock.BatchGet invocations
+func (mmBatchGet *UserServiceMock) BatchGetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchGet.beforeBatchGetCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.CanDelete.
+// Calls returns a list of arguments used in each call to UserServiceMock.BatchGet.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCanDelete *mUserServiceMockCanDelete) Calls() []*UserServiceMockCanDeleteParams {
-	mmCanDelete.mutex.RLock()
+func (mmBatchGet *mUserServiceMockBatchGet) Calls() []*UserServiceMockBatchGetParams {
+	mmBatchGet.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockCanDeleteParams, len(mmCanDelete.callArgs))
-	copy(argCopy, mmCanDelete.callArgs)
+	argCopy := make([]*UserServiceMockBatchGetParams, len(mmBatchGet.callArgs))
+	copy(argCopy, mmBatchGet.callArgs)
 
-	mmCanDelete.mutex.RUnlock()
+	mmBatchGet.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCanDeleteDone returns true if the count of the CanDelete invocations corresponds
+// MinimockBatchGetDone returns true if the count of the BatchGet invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockCanDeleteDone() bool {
-	for _, e := range m.CanDeleteMock.expectations {
+func (m *UserServiceMock) MinimockBatchGetDone() bool {
+	for _, e := range m.BatchGetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CanDeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+	if m.BatchGetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchGetCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCanDelete != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+	if m.funcBatchGet != nil && mm_atomic.LoadUint64(&m.afterBatchGetCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockCanDeleteInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockCanDeleteInspect() {
-	for _, e := range m.CanDeleteMock.expectations {
+// MinimockBatchGetInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockBatchGetInspect() {
+	for _, e := range m.BatchGetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.CanDelete with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.BatchGet with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CanDeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
-		if m.CanDeleteMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserServiceMock.CanDelete")
+	if m.BatchGetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchGetCounter) < 1 {
+		if m.BatchGetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.BatchGet")
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.CanDelete with params: %#v", *m.CanDeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.BatchGet with params: %#v", *m.BatchGetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCanDelete != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
-		m.t.Error("Expected call to UserServiceMock.CanDelete")
+	if m.funcBatchGet != nil && mm_atomic.LoadUint64(&m.afterBatchGetCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.BatchGet")
 	}
 }
 
-type mUserServiceMockCreate struct {
+type mUserServiceMockBlockUser struct {
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockCreateExpectation
-	expectations       []*UserServiceMockCreateExpectation
+	defaultExpectation *UserServiceMockBlockUserExpectation
+	expectations       []*UserServiceMockBlockUserExpectation
 
-	callArgs []*UserServiceMockCreateParams
+	callArgs []*UserServiceMockBlockUserParams
 	mutex    sync.RWMutex
 }
 
-// UserServiceMockCreateExpectation specifies expectation struct of the UserService.Create
-type UserServiceMockCreateExpectation struct {
+// UserServiceMockBlockUserExpectation specifies expectation struct of the UserService.BlockUser
+type UserServiceMockBlockUserExpectation struct {
 	mock    *UserServiceMock
-	params  *UserServiceMockCreateParams
-	results *UserServiceMockCreateResults
+	params  *UserServiceMockBlockUserParams
+	results *UserServiceMockBlockUserResults
 	Counter uint64
 }
 
-// UserServiceMockCreateParams contains parameters of the UserService.Create
-type UserServiceMockCreateParams struct {
+// UserServiceMockBlockUserParams contains parameters of the UserService.BlockUser
+type UserServiceMockBlockUserParams struct {
+	ctx    context.Context
+	userID int64
+	reason string
+	until  time.Time
+}
+
+// UserServiceMockBlockUserResults contains results of the UserService.BlockUser
+type UserServiceMockBlockUserResults struct {
+	u1  def.UserDTO
+	err error
+}
+
+// Expect sets up expected params for UserService.BlockUser
+func (mmBlockUser *mUserServiceMockBlockUser) Expect(ctx context.Context, userID int64, reason string, until time.Time) *mUserServiceMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("UserServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &UserServiceMockBlockUserExpectation{}
+	}
+
+	mmBlockUser.defaultExpectation.params = &UserServiceMockBlockUserParams{ctx, userID, reason, until}
+	for _, e := range mmBlockUser.expectations {
+		if minimock.Equal(e.params, mmBlockUser.defaultExpectation.params) {
+			mmBlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmBlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.BlockUser
+func (mmBlockUser *mUserServiceMockBlockUser) Inspect(f func(ctx context.Context, userID int64, reason string, until time.Time)) *mUserServiceMockBlockUser {
+	if mmBlockUser.mock.inspectFuncBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.BlockUser")
+	}
+
+	mmBlockUser.mock.inspectFuncBlockUser = f
+
+	return mmBlockUser
+}
+
+// Return sets up results that will be returned by UserService.BlockUser
+func (mmBlockUser *mUserServiceMockBlockUser) Return(u1 def.UserDTO, err error) *UserServiceMock {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("UserServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &UserServiceMockBlockUserExpectation{mock: mmBlockUser.mock}
+	}
+	mmBlockUser.defaultExpectation.results = &UserServiceMockBlockUserResults{u1, err}
+	return mmBlockUser.mock
+}
+
+// Set uses given function f to mock the UserService.BlockUser method
+func (mmBlockUser *mUserServiceMockBlockUser) Set(f func(ctx context.Context, userID int64, reason string, until time.Time) (u1 def.UserDTO, err error)) *UserServiceMock {
+	if mmBlockUser.defaultExpectation != nil {
+		mmBlockUser.mock.t.Fatalf("Default expectation is already set for the UserService.BlockUser method")
+	}
+
+	if len(mmBlockUser.expectations) > 0 {
+		mmBlockUser.mock.t.Fatalf("Some expectations are already set for the UserService.BlockUser method")
+	}
+
+	mmBlockUser.mock.funcBlockUser = f
+	return mmBlockUser.mock
+}
+
+// When sets expectation for the UserService.BlockUser which will trigger the result defined by the following
+// Then helper
+func (mmBlockUser *mUserServiceMockBlockUser) When(ctx context.Context, userID int64, reason string, until time.Time) *UserServiceMockBlockUserExpectation {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("UserServiceMock.BlockUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockBlockUserExpectation{
+		mock:   mmBlockUser.mock,
+		params: &UserServiceMockBlockUserParams{ctx, userID, reason, until},
+	}
+	mmBlockUser.expectations = append(mmBlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.BlockUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockBlockUserExpectation) Then(u1 def.UserDTO, err error) *UserServiceMock {
+	e.results = &UserServiceMockBlockUserResults{u1, err}
+	return e.mock
+}
+
+// BlockUser implements usecases.UserService
+func (mmBlockUser *UserServiceMock) BlockUser(ctx context.Context, userID int64, reason string, until time.Time) (u1 def.UserDTO, err error) {
+	mm_atomic.AddUint64(&mmBlockUser.beforeBlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlockUser.afterBlockUserCounter, 1)
+
+	if mmBlockUser.inspectFuncBlockUser != nil {
+		mmBlockUser.inspectFuncBlockUser(ctx, userID, reason, until)
+	}
+
+	mm_params := UserServiceMockBlockUserParams{ctx, userID, reason, until}
+
+	// Record call args
+	mmBlockUser.BlockUserMock.mutex.Lock()
+	mmBlockUser.BlockUserMock.callArgs = append(mmBlockUser.BlockUserMock.callArgs, &mm_params)
+	mmBlockUser.BlockUserMock.mutex.Unlock()
+
+	for _, e := range mmBlockUser.BlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmBlockUser.BlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlockUser.BlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlockUser.BlockUserMock.defaultExpectation.params
+		mm_got := UserServiceMockBlockUserParams{ctx, userID, reason, until}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlockUser.t.Errorf("UserServiceMock.BlockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlockUser.BlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlockUser.t.Fatal("No results are set for the UserServiceMock.BlockUser")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmBlockUser.funcBlockUser != nil {
+		return mmBlockUser.funcBlockUser(ctx, userID, reason, until)
+	}
+	mmBlockUser.t.Fatalf("Unexpected call to UserServiceMock.BlockUser. %v %v %v %v", ctx, userID, reason, until)
+	return
+}
+
+// BlockUserAfterCounter returns a count of finished UserServiceMock.BlockUser invocations
+func (mmBlockUser *UserServiceMock) BlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.afterBlockUserCounter)
+}
+
+// BlockUserBeforeCounter returns a count of UserServiceMock.BlockUser invocations
+func (mmBlockUser *UserServiceMock) BlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.beforeBlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.BlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlockUser *mUserServiceMockBlockUser) Calls() []*UserServiceMockBlockUserParams {
+	mmBlockUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockBlockUserParams, len(mmBlockUser.callArgs))
+	copy(argCopy, mmBlockUser.callArgs)
+
+	mmBlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockUserDone returns true if the count of the BlockUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockBlockUserDone() bool {
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBlockUserCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockUser != nil && mm_atomic.LoadUint64(&m.afterBlockUserCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBlockUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockBlockUserInspect() {
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.BlockUser with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBlockUserCounter) < 1 {
+		if m.BlockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.BlockUser")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.BlockUser with params: %#v", *m.BlockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockUser != nil && mm_atomic.LoadUint64(&m.afterBlockUserCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.BlockUser")
+	}
+}
+
+type mUserServiceMockBootstrapAdmin struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockBootstrapAdminExpectation
+	expectations       []*UserServiceMockBootstrapAdminExpectation
+
+	callArgs []*UserServiceMockBootstrapAdminParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockBootstrapAdminExpectation specifies expectation struct of the UserService.BootstrapAdmin
+type UserServiceMockBootstrapAdminExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockBootstrapAdminParams
+	results *UserServiceMockBootstrapAdminResults
+	Counter uint64
+}
+
+// UserServiceMockBootstrapAdminParams contains parameters of the UserService.BootstrapAdmin
+type UserServiceMockBootstrapAdminParams struct {
 	ctx context.Context
 	req def.CreateDTO
 }
 
-// UserServiceMockCreateResults contains results of the UserService.Create
-type UserServiceMockCreateResults struct {
+// UserServiceMockBootstrapAdminResults contains results of the UserService.BootstrapAdmin
+type UserServiceMockBootstrapAdminResults struct {
 	i1  int64
 	err error
 }
 
-// Expect sets up expected params for UserService.Create
-func (mmCreate *mUserServiceMockCreate) Expect(ctx context.Context, req def.CreateDTO) *mUserServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// Expect sets up expected params for UserService.BootstrapAdmin
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) Expect(ctx context.Context, req def.CreateDTO) *mUserServiceMockBootstrapAdmin {
+	if mmBootstrapAdmin.mock.funcBootstrapAdmin != nil {
+		mmBootstrapAdmin.mock.t.Fatalf("UserServiceMock.BootstrapAdmin mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	if mmBootstrapAdmin.defaultExpectation == nil {
+		mmBootstrapAdmin.defaultExpectation = &UserServiceMockBootstrapAdminExpectation{}
 	}
 
-	mmCreate.defaultExpectation.params = &UserServiceMockCreateParams{ctx, req}
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmBootstrapAdmin.defaultExpectation.params = &UserServiceMockBootstrapAdminParams{ctx, req}
+	for _, e := range mmBootstrapAdmin.expectations {
+		if minimock.Equal(e.params, mmBootstrapAdmin.defaultExpectation.params) {
+			mmBootstrapAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBootstrapAdmin.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmBootstrapAdmin
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Create
-func (mmCreate *mUserServiceMockCreate) Inspect(f func(ctx context.Context, req def.CreateDTO)) *mUserServiceMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Create")
+// Inspect accepts an inspector function that has same arguments as the UserService.BootstrapAdmin
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) Inspect(f func(ctx context.Context, req def.CreateDTO)) *mUserServiceMockBootstrapAdmin {
+	if mmBootstrapAdmin.mock.inspectFuncBootstrapAdmin != nil {
+		mmBootstrapAdmin.mock.t.Fatalf("Inspect function is already set for UserServiceMock.BootstrapAdmin")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	mmBootstrapAdmin.mock.inspectFuncBootstrapAdmin = f
 
-	return mmCreate
+	return mmBootstrapAdmin
 }
 
-// Return sets up results that will be returned by UserService.Create
-func (mmCreate *mUserServiceMockCreate) Return(i1 int64, err error) *UserServiceMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// Return sets up results that will be returned by UserService.BootstrapAdmin
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) Return(i1 int64, err error) *UserServiceMock {
+	if mmBootstrapAdmin.mock.funcBootstrapAdmin != nil {
+		mmBootstrapAdmin.mock.t.Fatalf("UserServiceMock.BootstrapAdmin mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{mock: mmCreate.mock}
+	if mmBootstrapAdmin.defaultExpectation == nil {
+		mmBootstrapAdmin.defaultExpectation = &UserServiceMockBootstrapAdminExpectation{mock: mmBootstrapAdmin.mock}
 	}
-	mmCreate.defaultExpectation.results = &UserServiceMockCreateResults{i1, err}
-	return mmCreate.mock
+	mmBootstrapAdmin.defaultExpectation.results = &UserServiceMockBootstrapAdminResults{i1, err}
+	return mmBootstrapAdmin.mock
 }
 
-// Set uses given function f to mock the UserService.Create method
-func (mmCreate *mUserServiceMockCreate) Set(f func(ctx context.Context, req def.CreateDTO) (i1 int64, err error)) *UserServiceMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserService.Create method")
+// Set uses given function f to mock the UserService.BootstrapAdmin method
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) Set(f func(ctx context.Context, req def.CreateDTO) (i1 int64, err error)) *UserServiceMock {
+	if mmBootstrapAdmin.defaultExpectation != nil {
+		mmBootstrapAdmin.mock.t.Fatalf("Default expectation is already set for the UserService.BootstrapAdmin method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserService.Create method")
+	if len(mmBootstrapAdmin.expectations) > 0 {
+		mmBootstrapAdmin.mock.t.Fatalf("Some expectations are already set for the UserService.BootstrapAdmin method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	return mmCreate.mock
+	mmBootstrapAdmin.mock.funcBootstrapAdmin = f
+	return mmBootstrapAdmin.mock
 }
 
-// When sets expectation for the UserService.Create which will trigger the result defined by the following
+// When sets expectation for the UserService.BootstrapAdmin which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mUserServiceMockCreate) When(ctx context.Context, req def.CreateDTO) *UserServiceMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) When(ctx context.Context, req def.CreateDTO) *UserServiceMockBootstrapAdminExpectation {
+	if mmBootstrapAdmin.mock.funcBootstrapAdmin != nil {
+		mmBootstrapAdmin.mock.t.Fatalf("UserServiceMock.BootstrapAdmin mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockCreateExpectation{
-		mock:   mmCreate.mock,
-		params: &UserServiceMockCreateParams{ctx, req},
+	expectation := &UserServiceMockBootstrapAdminExpectation{
+		mock:   mmBootstrapAdmin.mock,
+		params: &UserServiceMockBootstrapAdminParams{ctx, req},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmBootstrapAdmin.expectations = append(mmBootstrapAdmin.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Create return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockCreateExpectation) Then(i1 int64, err error) *UserServiceMock {
-	e.results = &UserServiceMockCreateResults{i1, err}
+// Then sets up UserService.BootstrapAdmin return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockBootstrapAdminExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockBootstrapAdminResults{i1, err}
 	return e.mock
 }
 
-// Create implements usecases.UserService
-func (mmCreate *UserServiceMock) Create(ctx context.Context, req def.CreateDTO) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// BootstrapAdmin implements usecases.UserService
+func (mmBootstrapAdmin *UserServiceMock) BootstrapAdmin(ctx context.Context, req def.CreateDTO) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmBootstrapAdmin.beforeBootstrapAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmBootstrapAdmin.afterBootstrapAdminCounter, 1)
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, req)
+	if mmBootstrapAdmin.inspectFuncBootstrapAdmin != nil {
+		mmBootstrapAdmin.inspectFuncBootstrapAdmin(ctx, req)
 	}
 
-	mm_params := UserServiceMockCreateParams{ctx, req}
+	mm_params := UserServiceMockBootstrapAdminParams{ctx, req}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmBootstrapAdmin.BootstrapAdminMock.mutex.Lock()
+	mmBootstrapAdmin.BootstrapAdminMock.callArgs = append(mmBootstrapAdmin.BootstrapAdminMock.callArgs, &mm_params)
+	mmBootstrapAdmin.BootstrapAdminMock.mutex.Unlock()
+
+	for _, e := range mmBootstrapAdmin.BootstrapAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmBootstrapAdmin.BootstrapAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBootstrapAdmin.BootstrapAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmBootstrapAdmin.BootstrapAdminMock.defaultExpectation.params
+		mm_got := UserServiceMockBootstrapAdminParams{ctx, req}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBootstrapAdmin.t.Errorf("UserServiceMock.BootstrapAdmin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBootstrapAdmin.BootstrapAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBootstrapAdmin.t.Fatal("No results are set for the UserServiceMock.BootstrapAdmin")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmBootstrapAdmin.funcBootstrapAdmin != nil {
+		return mmBootstrapAdmin.funcBootstrapAdmin(ctx, req)
+	}
+	mmBootstrapAdmin.t.Fatalf("Unexpected call to UserServiceMock.BootstrapAdmin. %v %v", ctx, req)
+	return
+}
+
+// BootstrapAdminAfterCounter returns a count of finished UserServiceMock.BootstrapAdmin invocations
+func (mmBootstrapAdmin *UserServiceMock) BootstrapAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBootstrapAdmin.afterBootstrapAdminCounter)
+}
+
+// BootstrapAdminBeforeCounter returns a count of UserServiceMock.BootstrapAdmin invocations
+func (mmBootstrapAdmin *UserServiceMock) BootstrapAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBootstrapAdmin.beforeBootstrapAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.BootstrapAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBootstrapAdmin *mUserServiceMockBootstrapAdmin) Calls() []*UserServiceMockBootstrapAdminParams {
+	mmBootstrapAdmin.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockBootstrapAdminParams, len(mmBootstrapAdmin.callArgs))
+	copy(argCopy, mmBootstrapAdmin.callArgs)
+
+	mmBootstrapAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBootstrapAdminDone returns true if the count of the BootstrapAdmin invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockBootstrapAdminDone() bool {
+	for _, e := range m.BootstrapAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BootstrapAdminMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBootstrapAdminCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBootstrapAdmin != nil && mm_atomic.LoadUint64(&m.afterBootstrapAdminCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBootstrapAdminInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockBootstrapAdminInspect() {
+	for _, e := range m.BootstrapAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.BootstrapAdmin with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BootstrapAdminMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBootstrapAdminCounter) < 1 {
+		if m.BootstrapAdminMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.BootstrapAdmin")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.BootstrapAdmin with params: %#v", *m.BootstrapAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBootstrapAdmin != nil && mm_atomic.LoadUint64(&m.afterBootstrapAdminCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.BootstrapAdmin")
+	}
+}
+
+type mUserServiceMockCanDelete struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCanDeleteExpectation
+	expectations       []*UserServiceMockCanDeleteExpectation
+
+	callArgs []*UserServiceMockCanDeleteParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockCanDeleteExpectation specifies expectation struct of the UserService.CanDelete
+type UserServiceMockCanDeleteExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockCanDeleteParams
+	results *UserServiceMockCanDeleteResults
+	Counter uint64
+}
+
+// UserServiceMockCanDeleteParams contains parameters of the UserService.CanDelete
+type UserServiceMockCanDeleteParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockCanDeleteResults contains results of the UserService.CanDelete
+type UserServiceMockCanDeleteResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for UserService.CanDelete
+func (mmCanDelete *mUserServiceMockCanDelete) Expect(ctx context.Context, userID int64) *mUserServiceMockCanDelete {
+	if mmCanDelete.mock.funcCanDelete != nil {
+		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+	}
+
+	if mmCanDelete.defaultExpectation == nil {
+		mmCanDelete.defaultExpectation = &UserServiceMockCanDeleteExpectation{}
+	}
+
+	mmCanDelete.defaultExpectation.params = &UserServiceMockCanDeleteParams{ctx, userID}
+	for _, e := range mmCanDelete.expectations {
+		if minimock.Equal(e.params, mmCanDelete.defaultExpectation.params) {
+			mmCanDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCanDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmCanDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CanDelete
+func (mmCanDelete *mUserServiceMockCanDelete) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockCanDelete {
+	if mmCanDelete.mock.inspectFuncCanDelete != nil {
+		mmCanDelete.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CanDelete")
+	}
+
+	mmCanDelete.mock.inspectFuncCanDelete = f
+
+	return mmCanDelete
+}
+
+// Return sets up results that will be returned by UserService.CanDelete
+func (mmCanDelete *mUserServiceMockCanDelete) Return(b1 bool) *UserServiceMock {
+	if mmCanDelete.mock.funcCanDelete != nil {
+		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+	}
+
+	if mmCanDelete.defaultExpectation == nil {
+		mmCanDelete.defaultExpectation = &UserServiceMockCanDeleteExpectation{mock: mmCanDelete.mock}
+	}
+	mmCanDelete.defaultExpectation.results = &UserServiceMockCanDeleteResults{b1}
+	return mmCanDelete.mock
+}
+
+// Set uses given function f to mock the UserService.CanDelete method
+func (mmCanDelete *mUserServiceMockCanDelete) Set(f func(ctx context.Context, userID int64) (b1 bool)) *UserServiceMock {
+	if mmCanDelete.defaultExpectation != nil {
+		mmCanDelete.mock.t.Fatalf("Default expectation is already set for the UserService.CanDelete method")
+	}
+
+	if len(mmCanDelete.expectations) > 0 {
+		mmCanDelete.mock.t.Fatalf("Some expectations are already set for the UserService.CanDelete method")
+	}
+
+	mmCanDelete.mock.funcCanDelete = f
+	return mmCanDelete.mock
+}
+
+// When sets expectation for the UserService.CanDelete which will trigger the result defined by the following
+// Then helper
+func (mmCanDelete *mUserServiceMockCanDelete) When(ctx context.Context, userID int64) *UserServiceMockCanDeleteExpectation {
+	if mmCanDelete.mock.funcCanDelete != nil {
+		mmCanDelete.mock.t.Fatalf("UserServiceMock.CanDelete mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCanDeleteExpectation{
+		mock:   mmCanDelete.mock,
+		params: &UserServiceMockCanDeleteParams{ctx, userID},
+	}
+	mmCanDelete.expectations = append(mmCanDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CanDelete return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCanDeleteExpectation) Then(b1 bool) *UserServiceMock {
+	e.results = &UserServiceMockCanDeleteResults{b1}
+	return e.mock
+}
+
+// CanDelete implements usecases.UserService
+func (mmCanDelete *UserServiceMock) CanDelete(ctx context.Context, userID int64) (b1 bool) {
+	mm_atomic.AddUint64(&mmCanDelete.beforeCanDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmCanDelete.afterCanDeleteCounter, 1)
+
+	if mmCanDelete.inspectFuncCanDelete != nil {
+		mmCanDelete.inspectFuncCanDelete(ctx, userID)
+	}
+
+	mm_params := UserServiceMockCanDeleteParams{ctx, userID}
+
+	// Record call args
+	mmCanDelete.CanDeleteMock.mutex.Lock()
+	mmCanDelete.CanDeleteMock.callArgs = append(mmCanDelete.CanDeleteMock.callArgs, &mm_params)
+	mmCanDelete.CanDeleteMock.mutex.Unlock()
+
+	for _, e := range mmCanDelete.CanDeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmCanDelete.CanDeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCanDelete.CanDeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmCanDelete.CanDeleteMock.defaultExpectation.params
+		mm_got := UserServiceMockCanDeleteParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCanDelete.t.Errorf("UserServiceMock.CanDelete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCanDelete.CanDeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCanDelete.t.Fatal("No results are set for the UserServiceMock.CanDelete")
+		}
+		return (*mm_results).b1
+	}
+	if mmCanDelete.funcCanDelete != nil {
+		return mmCanDelete.funcCanDelete(ctx, userID)
+	}
+	mmCanDelete.t.Fatalf("Unexpected call to UserServiceMock.CanDelete. %v %v", ctx, userID)
+	return
+}
+
+// CanDeleteAfterCounter returns a count of finished UserServiceMock.CanDelete invocations
+func (mmCanDelete *UserServiceMock) CanDeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCanDelete.afterCanDeleteCounter)
+}
+
+// CanDeleteBeforeCounter returns a count of UserServiceMock.CanDelete invocations
+func (mmCanDelete *UserServiceMock) CanDeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCanDelete.beforeCanDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CanDelete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCanDelete *mUserServiceMockCanDelete) Calls() []*UserServiceMockCanDeleteParams {
+	mmCanDelete.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCanDeleteParams, len(mmCanDelete.callArgs))
+	copy(argCopy, mmCanDelete.callArgs)
+
+	mmCanDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCanDeleteDone returns true if the count of the CanDelete invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCanDeleteDone() bool {
+	for _, e := range m.CanDeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CanDeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCanDelete != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCanDeleteInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCanDeleteInspect() {
+	for _, e := range m.CanDeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CanDelete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CanDeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+		if m.CanDeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.CanDelete")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CanDelete with params: %#v", *m.CanDeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCanDelete != nil && mm_atomic.LoadUint64(&m.afterCanDeleteCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.CanDelete")
+	}
+}
+
+type mUserServiceMockCheckStatus struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCheckStatusExpectation
+	expectations       []*UserServiceMockCheckStatusExpectation
+
+	callArgs []*UserServiceMockCheckStatusParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockCheckStatusExpectation specifies expectation struct of the UserService.CheckStatus
+type UserServiceMockCheckStatusExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockCheckStatusParams
+	results *UserServiceMockCheckStatusResults
+	Counter uint64
+}
+
+// UserServiceMockCheckStatusParams contains parameters of the UserService.CheckStatus
+type UserServiceMockCheckStatusParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockCheckStatusResults contains results of the UserService.CheckStatus
+type UserServiceMockCheckStatusResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.CheckStatus
+func (mmCheckStatus *mUserServiceMockCheckStatus) Expect(ctx context.Context, userID int64) *mUserServiceMockCheckStatus {
+	if mmCheckStatus.mock.funcCheckStatus != nil {
+		mmCheckStatus.mock.t.Fatalf("UserServiceMock.CheckStatus mock is already set by Set")
+	}
+
+	if mmCheckStatus.defaultExpectation == nil {
+		mmCheckStatus.defaultExpectation = &UserServiceMockCheckStatusExpectation{}
+	}
+
+	mmCheckStatus.defaultExpectation.params = &UserServiceMockCheckStatusParams{ctx, userID}
+	for _, e := range mmCheckStatus.expectations {
+		if minimock.Equal(e.params, mmCheckStatus.defaultExpectation.params) {
+			mmCheckStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CheckStatus
+func (mmCheckStatus *mUserServiceMockCheckStatus) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockCheckStatus {
+	if mmCheckStatus.mock.inspectFuncCheckStatus != nil {
+		mmCheckStatus.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CheckStatus")
+	}
+
+	mmCheckStatus.mock.inspectFuncCheckStatus = f
+
+	return mmCheckStatus
+}
+
+// Return sets up results that will be returned by UserService.CheckStatus
+func (mmCheckStatus *mUserServiceMockCheckStatus) Return(err error) *UserServiceMock {
+	if mmCheckStatus.mock.funcCheckStatus != nil {
+		mmCheckStatus.mock.t.Fatalf("UserServiceMock.CheckStatus mock is already set by Set")
+	}
+
+	if mmCheckStatus.defaultExpectation == nil {
+		mmCheckStatus.defaultExpectation = &UserServiceMockCheckStatusExpectation{mock: mmCheckStatus.mock}
+	}
+	mmCheckStatus.defaultExpectation.results = &UserServiceMockCheckStatusResults{err}
+	return mmCheckStatus.mock
+}
+
+// Set uses given function f to mock the UserService.CheckStatus method
+func (mmCheckStatus *mUserServiceMockCheckStatus) Set(f func(ctx context.Context, userID int64) (err error)) *UserServiceMock {
+	if mmCheckStatus.defaultExpectation != nil {
+		mmCheckStatus.mock.t.Fatalf("Default expectation is already set for the UserService.CheckStatus method")
+	}
+
+	if len(mmCheckStatus.expectations) > 0 {
+		mmCheckStatus.mock.t.Fatalf("Some expectations are already set for the UserService.CheckStatus method")
+	}
+
+	mmCheckStatus.mock.funcCheckStatus = f
+	return mmCheckStatus.mock
+}
+
+// When sets expectation for the UserService.CheckStatus which will trigger the result defined by the following
+// Then helper
+func (mmCheckStatus *mUserServiceMockCheckStatus) When(ctx context.Context, userID int64) *UserServiceMockCheckStatusExpectation {
+	if mmCheckStatus.mock.funcCheckStatus != nil {
+		mmCheckStatus.mock.t.Fatalf("UserServiceMock.CheckStatus mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCheckStatusExpectation{
+		mock:   mmCheckStatus.mock,
+		params: &UserServiceMockCheckStatusParams{ctx, userID},
+	}
+	mmCheckStatus.expectations = append(mmCheckStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CheckStatus return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCheckStatusExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockCheckStatusResults{err}
+	return e.mock
+}
+
+// CheckStatus implements usecases.UserService
+func (mmCheckStatus *UserServiceMock) CheckStatus(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmCheckStatus.beforeCheckStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckStatus.afterCheckStatusCounter, 1)
+
+	if mmCheckStatus.inspectFuncCheckStatus != nil {
+		mmCheckStatus.inspectFuncCheckStatus(ctx, userID)
+	}
+
+	mm_params := UserServiceMockCheckStatusParams{ctx, userID}
+
+	// Record call args
+	mmCheckStatus.CheckStatusMock.mutex.Lock()
+	mmCheckStatus.CheckStatusMock.callArgs = append(mmCheckStatus.CheckStatusMock.callArgs, &mm_params)
+	mmCheckStatus.CheckStatusMock.mutex.Unlock()
+
+	for _, e := range mmCheckStatus.CheckStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckStatus.CheckStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckStatus.CheckStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckStatus.CheckStatusMock.defaultExpectation.params
+		mm_got := UserServiceMockCheckStatusParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckStatus.t.Errorf("UserServiceMock.CheckStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckStatus.CheckStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckStatus.t.Fatal("No results are set for the UserServiceMock.CheckStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckStatus.funcCheckStatus != nil {
+		return mmCheckStatus.funcCheckStatus(ctx, userID)
+	}
+	mmCheckStatus.t.Fatalf("Unexpected call to UserServiceMock.CheckStatus. %v %v", ctx, userID)
+	return
+}
+
+// CheckStatusAfterCounter returns a count of finished UserServiceMock.CheckStatus invocations
+func (mmCheckStatus *UserServiceMock) CheckStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckStatus.afterCheckStatusCounter)
+}
+
+// CheckStatusBeforeCounter returns a count of UserServiceMock.CheckStatus invocations
+func (mmCheckStatus *UserServiceMock) CheckStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckStatus.beforeCheckStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CheckStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckStatus *mUserServiceMockCheckStatus) Calls() []*UserServiceMockCheckStatusParams {
+	mmCheckStatus.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCheckStatusParams, len(mmCheckStatus.callArgs))
+	copy(argCopy, mmCheckStatus.callArgs)
+
+	mmCheckStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckStatusDone returns true if the count of the CheckStatus invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCheckStatusDone() bool {
+	for _, e := range m.CheckStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckStatusCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckStatus != nil && mm_atomic.LoadUint64(&m.afterCheckStatusCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckStatusInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCheckStatusInspect() {
+	for _, e := range m.CheckStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CheckStatus with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckStatusCounter) < 1 {
+		if m.CheckStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.CheckStatus")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CheckStatus with params: %#v", *m.CheckStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckStatus != nil && mm_atomic.LoadUint64(&m.afterCheckStatusCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.CheckStatus")
+	}
+}
+
+type mUserServiceMockCheckpointAudit struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCheckpointAuditExpectation
+	expectations       []*UserServiceMockCheckpointAuditExpectation
+
+	callArgs []*UserServiceMockCheckpointAuditParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockCheckpointAuditExpectation specifies expectation struct of the UserService.CheckpointAudit
+type UserServiceMockCheckpointAuditExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockCheckpointAuditParams
+	results *UserServiceMockCheckpointAuditResults
+	Counter uint64
+}
+
+// UserServiceMockCheckpointAuditParams contains parameters of the UserService.CheckpointAudit
+type UserServiceMockCheckpointAuditParams struct {
+	ctx context.Context
+}
+
+// UserServiceMockCheckpointAuditResults contains results of the UserService.CheckpointAudit
+type UserServiceMockCheckpointAuditResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.CheckpointAudit
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) Expect(ctx context.Context) *mUserServiceMockCheckpointAudit {
+	if mmCheckpointAudit.mock.funcCheckpointAudit != nil {
+		mmCheckpointAudit.mock.t.Fatalf("UserServiceMock.CheckpointAudit mock is already set by Set")
+	}
+
+	if mmCheckpointAudit.defaultExpectation == nil {
+		mmCheckpointAudit.defaultExpectation = &UserServiceMockCheckpointAuditExpectation{}
+	}
+
+	mmCheckpointAudit.defaultExpectation.params = &UserServiceMockCheckpointAuditParams{ctx}
+	for _, e := range mmCheckpointAudit.expectations {
+		if minimock.Equal(e.params, mmCheckpointAudit.defaultExpectation.params) {
+			mmCheckpointAudit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckpointAudit.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckpointAudit
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.CheckpointAudit
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) Inspect(f func(ctx context.Context)) *mUserServiceMockCheckpointAudit {
+	if mmCheckpointAudit.mock.inspectFuncCheckpointAudit != nil {
+		mmCheckpointAudit.mock.t.Fatalf("Inspect function is already set for UserServiceMock.CheckpointAudit")
+	}
+
+	mmCheckpointAudit.mock.inspectFuncCheckpointAudit = f
+
+	return mmCheckpointAudit
+}
+
+// Return sets up results that will be returned by UserService.CheckpointAudit
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) Return(err error) *UserServiceMock {
+	if mmCheckpointAudit.mock.funcCheckpointAudit != nil {
+		mmCheckpointAudit.mock.t.Fatalf("UserServiceMock.CheckpointAudit mock is already set by Set")
+	}
+
+	if mmCheckpointAudit.defaultExpectation == nil {
+		mmCheckpointAudit.defaultExpectation = &UserServiceMockCheckpointAuditExpectation{mock: mmCheckpointAudit.mock}
+	}
+	mmCheckpointAudit.defaultExpectation.results = &UserServiceMockCheckpointAuditResults{err}
+	return mmCheckpointAudit.mock
+}
+
+// Set uses given function f to mock the UserService.CheckpointAudit method
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) Set(f func(ctx context.Context) (err error)) *UserServiceMock {
+	if mmCheckpointAudit.defaultExpectation != nil {
+		mmCheckpointAudit.mock.t.Fatalf("Default expectation is already set for the UserService.CheckpointAudit method")
+	}
+
+	if len(mmCheckpointAudit.expectations) > 0 {
+		mmCheckpointAudit.mock.t.Fatalf("Some expectations are already set for the UserService.CheckpointAudit method")
+	}
+
+	mmCheckpointAudit.mock.funcCheckpointAudit = f
+	return mmCheckpointAudit.mock
+}
+
+// When sets expectation for the UserService.CheckpointAudit which will trigger the result defined by the following
+// Then helper
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) When(ctx context.Context) *UserServiceMockCheckpointAuditExpectation {
+	if mmCheckpointAudit.mock.funcCheckpointAudit != nil {
+		mmCheckpointAudit.mock.t.Fatalf("UserServiceMock.CheckpointAudit mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCheckpointAuditExpectation{
+		mock:   mmCheckpointAudit.mock,
+		params: &UserServiceMockCheckpointAuditParams{ctx},
+	}
+	mmCheckpointAudit.expectations = append(mmCheckpointAudit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.CheckpointAudit return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCheckpointAuditExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockCheckpointAuditResults{err}
+	return e.mock
+}
+
+// CheckpointAudit implements usecases.UserService
+func (mmCheckpointAudit *UserServiceMock) CheckpointAudit(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCheckpointAudit.beforeCheckpointAuditCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckpointAudit.afterCheckpointAuditCounter, 1)
+
+	if mmCheckpointAudit.inspectFuncCheckpointAudit != nil {
+		mmCheckpointAudit.inspectFuncCheckpointAudit(ctx)
+	}
+
+	mm_params := UserServiceMockCheckpointAuditParams{ctx}
+
+	// Record call args
+	mmCheckpointAudit.CheckpointAuditMock.mutex.Lock()
+	mmCheckpointAudit.CheckpointAuditMock.callArgs = append(mmCheckpointAudit.CheckpointAuditMock.callArgs, &mm_params)
+	mmCheckpointAudit.CheckpointAuditMock.mutex.Unlock()
+
+	for _, e := range mmCheckpointAudit.CheckpointAuditMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckpointAudit.CheckpointAuditMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckpointAudit.CheckpointAuditMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckpointAudit.CheckpointAuditMock.defaultExpectation.params
+		mm_got := UserServiceMockCheckpointAuditParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckpointAudit.t.Errorf("UserServiceMock.CheckpointAudit got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckpointAudit.CheckpointAuditMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckpointAudit.t.Fatal("No results are set for the UserServiceMock.CheckpointAudit")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckpointAudit.funcCheckpointAudit != nil {
+		return mmCheckpointAudit.funcCheckpointAudit(ctx)
+	}
+	mmCheckpointAudit.t.Fatalf("Unexpected call to UserServiceMock.CheckpointAudit. %v", ctx)
+	return
+}
+
+// CheckpointAuditAfterCounter returns a count of finished UserServiceMock.CheckpointAudit invocations
+func (mmCheckpointAudit *UserServiceMock) CheckpointAuditAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckpointAudit.afterCheckpointAuditCounter)
+}
+
+// CheckpointAuditBeforeCounter returns a count of UserServiceMock.CheckpointAudit invocations
+func (mmCheckpointAudit *UserServiceMock) CheckpointAuditBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckpointAudit.beforeCheckpointAuditCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.CheckpointAudit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckpointAudit *mUserServiceMockCheckpointAudit) Calls() []*UserServiceMockCheckpointAuditParams {
+	mmCheckpointAudit.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCheckpointAuditParams, len(mmCheckpointAudit.callArgs))
+	copy(argCopy, mmCheckpointAudit.callArgs)
+
+	mmCheckpointAudit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckpointAuditDone returns true if the count of the CheckpointAudit invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCheckpointAuditDone() bool {
+	for _, e := range m.CheckpointAuditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckpointAuditMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckpointAuditCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckpointAudit != nil && mm_atomic.LoadUint64(&m.afterCheckpointAuditCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckpointAuditInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCheckpointAuditInspect() {
+	for _, e := range m.CheckpointAuditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.CheckpointAudit with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckpointAuditMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckpointAuditCounter) < 1 {
+		if m.CheckpointAuditMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.CheckpointAudit")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.CheckpointAudit with params: %#v", *m.CheckpointAuditMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckpointAudit != nil && mm_atomic.LoadUint64(&m.afterCheckpointAuditCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.CheckpointAudit")
+	}
+}
+
+type mUserServiceMockConsumeLoginLink struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockConsumeLoginLinkExpectation
+	expectations       []*UserServiceMockConsumeLoginLinkExpectation
+
+	callArgs []*UserServiceMockConsumeLoginLinkParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockConsumeLoginLinkExpectation specifies expectation struct of the UserService.ConsumeLoginLink
+type UserServiceMockConsumeLoginLinkExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockConsumeLoginLinkParams
+	results *UserServiceMockConsumeLoginLinkResults
+	Counter uint64
+}
+
+// UserServiceMockConsumeLoginLinkParams contains parameters of the UserService.ConsumeLoginLink
+type UserServiceMockConsumeLoginLinkParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UserServiceMockConsumeLoginLinkResults contains results of the UserService.ConsumeLoginLink
+type UserServiceMockConsumeLoginLinkResults struct {
+	a1  def.AuthTokens
+	err error
+}
+
+// Expect sets up expected params for UserService.ConsumeLoginLink
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) Expect(ctx context.Context, token string) *mUserServiceMockConsumeLoginLink {
+	if mmConsumeLoginLink.mock.funcConsumeLoginLink != nil {
+		mmConsumeLoginLink.mock.t.Fatalf("UserServiceMock.ConsumeLoginLink mock is already set by Set")
+	}
+
+	if mmConsumeLoginLink.defaultExpectation == nil {
+		mmConsumeLoginLink.defaultExpectation = &UserServiceMockConsumeLoginLinkExpectation{}
+	}
+
+	mmConsumeLoginLink.defaultExpectation.params = &UserServiceMockConsumeLoginLinkParams{ctx, token}
+	for _, e := range mmConsumeLoginLink.expectations {
+		if minimock.Equal(e.params, mmConsumeLoginLink.defaultExpectation.params) {
+			mmConsumeLoginLink.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsumeLoginLink.defaultExpectation.params)
+		}
+	}
+
+	return mmConsumeLoginLink
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ConsumeLoginLink
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) Inspect(f func(ctx context.Context, token string)) *mUserServiceMockConsumeLoginLink {
+	if mmConsumeLoginLink.mock.inspectFuncConsumeLoginLink != nil {
+		mmConsumeLoginLink.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ConsumeLoginLink")
+	}
+
+	mmConsumeLoginLink.mock.inspectFuncConsumeLoginLink = f
+
+	return mmConsumeLoginLink
+}
+
+// Return sets up results that will be returned by UserService.ConsumeLoginLink
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) Return(a1 def.AuthTokens, err error) *UserServiceMock {
+	if mmConsumeLoginLink.mock.funcConsumeLoginLink != nil {
+		mmConsumeLoginLink.mock.t.Fatalf("UserServiceMock.ConsumeLoginLink mock is already set by Set")
+	}
+
+	if mmConsumeLoginLink.defaultExpectation == nil {
+		mmConsumeLoginLink.defaultExpectation = &UserServiceMockConsumeLoginLinkExpectation{mock: mmConsumeLoginLink.mock}
+	}
+	mmConsumeLoginLink.defaultExpectation.results = &UserServiceMockConsumeLoginLinkResults{a1, err}
+	return mmConsumeLoginLink.mock
+}
+
+// Set uses given function f to mock the UserService.ConsumeLoginLink method
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) Set(f func(ctx context.Context, token string) (a1 def.AuthTokens, err error)) *UserServiceMock {
+	if mmConsumeLoginLink.defaultExpectation != nil {
+		mmConsumeLoginLink.mock.t.Fatalf("Default expectation is already set for the UserService.ConsumeLoginLink method")
+	}
+
+	if len(mmConsumeLoginLink.expectations) > 0 {
+		mmConsumeLoginLink.mock.t.Fatalf("Some expectations are already set for the UserService.ConsumeLoginLink method")
+	}
+
+	mmConsumeLoginLink.mock.funcConsumeLoginLink = f
+	return mmConsumeLoginLink.mock
+}
+
+// When sets expectation for the UserService.ConsumeLoginLink which will trigger the result defined by the following
+// Then helper
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) When(ctx context.Context, token string) *UserServiceMockConsumeLoginLinkExpectation {
+	if mmConsumeLoginLink.mock.funcConsumeLoginLink != nil {
+		mmConsumeLoginLink.mock.t.Fatalf("UserServiceMock.ConsumeLoginLink mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockConsumeLoginLinkExpectation{
+		mock:   mmConsumeLoginLink.mock,
+		params: &UserServiceMockConsumeLoginLinkParams{ctx, token},
+	}
+	mmConsumeLoginLink.expectations = append(mmConsumeLoginLink.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ConsumeLoginLink return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockConsumeLoginLinkExpectation) Then(a1 def.AuthTokens, err error) *UserServiceMock {
+	e.results = &UserServiceMockConsumeLoginLinkResults{a1, err}
+	return e.mock
+}
+
+// ConsumeLoginLink implements usecases.UserService
+func (mmConsumeLoginLink *UserServiceMock) ConsumeLoginLink(ctx context.Context, token string) (a1 def.AuthTokens, err error) {
+	mm_atomic.AddUint64(&mmConsumeLoginLink.beforeConsumeLoginLinkCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsumeLoginLink.afterConsumeLoginLinkCounter, 1)
+
+	if mmConsumeLoginLink.inspectFuncConsumeLoginLink != nil {
+		mmConsumeLoginLink.inspectFuncConsumeLoginLink(ctx, token)
+	}
+
+	mm_params := UserServiceMockConsumeLoginLinkParams{ctx, token}
+
+	// Record call args
+	mmConsumeLoginLink.ConsumeLoginLinkMock.mutex.Lock()
+	mmConsumeLoginLink.ConsumeLoginLinkMock.callArgs = append(mmConsumeLoginLink.ConsumeLoginLinkMock.callArgs, &mm_params)
+	mmConsumeLoginLink.ConsumeLoginLinkMock.mutex.Unlock()
+
+	for _, e := range mmConsumeLoginLink.ConsumeLoginLinkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmConsumeLoginLink.ConsumeLoginLinkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsumeLoginLink.ConsumeLoginLinkMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsumeLoginLink.ConsumeLoginLinkMock.defaultExpectation.params
+		mm_got := UserServiceMockConsumeLoginLinkParams{ctx, token}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsumeLoginLink.t.Errorf("UserServiceMock.ConsumeLoginLink got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsumeLoginLink.ConsumeLoginLinkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsumeLoginLink.t.Fatal("No results are set for the UserServiceMock.ConsumeLoginLink")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmConsumeLoginLink.funcConsumeLoginLink != nil {
+		return mmConsumeLoginLink.funcConsumeLoginLink(ctx, token)
+	}
+	mmConsumeLoginLink.t.Fatalf("Unexpected call to UserServiceMock.ConsumeLoginLink. %v %v", ctx, token)
+	return
+}
+
+// ConsumeLoginLinkAfterCounter returns a count of finished UserServiceMock.ConsumeLoginLink invocations
+func (mmConsumeLoginLink *UserServiceMock) ConsumeLoginLinkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeLoginLink.afterConsumeLoginLinkCounter)
+}
+
+// ConsumeLoginLinkBeforeCounter returns a count of UserServiceMock.ConsumeLoginLink invocations
+func (mmConsumeLoginLink *UserServiceMock) ConsumeLoginLinkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsumeLoginLink.beforeConsumeLoginLinkCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ConsumeLoginLink.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsumeLoginLink *mUserServiceMockConsumeLoginLink) Calls() []*UserServiceMockConsumeLoginLinkParams {
+	mmConsumeLoginLink.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockConsumeLoginLinkParams, len(mmConsumeLoginLink.callArgs))
+	copy(argCopy, mmConsumeLoginLink.callArgs)
+
+	mmConsumeLoginLink.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeLoginLinkDone returns true if the count of the ConsumeLoginLink invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockConsumeLoginLinkDone() bool {
+	for _, e := range m.ConsumeLoginLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeLoginLinkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConsumeLoginLinkCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsumeLoginLink != nil && mm_atomic.LoadUint64(&m.afterConsumeLoginLinkCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockConsumeLoginLinkInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockConsumeLoginLinkInspect() {
+	for _, e := range m.ConsumeLoginLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ConsumeLoginLink with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeLoginLinkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConsumeLoginLinkCounter) < 1 {
+		if m.ConsumeLoginLinkMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ConsumeLoginLink")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ConsumeLoginLink with params: %#v", *m.ConsumeLoginLinkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsumeLoginLink != nil && mm_atomic.LoadUint64(&m.afterConsumeLoginLinkCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ConsumeLoginLink")
+	}
+}
+
+type mUserServiceMockCreate struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCreateExpectation
+	expectations       []*UserServiceMockCreateExpectation
+
+	callArgs []*UserServiceMockCreateParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockCreateExpectation specifies expectation struct of the UserService.Create
+type UserServiceMockCreateExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockCreateParams
+	results *UserServiceMockCreateResults
+	Counter uint64
+}
+
+// UserServiceMockCreateParams contains parameters of the UserService.Create
+type UserServiceMockCreateParams struct {
+	ctx context.Context
+	req def.CreateDTO
+}
+
+// UserServiceMockCreateResults contains results of the UserService.Create
+type UserServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for UserService.Create
+func (mmCreate *mUserServiceMockCreate) Expect(ctx context.Context, req def.CreateDTO) *mUserServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	}
+
+	mmCreate.defaultExpectation.params = &UserServiceMockCreateParams{ctx, req}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Create
+func (mmCreate *mUserServiceMockCreate) Inspect(f func(ctx context.Context, req def.CreateDTO)) *mUserServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by UserService.Create
+func (mmCreate *mUserServiceMockCreate) Return(i1 int64, err error) *UserServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &UserServiceMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the UserService.Create method
+func (mmCreate *mUserServiceMockCreate) Set(f func(ctx context.Context, req def.CreateDTO) (i1 int64, err error)) *UserServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the UserService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mUserServiceMockCreate) When(ctx context.Context, req def.CreateDTO) *UserServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &UserServiceMockCreateParams{ctx, req},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Create return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCreateExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Create implements usecases.UserService
+func (mmCreate *UserServiceMock) Create(ctx context.Context, req def.CreateDTO) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := UserServiceMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_got := UserServiceMockCreateParams{ctx, req}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the UserServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to UserServiceMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished UserServiceMock.Create invocations
+func (mmCreate *UserServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of UserServiceMock.Create invocations
+func (mmCreate *UserServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mUserServiceMockCreate) Calls() []*UserServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCreateDone() bool {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.Create")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.Create")
+	}
+}
+
+type mUserServiceMockDelete struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeleteExpectation
+	expectations       []*UserServiceMockDeleteExpectation
+
+	callArgs []*UserServiceMockDeleteParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockDeleteExpectation specifies expectation struct of the UserService.Delete
+type UserServiceMockDeleteExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockDeleteParams
+	results *UserServiceMockDeleteResults
+	Counter uint64
+}
+
+// UserServiceMockDeleteParams contains parameters of the UserService.Delete
+type UserServiceMockDeleteParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockDeleteResults contains results of the UserService.Delete
+type UserServiceMockDeleteResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.Delete
+func (mmDelete *mUserServiceMockDelete) Expect(ctx context.Context, userID int64) *mUserServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	}
+
+	mmDelete.defaultExpectation.params = &UserServiceMockDeleteParams{ctx, userID}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Delete
+func (mmDelete *mUserServiceMockDelete) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by UserService.Delete
+func (mmDelete *mUserServiceMockDelete) Return(err error) *UserServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &UserServiceMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the UserService.Delete method
+func (mmDelete *mUserServiceMockDelete) Set(f func(ctx context.Context, userID int64) (err error)) *UserServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the UserService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mUserServiceMockDelete) When(ctx context.Context, userID int64) *UserServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &UserServiceMockDeleteParams{ctx, userID},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Delete return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeleteExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Delete implements usecases.UserService
+func (mmDelete *UserServiceMock) Delete(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, userID)
+	}
+
+	mm_params := UserServiceMockDeleteParams{ctx, userID}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_got := UserServiceMockDeleteParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the UserServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, userID)
+	}
+	mmDelete.t.Fatalf("Unexpected call to UserServiceMock.Delete. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished UserServiceMock.Delete invocations
+func (mmDelete *UserServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of UserServiceMock.Delete invocations
+func (mmDelete *UserServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mUserServiceMockDelete) Calls() []*UserServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeleteDone() bool {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.Delete")
+	}
+}
+
+type mUserServiceMockEraseUser struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockEraseUserExpectation
+	expectations       []*UserServiceMockEraseUserExpectation
+
+	callArgs []*UserServiceMockEraseUserParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockEraseUserExpectation specifies expectation struct of the UserService.EraseUser
+type UserServiceMockEraseUserExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockEraseUserParams
+	results *UserServiceMockEraseUserResults
+	Counter uint64
+}
+
+// UserServiceMockEraseUserParams contains parameters of the UserService.EraseUser
+type UserServiceMockEraseUserParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockEraseUserResults contains results of the UserService.EraseUser
+type UserServiceMockEraseUserResults struct {
+	e1  def.Erasure
+	err error
+}
+
+// Expect sets up expected params for UserService.EraseUser
+func (mmEraseUser *mUserServiceMockEraseUser) Expect(ctx context.Context, userID int64) *mUserServiceMockEraseUser {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("UserServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &UserServiceMockEraseUserExpectation{}
+	}
+
+	mmEraseUser.defaultExpectation.params = &UserServiceMockEraseUserParams{ctx, userID}
+	for _, e := range mmEraseUser.expectations {
+		if minimock.Equal(e.params, mmEraseUser.defaultExpectation.params) {
+			mmEraseUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEraseUser.defaultExpectation.params)
+		}
+	}
+
+	return mmEraseUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.EraseUser
+func (mmEraseUser *mUserServiceMockEraseUser) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockEraseUser {
+	if mmEraseUser.mock.inspectFuncEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.EraseUser")
+	}
+
+	mmEraseUser.mock.inspectFuncEraseUser = f
+
+	return mmEraseUser
+}
+
+// Return sets up results that will be returned by UserService.EraseUser
+func (mmEraseUser *mUserServiceMockEraseUser) Return(e1 def.Erasure, err error) *UserServiceMock {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("UserServiceMock.EraseUser mock is already set by Set")
+	}
+
+	if mmEraseUser.defaultExpectation == nil {
+		mmEraseUser.defaultExpectation = &UserServiceMockEraseUserExpectation{mock: mmEraseUser.mock}
+	}
+	mmEraseUser.defaultExpectation.results = &UserServiceMockEraseUserResults{e1, err}
+	return mmEraseUser.mock
+}
+
+// Set uses given function f to mock the UserService.EraseUser method
+func (mmEraseUser *mUserServiceMockEraseUser) Set(f func(ctx context.Context, userID int64) (e1 def.Erasure, err error)) *UserServiceMock {
+	if mmEraseUser.defaultExpectation != nil {
+		mmEraseUser.mock.t.Fatalf("Default expectation is already set for the UserService.EraseUser method")
+	}
+
+	if len(mmEraseUser.expectations) > 0 {
+		mmEraseUser.mock.t.Fatalf("Some expectations are already set for the UserService.EraseUser method")
+	}
+
+	mmEraseUser.mock.funcEraseUser = f
+	return mmEraseUser.mock
+}
+
+// When sets expectation for the UserService.EraseUser which will trigger the result defined by the following
+// Then helper
+func (mmEraseUser *mUserServiceMockEraseUser) When(ctx context.Context, userID int64) *UserServiceMockEraseUserExpectation {
+	if mmEraseUser.mock.funcEraseUser != nil {
+		mmEraseUser.mock.t.Fatalf("UserServiceMock.EraseUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockEraseUserExpectation{
+		mock:   mmEraseUser.mock,
+		params: &UserServiceMockEraseUserParams{ctx, userID},
+	}
+	mmEraseUser.expectations = append(mmEraseUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.EraseUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockEraseUserExpectation) Then(e1 def.Erasure, err error) *UserServiceMock {
+	e.results = &UserServiceMockEraseUserResults{e1, err}
+	return e.mock
+}
+
+// EraseUser implements usecases.UserService
+func (mmEraseUser *UserServiceMock) EraseUser(ctx context.Context, userID int64) (e1 def.Erasure, err error) {
+	mm_atomic.AddUint64(&mmEraseUser.beforeEraseUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmEraseUser.afterEraseUserCounter, 1)
+
+	if mmEraseUser.inspectFuncEraseUser != nil {
+		mmEraseUser.inspectFuncEraseUser(ctx, userID)
+	}
+
+	mm_params := UserServiceMockEraseUserParams{ctx, userID}
+
+	// Record call args
+	mmEraseUser.EraseUserMock.mutex.Lock()
+	mmEraseUser.EraseUserMock.callArgs = append(mmEraseUser.EraseUserMock.callArgs, &mm_params)
+	mmEraseUser.EraseUserMock.mutex.Unlock()
+
+	for _, e := range mmEraseUser.EraseUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmEraseUser.EraseUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEraseUser.EraseUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmEraseUser.EraseUserMock.defaultExpectation.params
+		mm_got := UserServiceMockEraseUserParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEraseUser.t.Errorf("UserServiceMock.EraseUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEraseUser.EraseUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEraseUser.t.Fatal("No results are set for the UserServiceMock.EraseUser")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmEraseUser.funcEraseUser != nil {
+		return mmEraseUser.funcEraseUser(ctx, userID)
+	}
+	mmEraseUser.t.Fatalf("Unexpected call to UserServiceMock.EraseUser. %v %v", ctx, userID)
+	return
+}
+
+// EraseUserAfterCounter returns a count of finished UserServiceMock.EraseUser invocations
+func (mmEraseUser *UserServiceMock) EraseUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUser.afterEraseUserCounter)
+}
+
+// EraseUserBeforeCounter returns a count of UserServiceMock.EraseUser invocations
+func (mmEraseUser *UserServiceMock) EraseUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUser.beforeEraseUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.EraseUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEraseUser *mUserServiceMockEraseUser) Calls() []*UserServiceMockEraseUserParams {
+	mmEraseUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockEraseUserParams, len(mmEraseUser.callArgs))
+	copy(argCopy, mmEraseUser.callArgs)
+
+	mmEraseUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEraseUserDone returns true if the count of the EraseUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockEraseUserDone() bool {
+	for _, e := range m.EraseUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EraseUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEraseUserCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEraseUser != nil && mm_atomic.LoadUint64(&m.afterEraseUserCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEraseUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockEraseUserInspect() {
+	for _, e := range m.EraseUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.EraseUser with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EraseUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEraseUserCounter) < 1 {
+		if m.EraseUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.EraseUser")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.EraseUser with params: %#v", *m.EraseUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEraseUser != nil && mm_atomic.LoadUint64(&m.afterEraseUserCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.EraseUser")
+	}
+}
+
+type mUserServiceMockExportMyData struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockExportMyDataExpectation
+	expectations       []*UserServiceMockExportMyDataExpectation
+
+	callArgs []*UserServiceMockExportMyDataParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockExportMyDataExpectation specifies expectation struct of the UserService.ExportMyData
+type UserServiceMockExportMyDataExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockExportMyDataParams
+	results *UserServiceMockExportMyDataResults
+	Counter uint64
+}
+
+// UserServiceMockExportMyDataParams contains parameters of the UserService.ExportMyData
+type UserServiceMockExportMyDataParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockExportMyDataResults contains results of the UserService.ExportMyData
+type UserServiceMockExportMyDataResults struct {
+	ba1 []byte
+	err error
+}
+
+// Expect sets up expected params for UserService.ExportMyData
+func (mmExportMyData *mUserServiceMockExportMyData) Expect(ctx context.Context, userID int64) *mUserServiceMockExportMyData {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("UserServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &UserServiceMockExportMyDataExpectation{}
+	}
+
+	mmExportMyData.defaultExpectation.params = &UserServiceMockExportMyDataParams{ctx, userID}
+	for _, e := range mmExportMyData.expectations {
+		if minimock.Equal(e.params, mmExportMyData.defaultExpectation.params) {
+			mmExportMyData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportMyData.defaultExpectation.params)
+		}
+	}
+
+	return mmExportMyData
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ExportMyData
+func (mmExportMyData *mUserServiceMockExportMyData) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockExportMyData {
+	if mmExportMyData.mock.inspectFuncExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ExportMyData")
+	}
+
+	mmExportMyData.mock.inspectFuncExportMyData = f
+
+	return mmExportMyData
+}
+
+// Return sets up results that will be returned by UserService.ExportMyData
+func (mmExportMyData *mUserServiceMockExportMyData) Return(ba1 []byte, err error) *UserServiceMock {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("UserServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	if mmExportMyData.defaultExpectation == nil {
+		mmExportMyData.defaultExpectation = &UserServiceMockExportMyDataExpectation{mock: mmExportMyData.mock}
+	}
+	mmExportMyData.defaultExpectation.results = &UserServiceMockExportMyDataResults{ba1, err}
+	return mmExportMyData.mock
+}
+
+// Set uses given function f to mock the UserService.ExportMyData method
+func (mmExportMyData *mUserServiceMockExportMyData) Set(f func(ctx context.Context, userID int64) (ba1 []byte, err error)) *UserServiceMock {
+	if mmExportMyData.defaultExpectation != nil {
+		mmExportMyData.mock.t.Fatalf("Default expectation is already set for the UserService.ExportMyData method")
+	}
+
+	if len(mmExportMyData.expectations) > 0 {
+		mmExportMyData.mock.t.Fatalf("Some expectations are already set for the UserService.ExportMyData method")
+	}
+
+	mmExportMyData.mock.funcExportMyData = f
+	return mmExportMyData.mock
+}
+
+// When sets expectation for the UserService.ExportMyData which will trigger the result defined by the following
+// Then helper
+func (mmExportMyData *mUserServiceMockExportMyData) When(ctx context.Context, userID int64) *UserServiceMockExportMyDataExpectation {
+	if mmExportMyData.mock.funcExportMyData != nil {
+		mmExportMyData.mock.t.Fatalf("UserServiceMock.ExportMyData mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockExportMyDataExpectation{
+		mock:   mmExportMyData.mock,
+		params: &UserServiceMockExportMyDataParams{ctx, userID},
+	}
+	mmExportMyData.expectations = append(mmExportMyData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ExportMyData return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockExportMyDataExpectation) Then(ba1 []byte, err error) *UserServiceMock {
+	e.results = &UserServiceMockExportMyDataResults{ba1, err}
+	return e.mock
+}
+
+// ExportMyData implements usecases.UserService
+func (mmExportMyData *UserServiceMock) ExportMyData(ctx context.Context, userID int64) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmExportMyData.beforeExportMyDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportMyData.afterExportMyDataCounter, 1)
+
+	if mmExportMyData.inspectFuncExportMyData != nil {
+		mmExportMyData.inspectFuncExportMyData(ctx, userID)
+	}
+
+	mm_params := UserServiceMockExportMyDataParams{ctx, userID}
+
+	// Record call args
+	mmExportMyData.ExportMyDataMock.mutex.Lock()
+	mmExportMyData.ExportMyDataMock.callArgs = append(mmExportMyData.ExportMyDataMock.callArgs, &mm_params)
+	mmExportMyData.ExportMyDataMock.mutex.Unlock()
+
+	for _, e := range mmExportMyData.ExportMyDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmExportMyData.ExportMyDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportMyData.ExportMyDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportMyData.ExportMyDataMock.defaultExpectation.params
+		mm_got := UserServiceMockExportMyDataParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportMyData.t.Errorf("UserServiceMock.ExportMyData got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportMyData.ExportMyDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportMyData.t.Fatal("No results are set for the UserServiceMock.ExportMyData")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmExportMyData.funcExportMyData != nil {
+		return mmExportMyData.funcExportMyData(ctx, userID)
+	}
+	mmExportMyData.t.Fatalf("Unexpected call to UserServiceMock.ExportMyData. %v %v", ctx, userID)
+	return
+}
+
+// ExportMyDataAfterCounter returns a count of finished UserServiceMock.ExportMyData invocations
+func (mmExportMyData *UserServiceMock) ExportMyDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportMyData.afterExportMyDataCounter)
+}
+
+// ExportMyDataBeforeCounter returns a count of UserServiceMock.ExportMyData invocations
+func (mmExportMyData *UserServiceMock) ExportMyDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportMyData.beforeExportMyDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ExportMyData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportMyData *mUserServiceMockExportMyData) Calls() []*UserServiceMockExportMyDataParams {
+	mmExportMyData.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockExportMyDataParams, len(mmExportMyData.callArgs))
+	copy(argCopy, mmExportMyData.callArgs)
+
+	mmExportMyData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportMyDataDone returns true if the count of the ExportMyData invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockExportMyDataDone() bool {
+	for _, e := range m.ExportMyDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMyDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExportMyDataCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportMyData != nil && mm_atomic.LoadUint64(&m.afterExportMyDataCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExportMyDataInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockExportMyDataInspect() {
+	for _, e := range m.ExportMyDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ExportMyData with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMyDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExportMyDataCounter) < 1 {
+		if m.ExportMyDataMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ExportMyData")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ExportMyData with params: %#v", *m.ExportMyDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportMyData != nil && mm_atomic.LoadUint64(&m.afterExportMyDataCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ExportMyData")
+	}
+}
+
+type mUserServiceMockExportUsers struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockExportUsersExpectation
+	expectations       []*UserServiceMockExportUsersExpectation
+
+	callArgs []*UserServiceMockExportUsersParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockExportUsersExpectation specifies expectation struct of the UserService.ExportUsers
+type UserServiceMockExportUsersExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockExportUsersParams
+	results *UserServiceMockExportUsersResults
+	Counter uint64
+}
+
+// UserServiceMockExportUsersParams contains parameters of the UserService.ExportUsers
+type UserServiceMockExportUsersParams struct {
+	ctx    context.Context
+	format string
+	w      io.Writer
+}
+
+// UserServiceMockExportUsersResults contains results of the UserService.ExportUsers
+type UserServiceMockExportUsersResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Expect(ctx context.Context, format string, w io.Writer) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
+	}
+
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{}
+	}
+
+	mmExportUsers.defaultExpectation.params = &UserServiceMockExportUsersParams{ctx, format, w}
+	for _, e := range mmExportUsers.expectations {
+		if minimock.Equal(e.params, mmExportUsers.defaultExpectation.params) {
+			mmExportUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmExportUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Inspect(f func(ctx context.Context, format string, w io.Writer)) *mUserServiceMockExportUsers {
+	if mmExportUsers.mock.inspectFuncExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ExportUsers")
+	}
+
+	mmExportUsers.mock.inspectFuncExportUsers = f
+
+	return mmExportUsers
+}
+
+// Return sets up results that will be returned by UserService.ExportUsers
+func (mmExportUsers *mUserServiceMockExportUsers) Return(i1 int64, err error) *UserServiceMock {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
+	}
+
+	if mmExportUsers.defaultExpectation == nil {
+		mmExportUsers.defaultExpectation = &UserServiceMockExportUsersExpectation{mock: mmExportUsers.mock}
+	}
+	mmExportUsers.defaultExpectation.results = &UserServiceMockExportUsersResults{i1, err}
+	return mmExportUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ExportUsers method
+func (mmExportUsers *mUserServiceMockExportUsers) Set(f func(ctx context.Context, format string, w io.Writer) (i1 int64, err error)) *UserServiceMock {
+	if mmExportUsers.defaultExpectation != nil {
+		mmExportUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ExportUsers method")
+	}
+
+	if len(mmExportUsers.expectations) > 0 {
+		mmExportUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ExportUsers method")
+	}
+
+	mmExportUsers.mock.funcExportUsers = f
+	return mmExportUsers.mock
+}
+
+// When sets expectation for the UserService.ExportUsers which will trigger the result defined by the following
+// Then helper
+func (mmExportUsers *mUserServiceMockExportUsers) When(ctx context.Context, format string, w io.Writer) *UserServiceMockExportUsersExpectation {
+	if mmExportUsers.mock.funcExportUsers != nil {
+		mmExportUsers.mock.t.Fatalf("UserServiceMock.ExportUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockExportUsersExpectation{
+		mock:   mmExportUsers.mock,
+		params: &UserServiceMockExportUsersParams{ctx, format, w},
+	}
+	mmExportUsers.expectations = append(mmExportUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ExportUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockExportUsersExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockExportUsersResults{i1, err}
+	return e.mock
+}
+
+// ExportUsers implements usecases.UserService
+func (mmExportUsers *UserServiceMock) ExportUsers(ctx context.Context, format string, w io.Writer) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmExportUsers.beforeExportUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportUsers.afterExportUsersCounter, 1)
+
+	if mmExportUsers.inspectFuncExportUsers != nil {
+		mmExportUsers.inspectFuncExportUsers(ctx, format, w)
+	}
+
+	mm_params := UserServiceMockExportUsersParams{ctx, format, w}
+
+	// Record call args
+	mmExportUsers.ExportUsersMock.mutex.Lock()
+	mmExportUsers.ExportUsersMock.callArgs = append(mmExportUsers.ExportUsersMock.callArgs, &mm_params)
+	mmExportUsers.ExportUsersMock.mutex.Unlock()
+
+	for _, e := range mmExportUsers.ExportUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmExportUsers.ExportUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportUsers.ExportUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportUsers.ExportUsersMock.defaultExpectation.params
+		mm_got := UserServiceMockExportUsersParams{ctx, format, w}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportUsers.t.Errorf("UserServiceMock.ExportUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportUsers.ExportUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportUsers.t.Fatal("No results are set for the UserServiceMock.ExportUsers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmExportUsers.funcExportUsers != nil {
+		return mmExportUsers.funcExportUsers(ctx, format, w)
+	}
+	mmExportUsers.t.Fatalf("Unexpected call to UserServiceMock.ExportUsers. %v %v %v", ctx, format, w)
+	return
+}
+
+// ExportUsersAfterCounter returns a count of finished UserServiceMock.ExportUsers invocations
+func (mmExportUsers *UserServiceMock) ExportUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUsers.afterExportUsersCounter)
+}
+
+// ExportUsersBeforeCounter returns a count of UserServiceMock.ExportUsers invocations
+func (mmExportUsers *UserServiceMock) ExportUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUsers.beforeExportUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ExportUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportUsers *mUserServiceMockExportUsers) Calls() []*UserServiceMockExportUsersParams {
+	mmExportUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockExportUsersParams, len(mmExportUsers.callArgs))
+	copy(argCopy, mmExportUsers.callArgs)
+
+	mmExportUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportUsersDone returns true if the count of the ExportUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockExportUsersDone() bool {
+	for _, e := range m.ExportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExportUsersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportUsers != nil && mm_atomic.LoadUint64(&m.afterExportUsersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExportUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockExportUsersInspect() {
+	for _, e := range m.ExportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ExportUsers with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExportUsersCounter) < 1 {
+		if m.ExportUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ExportUsers")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ExportUsers with params: %#v", *m.ExportUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportUsers != nil && mm_atomic.LoadUint64(&m.afterExportUsersCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ExportUsers")
+	}
+}
+
+type mUserServiceMockGet struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetExpectation
+	expectations       []*UserServiceMockGetExpectation
+
+	callArgs []*UserServiceMockGetParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockGetExpectation specifies expectation struct of the UserService.Get
+type UserServiceMockGetExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockGetParams
+	results *UserServiceMockGetResults
+	Counter uint64
+}
+
+// UserServiceMockGetParams contains parameters of the UserService.Get
+type UserServiceMockGetParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockGetResults contains results of the UserService.Get
+type UserServiceMockGetResults struct {
+	u1  def.UserDTO
+	err error
+}
+
+// Expect sets up expected params for UserService.Get
+func (mmGet *mUserServiceMockGet) Expect(ctx context.Context, userID int64) *mUserServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	}
+
+	mmGet.defaultExpectation.params = &UserServiceMockGetParams{ctx, userID}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Get
+func (mmGet *mUserServiceMockGet) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by UserService.Get
+func (mmGet *mUserServiceMockGet) Return(u1 def.UserDTO, err error) *UserServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &UserServiceMockGetResults{u1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the UserService.Get method
+func (mmGet *mUserServiceMockGet) Set(f func(ctx context.Context, userID int64) (u1 def.UserDTO, err error)) *UserServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the UserService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the UserService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the UserService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mUserServiceMockGet) When(ctx context.Context, userID int64) *UserServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &UserServiceMockGetParams{ctx, userID},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Get return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetExpectation) Then(u1 def.UserDTO, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetResults{u1, err}
+	return e.mock
+}
+
+// Get implements usecases.UserService
+func (mmGet *UserServiceMock) Get(ctx context.Context, userID int64) (u1 def.UserDTO, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, userID)
+	}
+
+	mm_params := UserServiceMockGetParams{ctx, userID}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_got := UserServiceMockGetParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("UserServiceMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the UserServiceMock.Get")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, userID)
+	}
+	mmGet.t.Fatalf("Unexpected call to UserServiceMock.Get. %v %v", ctx, userID)
+	return
+}
+
+// GetAfterCounter returns a count of finished UserServiceMock.Get invocations
+func (mmGet *UserServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of UserServiceMock.Get invocations
+func (mmGet *UserServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mUserServiceMockGet) Calls() []*UserServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetDone() bool {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.Get")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.Get")
+	}
+}
+
+type mUserServiceMockImportUsers struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockImportUsersExpectation
+	expectations       []*UserServiceMockImportUsersExpectation
+
+	callArgs []*UserServiceMockImportUsersParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockImportUsersExpectation specifies expectation struct of the UserService.ImportUsers
+type UserServiceMockImportUsersExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockImportUsersParams
+	results *UserServiceMockImportUsersResults
+	Counter uint64
+}
+
+// UserServiceMockImportUsersParams contains parameters of the UserService.ImportUsers
+type UserServiceMockImportUsersParams struct {
+	ctx    context.Context
+	format string
+	r      io.Reader
+}
+
+// UserServiceMockImportUsersResults contains results of the UserService.ImportUsers
+type UserServiceMockImportUsersResults struct {
+	i1  def.ImportResult
+	err error
+}
+
+// Expect sets up expected params for UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Expect(ctx context.Context, format string, r io.Reader) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{}
+	}
+
+	mmImportUsers.defaultExpectation.params = &UserServiceMockImportUsersParams{ctx, format, r}
+	for _, e := range mmImportUsers.expectations {
+		if minimock.Equal(e.params, mmImportUsers.defaultExpectation.params) {
+			mmImportUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmImportUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Inspect(f func(ctx context.Context, format string, r io.Reader)) *mUserServiceMockImportUsers {
+	if mmImportUsers.mock.inspectFuncImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ImportUsers")
+	}
+
+	mmImportUsers.mock.inspectFuncImportUsers = f
+
+	return mmImportUsers
+}
+
+// Return sets up results that will be returned by UserService.ImportUsers
+func (mmImportUsers *mUserServiceMockImportUsers) Return(i1 def.ImportResult, err error) *UserServiceMock {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	if mmImportUsers.defaultExpectation == nil {
+		mmImportUsers.defaultExpectation = &UserServiceMockImportUsersExpectation{mock: mmImportUsers.mock}
+	}
+	mmImportUsers.defaultExpectation.results = &UserServiceMockImportUsersResults{i1, err}
+	return mmImportUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ImportUsers method
+func (mmImportUsers *mUserServiceMockImportUsers) Set(f func(ctx context.Context, format string, r io.Reader) (i1 def.ImportResult, err error)) *UserServiceMock {
+	if mmImportUsers.defaultExpectation != nil {
+		mmImportUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ImportUsers method")
+	}
+
+	if len(mmImportUsers.expectations) > 0 {
+		mmImportUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ImportUsers method")
+	}
+
+	mmImportUsers.mock.funcImportUsers = f
+	return mmImportUsers.mock
+}
+
+// When sets expectation for the UserService.ImportUsers which will trigger the result defined by the following
+// Then helper
+func (mmImportUsers *mUserServiceMockImportUsers) When(ctx context.Context, format string, r io.Reader) *UserServiceMockImportUsersExpectation {
+	if mmImportUsers.mock.funcImportUsers != nil {
+		mmImportUsers.mock.t.Fatalf("UserServiceMock.ImportUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockImportUsersExpectation{
+		mock:   mmImportUsers.mock,
+		params: &UserServiceMockImportUsersParams{ctx, format, r},
+	}
+	mmImportUsers.expectations = append(mmImportUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ImportUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockImportUsersExpectation) Then(i1 def.ImportResult, err error) *UserServiceMock {
+	e.results = &UserServiceMockImportUsersResults{i1, err}
+	return e.mock
+}
+
+// ImportUsers implements usecases.UserService
+func (mmImportUsers *UserServiceMock) ImportUsers(ctx context.Context, format string, r io.Reader) (i1 def.ImportResult, err error) {
+	mm_atomic.AddUint64(&mmImportUsers.beforeImportUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportUsers.afterImportUsersCounter, 1)
+
+	if mmImportUsers.inspectFuncImportUsers != nil {
+		mmImportUsers.inspectFuncImportUsers(ctx, format, r)
+	}
+
+	mm_params := UserServiceMockImportUsersParams{ctx, format, r}
+
+	// Record call args
+	mmImportUsers.ImportUsersMock.mutex.Lock()
+	mmImportUsers.ImportUsersMock.callArgs = append(mmImportUsers.ImportUsersMock.callArgs, &mm_params)
+	mmImportUsers.ImportUsersMock.mutex.Unlock()
+
+	for _, e := range mmImportUsers.ImportUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmImportUsers.ImportUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportUsers.ImportUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportUsers.ImportUsersMock.defaultExpectation.params
+		mm_got := UserServiceMockImportUsersParams{ctx, format, r}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportUsers.t.Errorf("UserServiceMock.ImportUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportUsers.ImportUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportUsers.t.Fatal("No results are set for the UserServiceMock.ImportUsers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmImportUsers.funcImportUsers != nil {
+		return mmImportUsers.funcImportUsers(ctx, format, r)
+	}
+	mmImportUsers.t.Fatalf("Unexpected call to UserServiceMock.ImportUsers. %v %v %v", ctx, format, r)
+	return
+}
+
+// ImportUsersAfterCounter returns a count of finished UserServiceMock.ImportUsers invocations
+func (mmImportUsers *UserServiceMock) ImportUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportUsers.afterImportUsersCounter)
+}
+
+// ImportUsersBeforeCounter returns a count of UserServiceMock.ImportUsers invocations
+func (mmImportUsers *UserServiceMock) ImportUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportUsers.beforeImportUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ImportUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportUsers *mUserServiceMockImportUsers) Calls() []*UserServiceMockImportUsersParams {
+	mmImportUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockImportUsersParams, len(mmImportUsers.callArgs))
+	copy(argCopy, mmImportUsers.callArgs)
+
+	mmImportUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportUsersDone returns true if the count of the ImportUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockImportUsersDone() bool {
+	for _, e := range m.ImportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterImportUsersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportUsers != nil && mm_atomic.LoadUint64(&m.afterImportUsersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockImportUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockImportUsersInspect() {
+	for _, e := range m.ImportUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ImportUsers with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterImportUsersCounter) < 1 {
+		if m.ImportUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ImportUsers")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ImportUsers with params: %#v", *m.ImportUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportUsers != nil && mm_atomic.LoadUint64(&m.afterImportUsersCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ImportUsers")
+	}
+}
+
+type mUserServiceMockListAuditEvents struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListAuditEventsExpectation
+	expectations       []*UserServiceMockListAuditEventsExpectation
+
+	callArgs []*UserServiceMockListAuditEventsParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockListAuditEventsExpectation specifies expectation struct of the UserService.ListAuditEvents
+type UserServiceMockListAuditEventsExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockListAuditEventsParams
+	results *UserServiceMockListAuditEventsResults
+	Counter uint64
+}
+
+// UserServiceMockListAuditEventsParams contains parameters of the UserService.ListAuditEvents
+type UserServiceMockListAuditEventsParams struct {
+	ctx context.Context
+	req def.AuditListDTO
+}
+
+// UserServiceMockListAuditEventsResults contains results of the UserService.ListAuditEvents
+type UserServiceMockListAuditEventsResults struct {
+	a1  def.AuditPage
+	err error
+}
+
+// Expect sets up expected params for UserService.ListAuditEvents
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) Expect(ctx context.Context, req def.AuditListDTO) *mUserServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("UserServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &UserServiceMockListAuditEventsExpectation{}
+	}
+
+	mmListAuditEvents.defaultExpectation.params = &UserServiceMockListAuditEventsParams{ctx, req}
+	for _, e := range mmListAuditEvents.expectations {
+		if minimock.Equal(e.params, mmListAuditEvents.defaultExpectation.params) {
+			mmListAuditEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListAuditEvents
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) Inspect(f func(ctx context.Context, req def.AuditListDTO)) *mUserServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListAuditEvents")
+	}
+
+	mmListAuditEvents.mock.inspectFuncListAuditEvents = f
+
+	return mmListAuditEvents
+}
+
+// Return sets up results that will be returned by UserService.ListAuditEvents
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) Return(a1 def.AuditPage, err error) *UserServiceMock {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("UserServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &UserServiceMockListAuditEventsExpectation{mock: mmListAuditEvents.mock}
+	}
+	mmListAuditEvents.defaultExpectation.results = &UserServiceMockListAuditEventsResults{a1, err}
+	return mmListAuditEvents.mock
+}
+
+// Set uses given function f to mock the UserService.ListAuditEvents method
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) Set(f func(ctx context.Context, req def.AuditListDTO) (a1 def.AuditPage, err error)) *UserServiceMock {
+	if mmListAuditEvents.defaultExpectation != nil {
+		mmListAuditEvents.mock.t.Fatalf("Default expectation is already set for the UserService.ListAuditEvents method")
+	}
+
+	if len(mmListAuditEvents.expectations) > 0 {
+		mmListAuditEvents.mock.t.Fatalf("Some expectations are already set for the UserService.ListAuditEvents method")
+	}
+
+	mmListAuditEvents.mock.funcListAuditEvents = f
+	return mmListAuditEvents.mock
+}
+
+// When sets expectation for the UserService.ListAuditEvents which will trigger the result defined by the following
+// Then helper
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) When(ctx context.Context, req def.AuditListDTO) *UserServiceMockListAuditEventsExpectation {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("UserServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListAuditEventsExpectation{
+		mock:   mmListAuditEvents.mock,
+		params: &UserServiceMockListAuditEventsParams{ctx, req},
+	}
+	mmListAuditEvents.expectations = append(mmListAuditEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListAuditEvents return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListAuditEventsExpectation) Then(a1 def.AuditPage, err error) *UserServiceMock {
+	e.results = &UserServiceMockListAuditEventsResults{a1, err}
+	return e.mock
+}
+
+// ListAuditEvents implements usecases.UserService
+func (mmListAuditEvents *UserServiceMock) ListAuditEvents(ctx context.Context, req def.AuditListDTO) (a1 def.AuditPage, err error) {
+	mm_atomic.AddUint64(&mmListAuditEvents.beforeListAuditEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditEvents.afterListAuditEventsCounter, 1)
+
+	if mmListAuditEvents.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.inspectFuncListAuditEvents(ctx, req)
+	}
+
+	mm_params := UserServiceMockListAuditEventsParams{ctx, req}
+
+	// Record call args
+	mmListAuditEvents.ListAuditEventsMock.mutex.Lock()
+	mmListAuditEvents.ListAuditEventsMock.callArgs = append(mmListAuditEvents.ListAuditEventsMock.callArgs, &mm_params)
+	mmListAuditEvents.ListAuditEventsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditEvents.ListAuditEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmListAuditEvents.ListAuditEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditEvents.ListAuditEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.params
+		mm_got := UserServiceMockListAuditEventsParams{ctx, req}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditEvents.t.Errorf("UserServiceMock.ListAuditEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditEvents.t.Fatal("No results are set for the UserServiceMock.ListAuditEvents")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmListAuditEvents.funcListAuditEvents != nil {
+		return mmListAuditEvents.funcListAuditEvents(ctx, req)
+	}
+	mmListAuditEvents.t.Fatalf("Unexpected call to UserServiceMock.ListAuditEvents. %v %v", ctx, req)
+	return
+}
+
+// ListAuditEventsAfterCounter returns a count of finished UserServiceMock.ListAuditEvents invocations
+func (mmListAuditEvents *UserServiceMock) ListAuditEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.afterListAuditEventsCounter)
+}
+
+// ListAuditEventsBeforeCounter returns a count of UserServiceMock.ListAuditEvents invocations
+func (mmListAuditEvents *UserServiceMock) ListAuditEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.beforeListAuditEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListAuditEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditEvents *mUserServiceMockListAuditEvents) Calls() []*UserServiceMockListAuditEventsParams {
+	mmListAuditEvents.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListAuditEventsParams, len(mmListAuditEvents.callArgs))
+	copy(argCopy, mmListAuditEvents.callArgs)
+
+	mmListAuditEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditEventsDone returns true if the count of the ListAuditEvents invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListAuditEventsDone() bool {
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditEventsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListAuditEventsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditEvents != nil && mm_atomic.LoadUint64(&m.afterListAuditEventsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListAuditEventsInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListAuditEventsInspect() {
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListAuditEvents with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditEventsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListAuditEventsCounter) < 1 {
+		if m.ListAuditEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ListAuditEvents")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListAuditEvents with params: %#v", *m.ListAuditEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditEvents != nil && mm_atomic.LoadUint64(&m.afterListAuditEventsCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ListAuditEvents")
+	}
+}
+
+type mUserServiceMockListUsers struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListUsersExpectation
+	expectations       []*UserServiceMockListUsersExpectation
+
+	callArgs []*UserServiceMockListUsersParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockListUsersExpectation specifies expectation struct of the UserService.ListUsers
+type UserServiceMockListUsersExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockListUsersParams
+	results *UserServiceMockListUsersResults
+	Counter uint64
+}
+
+// UserServiceMockListUsersParams contains parameters of the UserService.ListUsers
+type UserServiceMockListUsersParams struct {
+	ctx context.Context
+	req def.ListDTO
+}
+
+// UserServiceMockListUsersResults contains results of the UserService.ListUsers
+type UserServiceMockListUsersResults struct {
+	u1  def.UsersPage
+	err error
+}
+
+// Expect sets up expected params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Expect(ctx context.Context, req def.ListDTO) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	mmListUsers.defaultExpectation.params = &UserServiceMockListUsersParams{ctx, req}
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Inspect(f func(ctx context.Context, req def.ListDTO)) *mUserServiceMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Return(u1 def.UsersPage, err error) *UserServiceMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserServiceMockListUsersResults{u1, err}
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ListUsers method
+func (mmListUsers *mUserServiceMockListUsers) Set(f func(ctx context.Context, req def.ListDTO) (u1 def.UsersPage, err error)) *UserServiceMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserService.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserServiceMockListUsers) When(ctx context.Context, req def.ListDTO) *UserServiceMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListUsersExpectation{
+		mock:   mmListUsers.mock,
+		params: &UserServiceMockListUsersParams{ctx, req},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListUsersExpectation) Then(u1 def.UsersPage, err error) *UserServiceMock {
+	e.results = &UserServiceMockListUsersResults{u1, err}
+	return e.mock
+}
+
+// ListUsers implements usecases.UserService
+func (mmListUsers *UserServiceMock) ListUsers(ctx context.Context, req def.ListDTO) (u1 def.UsersPage, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, req)
+	}
+
+	mm_params := UserServiceMockListUsersParams{ctx, req}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_got := UserServiceMockListUsersParams{ctx, req}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserServiceMock.ListUsers")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, req)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserServiceMock.ListUsers. %v %v", ctx, req)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserServiceMockListUsers) Calls() []*UserServiceMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListUsersDone() bool {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUsersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && mm_atomic.LoadUint64(&m.afterListUsersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUsersCounter) < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ListUsers")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers with params: %#v", *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && mm_atomic.LoadUint64(&m.afterListUsersCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ListUsers")
+	}
+}
+
+type mUserServiceMockPurgeDeleted struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockPurgeDeletedExpectation
+	expectations       []*UserServiceMockPurgeDeletedExpectation
+
+	callArgs []*UserServiceMockPurgeDeletedParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockPurgeDeletedExpectation specifies expectation struct of the UserService.PurgeDeleted
+type UserServiceMockPurgeDeletedExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockPurgeDeletedParams
+	results *UserServiceMockPurgeDeletedResults
+	Counter uint64
+}
+
+// UserServiceMockPurgeDeletedParams contains parameters of the UserService.PurgeDeleted
+type UserServiceMockPurgeDeletedParams struct {
+	ctx context.Context
+}
+
+// UserServiceMockPurgeDeletedResults contains results of the UserService.PurgeDeleted
+type UserServiceMockPurgeDeletedResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for UserService.PurgeDeleted
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) Expect(ctx context.Context) *mUserServiceMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserServiceMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserServiceMockPurgeDeletedExpectation{}
+	}
+
+	mmPurgeDeleted.defaultExpectation.params = &UserServiceMockPurgeDeletedParams{ctx}
+	for _, e := range mmPurgeDeleted.expectations {
+		if minimock.Equal(e.params, mmPurgeDeleted.defaultExpectation.params) {
+			mmPurgeDeleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeleted.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeleted
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.PurgeDeleted
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) Inspect(f func(ctx context.Context)) *mUserServiceMockPurgeDeleted {
+	if mmPurgeDeleted.mock.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Inspect function is already set for UserServiceMock.PurgeDeleted")
+	}
+
+	mmPurgeDeleted.mock.inspectFuncPurgeDeleted = f
+
+	return mmPurgeDeleted
+}
+
+// Return sets up results that will be returned by UserService.PurgeDeleted
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) Return(i1 int64, err error) *UserServiceMock {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserServiceMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &UserServiceMockPurgeDeletedExpectation{mock: mmPurgeDeleted.mock}
+	}
+	mmPurgeDeleted.defaultExpectation.results = &UserServiceMockPurgeDeletedResults{i1, err}
+	return mmPurgeDeleted.mock
+}
+
+// Set uses given function f to mock the UserService.PurgeDeleted method
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) Set(f func(ctx context.Context) (i1 int64, err error)) *UserServiceMock {
+	if mmPurgeDeleted.defaultExpectation != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Default expectation is already set for the UserService.PurgeDeleted method")
+	}
+
+	if len(mmPurgeDeleted.expectations) > 0 {
+		mmPurgeDeleted.mock.t.Fatalf("Some expectations are already set for the UserService.PurgeDeleted method")
+	}
+
+	mmPurgeDeleted.mock.funcPurgeDeleted = f
+	return mmPurgeDeleted.mock
+}
+
+// When sets expectation for the UserService.PurgeDeleted which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) When(ctx context.Context) *UserServiceMockPurgeDeletedExpectation {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("UserServiceMock.PurgeDeleted mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockPurgeDeletedExpectation{
+		mock:   mmPurgeDeleted.mock,
+		params: &UserServiceMockPurgeDeletedParams{ctx},
+	}
+	mmPurgeDeleted.expectations = append(mmPurgeDeleted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.PurgeDeleted return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockPurgeDeletedExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockPurgeDeletedResults{i1, err}
+	return e.mock
+}
+
+// PurgeDeleted implements usecases.UserService
+func (mmPurgeDeleted *UserServiceMock) PurgeDeleted(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeleted.beforePurgeDeletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeleted.afterPurgeDeletedCounter, 1)
+
+	if mmPurgeDeleted.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.inspectFuncPurgeDeleted(ctx)
+	}
+
+	mm_params := UserServiceMockPurgeDeletedParams{ctx}
+
+	// Record call args
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Lock()
+	mmPurgeDeleted.PurgeDeletedMock.callArgs = append(mmPurgeDeleted.PurgeDeletedMock.callArgs, &mm_params)
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeleted.PurgeDeletedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeleted.PurgeDeletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.params
+		mm_got := UserServiceMockPurgeDeletedParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeleted.t.Errorf("UserServiceMock.PurgeDeleted got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeleted.t.Fatal("No results are set for the UserServiceMock.PurgeDeleted")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeleted.funcPurgeDeleted != nil {
+		return mmPurgeDeleted.funcPurgeDeleted(ctx)
+	}
+	mmPurgeDeleted.t.Fatalf("Unexpected call to UserServiceMock.PurgeDeleted. %v", ctx)
+	return
+}
+
+// PurgeDeletedAfterCounter returns a count of finished UserServiceMock.PurgeDeleted invocations
+func (mmPurgeDeleted *UserServiceMock) PurgeDeletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.afterPurgeDeletedCounter)
+}
+
+// PurgeDeletedBeforeCounter returns a count of UserServiceMock.PurgeDeleted invocations
+func (mmPurgeDeleted *UserServiceMock) PurgeDeletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.beforePurgeDeletedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.PurgeDeleted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeleted *mUserServiceMockPurgeDeleted) Calls() []*UserServiceMockPurgeDeletedParams {
+	mmPurgeDeleted.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockPurgeDeletedParams, len(mmPurgeDeleted.callArgs))
+	copy(argCopy, mmPurgeDeleted.callArgs)
+
+	mmPurgeDeleted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedDone returns true if the count of the PurgeDeleted invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockPurgeDeletedDone() bool {
+	for _, e := range m.PurgeDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeleted != nil && mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPurgeDeletedInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockPurgeDeletedInspect() {
+	for _, e := range m.PurgeDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.PurgeDeleted with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter) < 1 {
+		if m.PurgeDeletedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.PurgeDeleted")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.PurgeDeleted with params: %#v", *m.PurgeDeletedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeleted != nil && mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.PurgeDeleted")
+	}
+}
+
+type mUserServiceMockRenewal struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRenewalExpectation
+	expectations       []*UserServiceMockRenewalExpectation
+
+	callArgs []*UserServiceMockRenewalParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockRenewalExpectation specifies expectation struct of the UserService.Renewal
+type UserServiceMockRenewalExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockRenewalParams
+	results *UserServiceMockRenewalResults
+	Counter uint64
+}
+
+// UserServiceMockRenewalParams contains parameters of the UserService.Renewal
+type UserServiceMockRenewalParams struct {
+	ctx           context.Context
+	refreshToken  string
+	isRenewAccess bool
+}
+
+// UserServiceMockRenewalResults contains results of the UserService.Renewal
+type UserServiceMockRenewalResults struct {
+	s1  string
+	err error
+}
+
+// Expect sets up expected params for UserService.Renewal
+func (mmRenewal *mUserServiceMockRenewal) Expect(ctx context.Context, refreshToken string, isRenewAccess bool) *mUserServiceMockRenewal {
+	if mmRenewal.mock.funcRenewal != nil {
+		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+	}
+
+	if mmRenewal.defaultExpectation == nil {
+		mmRenewal.defaultExpectation = &UserServiceMockRenewalExpectation{}
+	}
+
+	mmRenewal.defaultExpectation.params = &UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
+	for _, e := range mmRenewal.expectations {
+		if minimock.Equal(e.params, mmRenewal.defaultExpectation.params) {
+			mmRenewal.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenewal.defaultExpectation.params)
+		}
+	}
+
+	return mmRenewal
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Renewal
+func (mmRenewal *mUserServiceMockRenewal) Inspect(f func(ctx context.Context, refreshToken string, isRenewAccess bool)) *mUserServiceMockRenewal {
+	if mmRenewal.mock.inspectFuncRenewal != nil {
+		mmRenewal.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Renewal")
+	}
+
+	mmRenewal.mock.inspectFuncRenewal = f
+
+	return mmRenewal
+}
+
+// Return sets up results that will be returned by UserService.Renewal
+func (mmRenewal *mUserServiceMockRenewal) Return(s1 string, err error) *UserServiceMock {
+	if mmRenewal.mock.funcRenewal != nil {
+		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+	}
+
+	if mmRenewal.defaultExpectation == nil {
+		mmRenewal.defaultExpectation = &UserServiceMockRenewalExpectation{mock: mmRenewal.mock}
+	}
+	mmRenewal.defaultExpectation.results = &UserServiceMockRenewalResults{s1, err}
+	return mmRenewal.mock
+}
+
+// Set uses given function f to mock the UserService.Renewal method
+func (mmRenewal *mUserServiceMockRenewal) Set(f func(ctx context.Context, refreshToken string, isRenewAccess bool) (s1 string, err error)) *UserServiceMock {
+	if mmRenewal.defaultExpectation != nil {
+		mmRenewal.mock.t.Fatalf("Default expectation is already set for the UserService.Renewal method")
+	}
+
+	if len(mmRenewal.expectations) > 0 {
+		mmRenewal.mock.t.Fatalf("Some expectations are already set for the UserService.Renewal method")
+	}
+
+	mmRenewal.mock.funcRenewal = f
+	return mmRenewal.mock
+}
+
+// When sets expectation for the UserService.Renewal which will trigger the result defined by the following
+// Then helper
+func (mmRenewal *mUserServiceMockRenewal) When(ctx context.Context, refreshToken string, isRenewAccess bool) *UserServiceMockRenewalExpectation {
+	if mmRenewal.mock.funcRenewal != nil {
+		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRenewalExpectation{
+		mock:   mmRenewal.mock,
+		params: &UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess},
+	}
+	mmRenewal.expectations = append(mmRenewal.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Renewal return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRenewalExpectation) Then(s1 string, err error) *UserServiceMock {
+	e.results = &UserServiceMockRenewalResults{s1, err}
+	return e.mock
+}
+
+// Renewal implements usecases.UserService
+func (mmRenewal *UserServiceMock) Renewal(ctx context.Context, refreshToken string, isRenewAccess bool) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRenewal.beforeRenewalCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenewal.afterRenewalCounter, 1)
+
+	if mmRenewal.inspectFuncRenewal != nil {
+		mmRenewal.inspectFuncRenewal(ctx, refreshToken, isRenewAccess)
+	}
+
+	mm_params := UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
+
+	// Record call args
+	mmRenewal.RenewalMock.mutex.Lock()
+	mmRenewal.RenewalMock.callArgs = append(mmRenewal.RenewalMock.callArgs, &mm_params)
+	mmRenewal.RenewalMock.mutex.Unlock()
+
+	for _, e := range mmRenewal.RenewalMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRenewal.RenewalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenewal.RenewalMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenewal.RenewalMock.defaultExpectation.params
+		mm_got := UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenewal.t.Errorf("UserServiceMock.Renewal got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenewal.RenewalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenewal.t.Fatal("No results are set for the UserServiceMock.Renewal")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRenewal.funcRenewal != nil {
+		return mmRenewal.funcRenewal(ctx, refreshToken, isRenewAccess)
+	}
+	mmRenewal.t.Fatalf("Unexpected call to UserServiceMock.Renewal. %v %v %v", ctx, refreshToken, isRenewAccess)
+	return
+}
+
+// RenewalAfterCounter returns a count of finished UserServiceMock.Renewal invocations
+func (mmRenewal *UserServiceMock) RenewalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewal.afterRenewalCounter)
+}
+
+// RenewalBeforeCounter returns a count of UserServiceMock.Renewal invocations
+func (mmRenewal *UserServiceMock) RenewalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewal.beforeRenewalCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Renewal.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenewal *mUserServiceMockRenewal) Calls() []*UserServiceMockRenewalParams {
+	mmRenewal.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockRenewalParams, len(mmRenewal.callArgs))
+	copy(argCopy, mmRenewal.callArgs)
+
+	mmRenewal.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenewalDone returns true if the count of the Renewal invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockRenewalDone() bool {
+	for _, e := range m.RenewalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenewalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenewal != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRenewalInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRenewalInspect() {
+	for _, e := range m.RenewalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Renewal with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenewalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+		if m.RenewalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.Renewal")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Renewal with params: %#v", *m.RenewalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenewal != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.Renewal")
+	}
+}
+
+type mUserServiceMockRequestLoginLink struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRequestLoginLinkExpectation
+	expectations       []*UserServiceMockRequestLoginLinkExpectation
+
+	callArgs []*UserServiceMockRequestLoginLinkParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockRequestLoginLinkExpectation specifies expectation struct of the UserService.RequestLoginLink
+type UserServiceMockRequestLoginLinkExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockRequestLoginLinkParams
+	results *UserServiceMockRequestLoginLinkResults
+	Counter uint64
+}
+
+// UserServiceMockRequestLoginLinkParams contains parameters of the UserService.RequestLoginLink
+type UserServiceMockRequestLoginLinkParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserServiceMockRequestLoginLinkResults contains results of the UserService.RequestLoginLink
+type UserServiceMockRequestLoginLinkResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.RequestLoginLink
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) Expect(ctx context.Context, email string) *mUserServiceMockRequestLoginLink {
+	if mmRequestLoginLink.mock.funcRequestLoginLink != nil {
+		mmRequestLoginLink.mock.t.Fatalf("UserServiceMock.RequestLoginLink mock is already set by Set")
+	}
+
+	if mmRequestLoginLink.defaultExpectation == nil {
+		mmRequestLoginLink.defaultExpectation = &UserServiceMockRequestLoginLinkExpectation{}
+	}
+
+	mmRequestLoginLink.defaultExpectation.params = &UserServiceMockRequestLoginLinkParams{ctx, email}
+	for _, e := range mmRequestLoginLink.expectations {
+		if minimock.Equal(e.params, mmRequestLoginLink.defaultExpectation.params) {
+			mmRequestLoginLink.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestLoginLink.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestLoginLink
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.RequestLoginLink
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) Inspect(f func(ctx context.Context, email string)) *mUserServiceMockRequestLoginLink {
+	if mmRequestLoginLink.mock.inspectFuncRequestLoginLink != nil {
+		mmRequestLoginLink.mock.t.Fatalf("Inspect function is already set for UserServiceMock.RequestLoginLink")
+	}
+
+	mmRequestLoginLink.mock.inspectFuncRequestLoginLink = f
+
+	return mmRequestLoginLink
+}
+
+// Return sets up results that will be returned by UserService.RequestLoginLink
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) Return(err error) *UserServiceMock {
+	if mmRequestLoginLink.mock.funcRequestLoginLink != nil {
+		mmRequestLoginLink.mock.t.Fatalf("UserServiceMock.RequestLoginLink mock is already set by Set")
+	}
+
+	if mmRequestLoginLink.defaultExpectation == nil {
+		mmRequestLoginLink.defaultExpectation = &UserServiceMockRequestLoginLinkExpectation{mock: mmRequestLoginLink.mock}
+	}
+	mmRequestLoginLink.defaultExpectation.results = &UserServiceMockRequestLoginLinkResults{err}
+	return mmRequestLoginLink.mock
+}
+
+// Set uses given function f to mock the UserService.RequestLoginLink method
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) Set(f func(ctx context.Context, email string) (err error)) *UserServiceMock {
+	if mmRequestLoginLink.defaultExpectation != nil {
+		mmRequestLoginLink.mock.t.Fatalf("Default expectation is already set for the UserService.RequestLoginLink method")
+	}
+
+	if len(mmRequestLoginLink.expectations) > 0 {
+		mmRequestLoginLink.mock.t.Fatalf("Some expectations are already set for the UserService.RequestLoginLink method")
+	}
+
+	mmRequestLoginLink.mock.funcRequestLoginLink = f
+	return mmRequestLoginLink.mock
+}
+
+// When sets expectation for the UserService.RequestLoginLink which will trigger the result defined by the following
+// Then helper
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) When(ctx context.Context, email string) *UserServiceMockRequestLoginLinkExpectation {
+	if mmRequestLoginLink.mock.funcRequestLoginLink != nil {
+		mmRequestLoginLink.mock.t.Fatalf("UserServiceMock.RequestLoginLink mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRequestLoginLinkExpectation{
+		mock:   mmRequestLoginLink.mock,
+		params: &UserServiceMockRequestLoginLinkParams{ctx, email},
+	}
+	mmRequestLoginLink.expectations = append(mmRequestLoginLink.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.RequestLoginLink return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRequestLoginLinkExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRequestLoginLinkResults{err}
+	return e.mock
+}
+
+// RequestLoginLink implements usecases.UserService
+func (mmRequestLoginLink *UserServiceMock) RequestLoginLink(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmRequestLoginLink.beforeRequestLoginLinkCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestLoginLink.afterRequestLoginLinkCounter, 1)
+
+	if mmRequestLoginLink.inspectFuncRequestLoginLink != nil {
+		mmRequestLoginLink.inspectFuncRequestLoginLink(ctx, email)
+	}
+
+	mm_params := UserServiceMockRequestLoginLinkParams{ctx, email}
+
+	// Record call args
+	mmRequestLoginLink.RequestLoginLinkMock.mutex.Lock()
+	mmRequestLoginLink.RequestLoginLinkMock.callArgs = append(mmRequestLoginLink.RequestLoginLinkMock.callArgs, &mm_params)
+	mmRequestLoginLink.RequestLoginLinkMock.mutex.Unlock()
+
+	for _, e := range mmRequestLoginLink.RequestLoginLinkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestLoginLink.RequestLoginLinkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestLoginLink.RequestLoginLinkMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestLoginLink.RequestLoginLinkMock.defaultExpectation.params
+		mm_got := UserServiceMockRequestLoginLinkParams{ctx, email}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestLoginLink.t.Errorf("UserServiceMock.RequestLoginLink got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestLoginLink.RequestLoginLinkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestLoginLink.t.Fatal("No results are set for the UserServiceMock.RequestLoginLink")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestLoginLink.funcRequestLoginLink != nil {
+		return mmRequestLoginLink.funcRequestLoginLink(ctx, email)
+	}
+	mmRequestLoginLink.t.Fatalf("Unexpected call to UserServiceMock.RequestLoginLink. %v %v", ctx, email)
+	return
+}
+
+// RequestLoginLinkAfterCounter returns a count of finished UserServiceMock.RequestLoginLink invocations
+func (mmRequestLoginLink *UserServiceMock) RequestLoginLinkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLoginLink.afterRequestLoginLinkCounter)
+}
+
+// RequestLoginLinkBeforeCounter returns a count of UserServiceMock.RequestLoginLink invocations
+func (mmRequestLoginLink *UserServiceMock) RequestLoginLinkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLoginLink.beforeRequestLoginLinkCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.RequestLoginLink.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestLoginLink *mUserServiceMockRequestLoginLink) Calls() []*UserServiceMockRequestLoginLinkParams {
+	mmRequestLoginLink.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockRequestLoginLinkParams, len(mmRequestLoginLink.callArgs))
+	copy(argCopy, mmRequestLoginLink.callArgs)
+
+	mmRequestLoginLink.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestLoginLinkDone returns true if the count of the RequestLoginLink invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockRequestLoginLinkDone() bool {
+	for _, e := range m.RequestLoginLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLoginLinkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLoginLinkCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLoginLink != nil && mm_atomic.LoadUint64(&m.afterRequestLoginLinkCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRequestLoginLinkInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRequestLoginLinkInspect() {
+	for _, e := range m.RequestLoginLinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.RequestLoginLink with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLoginLinkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLoginLinkCounter) < 1 {
+		if m.RequestLoginLinkMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.RequestLoginLink")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.RequestLoginLink with params: %#v", *m.RequestLoginLinkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLoginLink != nil && mm_atomic.LoadUint64(&m.afterRequestLoginLinkCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.RequestLoginLink")
+	}
+}
+
+type mUserServiceMockResetPassword struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockResetPasswordExpectation
+	expectations       []*UserServiceMockResetPasswordExpectation
+
+	callArgs []*UserServiceMockResetPasswordParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockResetPasswordExpectation specifies expectation struct of the UserService.ResetPassword
+type UserServiceMockResetPasswordExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockResetPasswordParams
+	results *UserServiceMockResetPasswordResults
+	Counter uint64
+}
+
+// UserServiceMockResetPasswordParams contains parameters of the UserService.ResetPassword
+type UserServiceMockResetPasswordParams struct {
+	ctx             context.Context
+	userID          int64
+	password        string
+	passwordConfirm string
+}
+
+// UserServiceMockResetPasswordResults contains results of the UserService.ResetPassword
+type UserServiceMockResetPasswordResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Expect(ctx context.Context, userID int64, password string, passwordConfirm string) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{}
+	}
+
+	mmResetPassword.defaultExpectation.params = &UserServiceMockResetPasswordParams{ctx, userID, password, passwordConfirm}
+	for _, e := range mmResetPassword.expectations {
+		if minimock.Equal(e.params, mmResetPassword.defaultExpectation.params) {
+			mmResetPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmResetPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Inspect(f func(ctx context.Context, userID int64, password string, passwordConfirm string)) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.inspectFuncResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ResetPassword")
+	}
+
+	mmResetPassword.mock.inspectFuncResetPassword = f
+
+	return mmResetPassword
+}
+
+// Return sets up results that will be returned by UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Return(err error) *UserServiceMock {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{mock: mmResetPassword.mock}
+	}
+	mmResetPassword.defaultExpectation.results = &UserServiceMockResetPasswordResults{err}
+	return mmResetPassword.mock
+}
+
+// Set uses given function f to mock the UserService.ResetPassword method
+func (mmResetPassword *mUserServiceMockResetPassword) Set(f func(ctx context.Context, userID int64, password string, passwordConfirm string) (err error)) *UserServiceMock {
+	if mmResetPassword.defaultExpectation != nil {
+		mmResetPassword.mock.t.Fatalf("Default expectation is already set for the UserService.ResetPassword method")
+	}
+
+	if len(mmResetPassword.expectations) > 0 {
+		mmResetPassword.mock.t.Fatalf("Some expectations are already set for the UserService.ResetPassword method")
+	}
+
+	mmResetPassword.mock.funcResetPassword = f
+	return mmResetPassword.mock
+}
+
+// When sets expectation for the UserService.ResetPassword which will trigger the result defined by the following
+// Then helper
+func (mmResetPassword *mUserServiceMockResetPassword) When(ctx context.Context, userID int64, password string, passwordConfirm string) *UserServiceMockResetPasswordExpectation {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockResetPasswordExpectation{
+		mock:   mmResetPassword.mock,
+		params: &UserServiceMockResetPasswordParams{ctx, userID, password, passwordConfirm},
+	}
+	mmResetPassword.expectations = append(mmResetPassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ResetPassword return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockResetPasswordExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockResetPasswordResults{err}
+	return e.mock
+}
+
+// ResetPassword implements usecases.UserService
+func (mmResetPassword *UserServiceMock) ResetPassword(ctx context.Context, userID int64, password string, passwordConfirm string) (err error) {
+	mm_atomic.AddUint64(&mmResetPassword.beforeResetPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetPassword.afterResetPasswordCounter, 1)
+
+	if mmResetPassword.inspectFuncResetPassword != nil {
+		mmResetPassword.inspectFuncResetPassword(ctx, userID, password, passwordConfirm)
+	}
+
+	mm_params := UserServiceMockResetPasswordParams{ctx, userID, password, passwordConfirm}
+
+	// Record call args
+	mmResetPassword.ResetPasswordMock.mutex.Lock()
+	mmResetPassword.ResetPasswordMock.callArgs = append(mmResetPassword.ResetPasswordMock.callArgs, &mm_params)
+	mmResetPassword.ResetPasswordMock.mutex.Unlock()
+
+	for _, e := range mmResetPassword.ResetPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResetPassword.ResetPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetPassword.ResetPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetPassword.ResetPasswordMock.defaultExpectation.params
+		mm_got := UserServiceMockResetPasswordParams{ctx, userID, password, passwordConfirm}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResetPassword.t.Errorf("UserServiceMock.ResetPassword got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResetPassword.ResetPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResetPassword.t.Fatal("No results are set for the UserServiceMock.ResetPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmResetPassword.funcResetPassword != nil {
+		return mmResetPassword.funcResetPassword(ctx, userID, password, passwordConfirm)
+	}
+	mmResetPassword.t.Fatalf("Unexpected call to UserServiceMock.ResetPassword. %v %v %v %v", ctx, userID, password, passwordConfirm)
+	return
+}
+
+// ResetPasswordAfterCounter returns a count of finished UserServiceMock.ResetPassword invocations
+func (mmResetPassword *UserServiceMock) ResetPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.afterResetPasswordCounter)
+}
+
+// ResetPasswordBeforeCounter returns a count of UserServiceMock.ResetPassword invocations
+func (mmResetPassword *UserServiceMock) ResetPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.beforeResetPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ResetPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResetPassword *mUserServiceMockResetPassword) Calls() []*UserServiceMockResetPasswordParams {
+	mmResetPassword.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockResetPasswordParams, len(mmResetPassword.callArgs))
+	copy(argCopy, mmResetPassword.callArgs)
+
+	mmResetPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetPasswordDone returns true if the count of the ResetPassword invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockResetPasswordDone() bool {
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetPasswordMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResetPasswordCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResetPassword != nil && mm_atomic.LoadUint64(&m.afterResetPasswordCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockResetPasswordInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockResetPasswordInspect() {
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ResetPassword with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetPasswordMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResetPasswordCounter) < 1 {
+		if m.ResetPasswordMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ResetPassword")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ResetPassword with params: %#v", *m.ResetPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResetPassword != nil && mm_atomic.LoadUint64(&m.afterResetPasswordCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.ResetPassword")
+	}
+}
+
+type mUserServiceMockRestore struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRestoreExpectation
+	expectations       []*UserServiceMockRestoreExpectation
+
+	callArgs []*UserServiceMockRestoreParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockRestoreExpectation specifies expectation struct of the UserService.Restore
+type UserServiceMockRestoreExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockRestoreParams
+	results *UserServiceMockRestoreResults
+	Counter uint64
+}
+
+// UserServiceMockRestoreParams contains parameters of the UserService.Restore
+type UserServiceMockRestoreParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockRestoreResults contains results of the UserService.Restore
+type UserServiceMockRestoreResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Expect(ctx context.Context, userID int64) *mUserServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{}
+	}
+
+	mmRestore.defaultExpectation.params = &UserServiceMockRestoreParams{ctx, userID}
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by UserService.Restore
+func (mmRestore *mUserServiceMockRestore) Return(err error) *UserServiceMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &UserServiceMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &UserServiceMockRestoreResults{err}
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the UserService.Restore method
+func (mmRestore *mUserServiceMockRestore) Set(f func(ctx context.Context, userID int64) (err error)) *UserServiceMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the UserService.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the UserService.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	return mmRestore.mock
+}
+
+// When sets expectation for the UserService.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mUserServiceMockRestore) When(ctx context.Context, userID int64) *UserServiceMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("UserServiceMock.Restore mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRestoreExpectation{
+		mock:   mmRestore.mock,
+		params: &UserServiceMockRestoreParams{ctx, userID},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Restore return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRestoreExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRestoreResults{err}
+	return e.mock
+}
+
+// Restore implements usecases.UserService
+func (mmRestore *UserServiceMock) Restore(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, userID)
+	}
+
+	mm_params := UserServiceMockRestoreParams{ctx, userID}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_got := UserServiceMockRestoreParams{ctx, userID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("UserServiceMock.Restore got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the UserServiceMock.Restore")
+		}
+		return (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, userID)
+	}
+	mmRestore.t.Fatalf("Unexpected call to UserServiceMock.Restore. %v %v", ctx, userID)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished UserServiceMock.Restore invocations
+func (mmRestore *UserServiceMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of UserServiceMock.Restore invocations
+func (mmRestore *UserServiceMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mUserServiceMockRestore) Calls() []*UserServiceMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockRestoreDone() bool {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Restore with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.Restore")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Restore with params: %#v", *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.Restore")
+	}
+}
+
+type mUserServiceMockRevokeSessions struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRevokeSessionsExpectation
+	expectations       []*UserServiceMockRevokeSessionsExpectation
+
+	callArgs []*UserServiceMockRevokeSessionsParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockRevokeSessionsExpectation specifies expectation struct of the UserService.RevokeSessions
+type UserServiceMockRevokeSessionsExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockRevokeSessionsParams
+	results *UserServiceMockRevokeSessionsResults
+	Counter uint64
+}
+
+// UserServiceMockRevokeSessionsParams contains parameters of the UserService.RevokeSessions
+type UserServiceMockRevokeSessionsParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserServiceMockRevokeSessionsResults contains results of the UserService.RevokeSessions
+type UserServiceMockRevokeSessionsResults struct {
+	err error
+}
+
+// Expect sets up expected params for UserService.RevokeSessions
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) Expect(ctx context.Context, userID int64) *mUserServiceMockRevokeSessions {
+	if mmRevokeSessions.mock.funcRevokeSessions != nil {
+		mmRevokeSessions.mock.t.Fatalf("UserServiceMock.RevokeSessions mock is already set by Set")
+	}
+
+	if mmRevokeSessions.defaultExpectation == nil {
+		mmRevokeSessions.defaultExpectation = &UserServiceMockRevokeSessionsExpectation{}
+	}
+
+	mmRevokeSessions.defaultExpectation.params = &UserServiceMockRevokeSessionsParams{ctx, userID}
+	for _, e := range mmRevokeSessions.expectations {
+		if minimock.Equal(e.params, mmRevokeSessions.defaultExpectation.params) {
+			mmRevokeSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.RevokeSessions
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockRevokeSessions {
+	if mmRevokeSessions.mock.inspectFuncRevokeSessions != nil {
+		mmRevokeSessions.mock.t.Fatalf("Inspect function is already set for UserServiceMock.RevokeSessions")
+	}
+
+	mmRevokeSessions.mock.inspectFuncRevokeSessions = f
+
+	return mmRevokeSessions
+}
+
+// Return sets up results that will be returned by UserService.RevokeSessions
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) Return(err error) *UserServiceMock {
+	if mmRevokeSessions.mock.funcRevokeSessions != nil {
+		mmRevokeSessions.mock.t.Fatalf("UserServiceMock.RevokeSessions mock is already set by Set")
+	}
+
+	if mmRevokeSessions.defaultExpectation == nil {
+		mmRevokeSessions.defaultExpectation = &UserServiceMockRevokeSessionsExpectation{mock: mmRevokeSessions.mock}
+	}
+	mmRevokeSessions.defaultExpectation.results = &UserServiceMockRevokeSessionsResults{err}
+	return mmRevokeSessions.mock
+}
+
+// Set uses given function f to mock the UserService.RevokeSessions method
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) Set(f func(ctx context.Context, userID int64) (err error)) *UserServiceMock {
+	if mmRevokeSessions.defaultExpectation != nil {
+		mmRevokeSessions.mock.t.Fatalf("Default expectation is already set for the UserService.RevokeSessions method")
+	}
+
+	if len(mmRevokeSessions.expectations) > 0 {
+		mmRevokeSessions.mock.t.Fatalf("Some expectations are already set for the UserService.RevokeSessions method")
+	}
+
+	mmRevokeSessions.mock.funcRevokeSessions = f
+	return mmRevokeSessions.mock
+}
+
+// When sets expectation for the UserService.RevokeSessions which will trigger the result defined by the following
+// Then helper
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) When(ctx context.Context, userID int64) *UserServiceMockRevokeSessionsExpectation {
+	if mmRevokeSessions.mock.funcRevokeSessions != nil {
+		mmRevokeSessions.mock.t.Fatalf("UserServiceMock.RevokeSessions mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockRevokeSessionsExpectation{
+		mock:   mmRevokeSessions.mock,
+		params: &UserServiceMockRevokeSessionsParams{ctx, userID},
+	}
+	mmRevokeSessions.expectations = append(mmRevokeSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.RevokeSessions return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRevokeSessionsExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRevokeSessionsResults{err}
+	return e.mock
+}
+
+// RevokeSessions implements usecases.UserService
+func (mmRevokeSessions *UserServiceMock) RevokeSessions(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeSessions.beforeRevokeSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeSessions.afterRevokeSessionsCounter, 1)
+
+	if mmRevokeSessions.inspectFuncRevokeSessions != nil {
+		mmRevokeSessions.inspectFuncRevokeSessions(ctx, userID)
+	}
+
+	mm_params := UserServiceMockRevokeSessionsParams{ctx, userID}
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	// Record call args
+	mmRevokeSessions.RevokeSessionsMock.mutex.Lock()
+	mmRevokeSessions.RevokeSessionsMock.callArgs = append(mmRevokeSessions.RevokeSessionsMock.callArgs, &mm_params)
+	mmRevokeSessions.RevokeSessionsMock.mutex.Unlock()
+
+	for _, e := range mmRevokeSessions.RevokeSessionsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_got := UserServiceMockCreateParams{ctx, req}
+	if mmRevokeSessions.RevokeSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeSessions.RevokeSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeSessions.RevokeSessionsMock.defaultExpectation.params
+		mm_got := UserServiceMockRevokeSessionsParams{ctx, userID}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRevokeSessions.t.Errorf("UserServiceMock.RevokeSessions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmRevokeSessions.RevokeSessionsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the UserServiceMock.Create")
+			mmRevokeSessions.t.Fatal("No results are set for the UserServiceMock.RevokeSessions")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, req)
+	if mmRevokeSessions.funcRevokeSessions != nil {
+		return mmRevokeSessions.funcRevokeSessions(ctx, userID)
 	}
-	mmCreate.t.Fatalf("Unexpected call to UserServiceMock.Create. %v %v", ctx, req)
+	mmRevokeSessions.t.Fatalf("Unexpected call to UserServiceMock.RevokeSessions. %v %v", ctx, userID)
 	return
 }
 
-// CreateAfterCounter returns a count of finished UserServiceMock.Create invocations
-func (mmCreate *UserServiceMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// RevokeSessionsAfterCounter returns a count of finished UserServiceMock.RevokeSessions invocations
+func (mmRevokeSessions *UserServiceMock) RevokeSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSessions.afterRevokeSessionsCounter)
 }
 
-// CreateBeforeCounter returns a count of UserServiceMock.Create invocations
-func (mmCreate *UserServiceMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// RevokeSessionsBeforeCounter returns a count of UserServiceMock.RevokeSessions invocations
+func (mmRevokeSessions *UserServiceMock) RevokeSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSessions.beforeRevokeSessionsCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Create.
+// Calls returns a list of arguments used in each call to UserServiceMock.RevokeSessions.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mUserServiceMockCreate) Calls() []*UserServiceMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmRevokeSessions *mUserServiceMockRevokeSessions) Calls() []*UserServiceMockRevokeSessionsParams {
+	mmRevokeSessions.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*UserServiceMockRevokeSessionsParams, len(mmRevokeSessions.callArgs))
+	copy(argCopy, mmRevokeSessions.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmRevokeSessions.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockRevokeSessionsDone returns true if the count of the RevokeSessions invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockCreateDone() bool {
-	for _, e := range m.CreateMock.expectations {
+func (m *UserServiceMock) MinimockRevokeSessionsDone() bool {
+	for _, e := range m.RevokeSessionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+	if m.RevokeSessionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeSessionsCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
+	if m.funcRevokeSessions != nil && mm_atomic.LoadUint64(&m.afterRevokeSessionsCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockRevokeSessionsInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRevokeSessionsInspect() {
+	for _, e := range m.RevokeSessionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Create with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.RevokeSessions with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserServiceMock.Create")
+	if m.RevokeSessionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRevokeSessionsCounter) < 1 {
+		if m.RevokeSessionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.RevokeSessions")
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.RevokeSessions with params: %#v", *m.RevokeSessionsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && mm_atomic.LoadUint64(&m.afterCreateCounter) < 1 {
-		m.t.Error("Expected call to UserServiceMock.Create")
+	if m.funcRevokeSessions != nil && mm_atomic.LoadUint64(&m.afterRevokeSessionsCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.RevokeSessions")
 	}
 }
 
-type mUserServiceMockDelete struct {
+type mUserServiceMockSearchUsers struct {
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockDeleteExpectation
-	expectations       []*UserServiceMockDeleteExpectation
+	defaultExpectation *UserServiceMockSearchUsersExpectation
+	expectations       []*UserServiceMockSearchUsersExpectation
 
-	callArgs []*UserServiceMockDeleteParams
+	callArgs []*UserServiceMockSearchUsersParams
 	mutex    sync.RWMutex
 }
 
-// UserServiceMockDeleteExpectation specifies expectation struct of the UserService.Delete
-type UserServiceMockDeleteExpectation struct {
+// UserServiceMockSearchUsersExpectation specifies expectation struct of the UserService.SearchUsers
+type UserServiceMockSearchUsersExpectation struct {
 	mock    *UserServiceMock
-	params  *UserServiceMockDeleteParams
-	results *UserServiceMockDeleteResults
+	params  *UserServiceMockSearchUsersParams
+	results *UserServiceMockSearchUsersResults
 	Counter uint64
 }
 
-// UserServiceMockDeleteParams contains parameters of the UserService.Delete
-type UserServiceMockDeleteParams struct {
-	ctx    context.Context
-	userID int64
+// UserServiceMockSearchUsersParams contains parameters of the UserService.SearchUsers
+type UserServiceMockSearchUsersParams struct {
+	ctx   context.Context
+	query string
+	limit uint32
 }
 
-// UserServiceMockDeleteResults contains results of the UserService.Delete
-type UserServiceMockDeleteResults struct {
+// UserServiceMockSearchUsersResults contains results of the UserService.SearchUsers
+type UserServiceMockSearchUsersResults struct {
+	sa1 []def.SearchHitDTO
 	err error
 }
 
-// Expect sets up expected params for UserService.Delete
-func (mmDelete *mUserServiceMockDelete) Expect(ctx context.Context, userID int64) *mUserServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// Expect sets up expected params for UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Expect(ctx context.Context, query string, limit uint32) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{}
 	}
 
-	mmDelete.defaultExpectation.params = &UserServiceMockDeleteParams{ctx, userID}
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmSearchUsers.defaultExpectation.params = &UserServiceMockSearchUsersParams{ctx, query, limit}
+	for _, e := range mmSearchUsers.expectations {
+		if minimock.Equal(e.params, mmSearchUsers.defaultExpectation.params) {
+			mmSearchUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchUsers.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmSearchUsers
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Delete
-func (mmDelete *mUserServiceMockDelete) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Delete")
+// Inspect accepts an inspector function that has same arguments as the UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Inspect(f func(ctx context.Context, query string, limit uint32)) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.inspectFuncSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SearchUsers")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	mmSearchUsers.mock.inspectFuncSearchUsers = f
 
-	return mmDelete
+	return mmSearchUsers
 }
 
-// Return sets up results that will be returned by UserService.Delete
-func (mmDelete *mUserServiceMockDelete) Return(err error) *UserServiceMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// Return sets up results that will be returned by UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Return(sa1 []def.SearchHitDTO, err error) *UserServiceMock {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{mock: mmDelete.mock}
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{mock: mmSearchUsers.mock}
 	}
-	mmDelete.defaultExpectation.results = &UserServiceMockDeleteResults{err}
-	return mmDelete.mock
+	mmSearchUsers.defaultExpectation.results = &UserServiceMockSearchUsersResults{sa1, err}
+	return mmSearchUsers.mock
 }
 
-// Set uses given function f to mock the UserService.Delete method
-func (mmDelete *mUserServiceMockDelete) Set(f func(ctx context.Context, userID int64) (err error)) *UserServiceMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserService.Delete method")
+// Set uses given function f to mock the UserService.SearchUsers method
+func (mmSearchUsers *mUserServiceMockSearchUsers) Set(f func(ctx context.Context, query string, limit uint32) (sa1 []def.SearchHitDTO, err error)) *UserServiceMock {
+	if mmSearchUsers.defaultExpectation != nil {
+		mmSearchUsers.mock.t.Fatalf("Default expectation is already set for the UserService.SearchUsers method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserService.Delete method")
+	if len(mmSearchUsers.expectations) > 0 {
+		mmSearchUsers.mock.t.Fatalf("Some expectations are already set for the UserService.SearchUsers method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	return mmDelete.mock
+	mmSearchUsers.mock.funcSearchUsers = f
+	return mmSearchUsers.mock
 }
 
-// When sets expectation for the UserService.Delete which will trigger the result defined by the following
+// When sets expectation for the UserService.SearchUsers which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mUserServiceMockDelete) When(ctx context.Context, userID int64) *UserServiceMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+func (mmSearchUsers *mUserServiceMockSearchUsers) When(ctx context.Context, query string, limit uint32) *UserServiceMockSearchUsersExpectation {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockDeleteExpectation{
-		mock:   mmDelete.mock,
-		params: &UserServiceMockDeleteParams{ctx, userID},
+	expectation := &UserServiceMockSearchUsersExpectation{
+		mock:   mmSearchUsers.mock,
+		params: &UserServiceMockSearchUsersParams{ctx, query, limit},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmSearchUsers.expectations = append(mmSearchUsers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Delete return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockDeleteExpectation) Then(err error) *UserServiceMock {
-	e.results = &UserServiceMockDeleteResults{err}
+// Then sets up UserService.SearchUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSearchUsersExpectation) Then(sa1 []def.SearchHitDTO, err error) *UserServiceMock {
+	e.results = &UserServiceMockSearchUsersResults{sa1, err}
 	return e.mock
 }
 
-// Delete implements usecases.UserService
-func (mmDelete *UserServiceMock) Delete(ctx context.Context, userID int64) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// SearchUsers implements usecases.UserService
+func (mmSearchUsers *UserServiceMock) SearchUsers(ctx context.Context, query string, limit uint32) (sa1 []def.SearchHitDTO, err error) {
+	mm_atomic.AddUint64(&mmSearchUsers.beforeSearchUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchUsers.afterSearchUsersCounter, 1)
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, userID)
+	if mmSearchUsers.inspectFuncSearchUsers != nil {
+		mmSearchUsers.inspectFuncSearchUsers(ctx, query, limit)
 	}
 
-	mm_params := UserServiceMockDeleteParams{ctx, userID}
+	mm_params := UserServiceMockSearchUsersParams{ctx, query, limit}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmSearchUsers.SearchUsersMock.mutex.Lock()
+	mmSearchUsers.SearchUsersMock.callArgs = append(mmSearchUsers.SearchUsersMock.callArgs, &mm_params)
+	mmSearchUsers.SearchUsersMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmSearchUsers.SearchUsersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_got := UserServiceMockDeleteParams{ctx, userID}
+	if mmSearchUsers.SearchUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchUsers.SearchUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchUsers.SearchUsersMock.defaultExpectation.params
+		mm_got := UserServiceMockSearchUsersParams{ctx, query, limit}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearchUsers.t.Errorf("UserServiceMock.SearchUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmSearchUsers.SearchUsersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the UserServiceMock.Delete")
+			mmSearchUsers.t.Fatal("No results are set for the UserServiceMock.SearchUsers")
 		}
-		return (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, userID)
+	if mmSearchUsers.funcSearchUsers != nil {
+		return mmSearchUsers.funcSearchUsers(ctx, query, limit)
 	}
-	mmDelete.t.Fatalf("Unexpected call to UserServiceMock.Delete. %v %v", ctx, userID)
+	mmSearchUsers.t.Fatalf("Unexpected call to UserServiceMock.SearchUsers. %v %v %v", ctx, query, limit)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished UserServiceMock.Delete invocations
-func (mmDelete *UserServiceMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// SearchUsersAfterCounter returns a count of finished UserServiceMock.SearchUsers invocations
+func (mmSearchUsers *UserServiceMock) SearchUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.afterSearchUsersCounter)
 }
 
-// DeleteBeforeCounter returns a count of UserServiceMock.Delete invocations
-func (mmDelete *UserServiceMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// SearchUsersBeforeCounter returns a count of UserServiceMock.SearchUsers invocations
+func (mmSearchUsers *UserServiceMock) SearchUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.beforeSearchUsersCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Delete.
+// Calls returns a list of arguments used in each call to UserServiceMock.SearchUsers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mUserServiceMockDelete) Calls() []*UserServiceMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmSearchUsers *mUserServiceMockSearchUsers) Calls() []*UserServiceMockSearchUsersParams {
+	mmSearchUsers.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*UserServiceMockSearchUsersParams, len(mmSearchUsers.callArgs))
+	copy(argCopy, mmSearchUsers.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmSearchUsers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockSearchUsersDone returns true if the count of the SearchUsers invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockDeleteDone() bool {
-	for _, e := range m.DeleteMock.expectations {
+func (m *UserServiceMock) MinimockSearchUsersDone() bool {
+	for _, e := range m.SearchUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+	if m.SearchUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSearchUsersCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
+	if m.funcSearchUsers != nil && mm_atomic.LoadUint64(&m.afterSearchUsersCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockSearchUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSearchUsersInspect() {
+	for _, e := range m.SearchUsersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Delete with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.SearchUsers with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserServiceMock.Delete")
+	if m.SearchUsersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSearchUsersCounter) < 1 {
+		if m.SearchUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.SearchUsers")
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.SearchUsers with params: %#v", *m.SearchUsersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && mm_atomic.LoadUint64(&m.afterDeleteCounter) < 1 {
-		m.t.Error("Expected call to UserServiceMock.Delete")
+	if m.funcSearchUsers != nil && mm_atomic.LoadUint64(&m.afterSearchUsersCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.SearchUsers")
 	}
 }
 
-type mUserServiceMockGet struct {
+type mUserServiceMockTrackActivity struct {
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockGetExpectation
-	expectations       []*UserServiceMockGetExpectation
+	defaultExpectation *UserServiceMockTrackActivityExpectation
+	expectations       []*UserServiceMockTrackActivityExpectation
 
-	callArgs []*UserServiceMockGetParams
+	callArgs []*UserServiceMockTrackActivityParams
 	mutex    sync.RWMutex
 }
 
-// UserServiceMockGetExpectation specifies expectation struct of the UserService.Get
-type UserServiceMockGetExpectation struct {
+// UserServiceMockTrackActivityExpectation specifies expectation struct of the UserService.TrackActivity
+type UserServiceMockTrackActivityExpectation struct {
 	mock    *UserServiceMock
-	params  *UserServiceMockGetParams
-	results *UserServiceMockGetResults
+	params  *UserServiceMockTrackActivityParams
+	results *UserServiceMockTrackActivityResults
 	Counter uint64
 }
 
-// UserServiceMockGetParams contains parameters of the UserService.Get
-type UserServiceMockGetParams struct {
+// UserServiceMockTrackActivityParams contains parameters of the UserService.TrackActivity
+type UserServiceMockTrackActivityParams struct {
 	ctx    context.Context
 	userID int64
+	at     time.Time
 }
 
-// UserServiceMockGetResults contains results of the UserService.Get
-type UserServiceMockGetResults struct {
-	u1  def.UserDTO
+// UserServiceMockTrackActivityResults contains results of the UserService.TrackActivity
+type UserServiceMockTrackActivityResults struct {
 	err error
 }
 
-// Expect sets up expected params for UserService.Get
-func (mmGet *mUserServiceMockGet) Expect(ctx context.Context, userID int64) *mUserServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// Expect sets up expected params for UserService.TrackActivity
+func (mmTrackActivity *mUserServiceMockTrackActivity) Expect(ctx context.Context, userID int64, at time.Time) *mUserServiceMockTrackActivity {
+	if mmTrackActivity.mock.funcTrackActivity != nil {
+		mmTrackActivity.mock.t.Fatalf("UserServiceMock.TrackActivity mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	if mmTrackActivity.defaultExpectation == nil {
+		mmTrackActivity.defaultExpectation = &UserServiceMockTrackActivityExpectation{}
 	}
 
-	mmGet.defaultExpectation.params = &UserServiceMockGetParams{ctx, userID}
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmTrackActivity.defaultExpectation.params = &UserServiceMockTrackActivityParams{ctx, userID, at}
+	for _, e := range mmTrackActivity.expectations {
+		if minimock.Equal(e.params, mmTrackActivity.defaultExpectation.params) {
+			mmTrackActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTrackActivity.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmTrackActivity
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Get
-func (mmGet *mUserServiceMockGet) Inspect(f func(ctx context.Context, userID int64)) *mUserServiceMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Get")
+// Inspect accepts an inspector function that has same arguments as the UserService.TrackActivity
+func (mmTrackActivity *mUserServiceMockTrackActivity) Inspect(f func(ctx context.Context, userID int64, at time.Time)) *mUserServiceMockTrackActivity {
+	if mmTrackActivity.mock.inspectFuncTrackActivity != nil {
+		mmTrackActivity.mock.t.Fatalf("Inspect function is already set for UserServiceMock.TrackActivity")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	mmTrackActivity.mock.inspectFuncTrackActivity = f
 
-	return mmGet
+	return mmTrackActivity
 }
 
-// Return sets up results that will be returned by UserService.Get
-func (mmGet *mUserServiceMockGet) Return(u1 def.UserDTO, err error) *UserServiceMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// Return sets up results that will be returned by UserService.TrackActivity
+func (mmTrackActivity *mUserServiceMockTrackActivity) Return(err error) *UserServiceMock {
+	if mmTrackActivity.mock.funcTrackActivity != nil {
+		mmTrackActivity.mock.t.Fatalf("UserServiceMock.TrackActivity mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{mock: mmGet.mock}
+	if mmTrackActivity.defaultExpectation == nil {
+		mmTrackActivity.defaultExpectation = &UserServiceMockTrackActivityExpectation{mock: mmTrackActivity.mock}
 	}
-	mmGet.defaultExpectation.results = &UserServiceMockGetResults{u1, err}
-	return mmGet.mock
+	mmTrackActivity.defaultExpectation.results = &UserServiceMockTrackActivityResults{err}
+	return mmTrackActivity.mock
 }
 
-// Set uses given function f to mock the UserService.Get method
-func (mmGet *mUserServiceMockGet) Set(f func(ctx context.Context, userID int64) (u1 def.UserDTO, err error)) *UserServiceMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the UserService.Get method")
+// Set uses given function f to mock the UserService.TrackActivity method
+func (mmTrackActivity *mUserServiceMockTrackActivity) Set(f func(ctx context.Context, userID int64, at time.Time) (err error)) *UserServiceMock {
+	if mmTrackActivity.defaultExpectation != nil {
+		mmTrackActivity.mock.t.Fatalf("Default expectation is already set for the UserService.TrackActivity method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the UserService.Get method")
+	if len(mmTrackActivity.expectations) > 0 {
+		mmTrackActivity.mock.t.Fatalf("Some expectations are already set for the UserService.TrackActivity method")
 	}
 
-	mmGet.mock.funcGet = f
-	return mmGet.mock
+	mmTrackActivity.mock.funcTrackActivity = f
+	return mmTrackActivity.mock
 }
 
-// When sets expectation for the UserService.Get which will trigger the result defined by the following
+// When sets expectation for the UserService.TrackActivity which will trigger the result defined by the following
 // Then helper
-func (mmGet *mUserServiceMockGet) When(ctx context.Context, userID int64) *UserServiceMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+func (mmTrackActivity *mUserServiceMockTrackActivity) When(ctx context.Context, userID int64, at time.Time) *UserServiceMockTrackActivityExpectation {
+	if mmTrackActivity.mock.funcTrackActivity != nil {
+		mmTrackActivity.mock.t.Fatalf("UserServiceMock.TrackActivity mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockGetExpectation{
-		mock:   mmGet.mock,
-		params: &UserServiceMockGetParams{ctx, userID},
+	expectation := &UserServiceMockTrackActivityExpectation{
+		mock:   mmTrackActivity.mock,
+		params: &UserServiceMockTrackActivityParams{ctx, userID, at},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmTrackActivity.expectations = append(mmTrackActivity.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Get return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockGetExpectation) Then(u1 def.UserDTO, err error) *UserServiceMock {
-	e.results = &UserServiceMockGetResults{u1, err}
+// Then sets up UserService.TrackActivity return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockTrackActivityExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockTrackActivityResults{err}
 	return e.mock
 }
 
-// Get implements usecases.UserService
-func (mmGet *UserServiceMock) Get(ctx context.Context, userID int64) (u1 def.UserDTO, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// TrackActivity implements usecases.UserService
+func (mmTrackActivity *UserServiceMock) TrackActivity(ctx context.Context, userID int64, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTrackActivity.beforeTrackActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmTrackActivity.afterTrackActivityCounter, 1)
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, userID)
+	if mmTrackActivity.inspectFuncTrackActivity != nil {
+		mmTrackActivity.inspectFuncTrackActivity(ctx, userID, at)
 	}
 
-	mm_params := UserServiceMockGetParams{ctx, userID}
+	mm_params := UserServiceMockTrackActivityParams{ctx, userID, at}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmTrackActivity.TrackActivityMock.mutex.Lock()
+	mmTrackActivity.TrackActivityMock.callArgs = append(mmTrackActivity.TrackActivityMock.callArgs, &mm_params)
+	mmTrackActivity.TrackActivityMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmTrackActivity.TrackActivityMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_got := UserServiceMockGetParams{ctx, userID}
+	if mmTrackActivity.TrackActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTrackActivity.TrackActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmTrackActivity.TrackActivityMock.defaultExpectation.params
+		mm_got := UserServiceMockTrackActivityParams{ctx, userID, at}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("UserServiceMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTrackActivity.t.Errorf("UserServiceMock.TrackActivity got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmTrackActivity.TrackActivityMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the UserServiceMock.Get")
+			mmTrackActivity.t.Fatal("No results are set for the UserServiceMock.TrackActivity")
 		}
-		return (*mm_results).u1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, userID)
+	if mmTrackActivity.funcTrackActivity != nil {
+		return mmTrackActivity.funcTrackActivity(ctx, userID, at)
 	}
-	mmGet.t.Fatalf("Unexpected call to UserServiceMock.Get. %v %v", ctx, userID)
+	mmTrackActivity.t.Fatalf("Unexpected call to UserServiceMock.TrackActivity. %v %v %v", ctx, userID, at)
 	return
 }
 
-// GetAfterCounter returns a count of finished UserServiceMock.Get invocations
-func (mmGet *UserServiceMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// TrackActivityAfterCounter returns a count of finished UserServiceMock.TrackActivity invocations
+func (mmTrackActivity *UserServiceMock) TrackActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTrackActivity.afterTrackActivityCounter)
 }
 
-// GetBeforeCounter returns a count of UserServiceMock.Get invocations
-func (mmGet *UserServiceMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// TrackActivityBeforeCounter returns a count of UserServiceMock.TrackActivity invocations
+func (mmTrackActivity *UserServiceMock) TrackActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTrackActivity.beforeTrackActivityCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Get.
+// Calls returns a list of arguments used in each call to UserServiceMock.TrackActivity.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mUserServiceMockGet) Calls() []*UserServiceMockGetParams {
-	mmGet.mutex.RLock()
+func (mmTrackActivity *mUserServiceMockTrackActivity) Calls() []*UserServiceMockTrackActivityParams {
+	mmTrackActivity.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*UserServiceMockTrackActivityParams, len(mmTrackActivity.callArgs))
+	copy(argCopy, mmTrackActivity.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmTrackActivity.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockTrackActivityDone returns true if the count of the TrackActivity invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockGetDone() bool {
-	for _, e := range m.GetMock.expectations {
+func (m *UserServiceMock) MinimockTrackActivityDone() bool {
+	for _, e := range m.TrackActivityMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+	if m.TrackActivityMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTrackActivityCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
+	if m.funcTrackActivity != nil && mm_atomic.LoadUint64(&m.afterTrackActivityCounter) < 1 {
 		return false
 	}
 	return true
 }
-
-// MinimockGetInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+
+// MinimockTrackActivityInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockTrackActivityInspect() {
+	for _, e := range m.TrackActivityMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Get with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.TrackActivity with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserServiceMock.Get")
+	if m.TrackActivityMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTrackActivityCounter) < 1 {
+		if m.TrackActivityMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.TrackActivity")
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.TrackActivity with params: %#v", *m.TrackActivityMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && mm_atomic.LoadUint64(&m.afterGetCounter) < 1 {
-		m.t.Error("Expected call to UserServiceMock.Get")
+	if m.funcTrackActivity != nil && mm_atomic.LoadUint64(&m.afterTrackActivityCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.TrackActivity")
 	}
 }
 
-type mUserServiceMockRenewal struct {
+type mUserServiceMockUnblockUser struct {
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockRenewalExpectation
-	expectations       []*UserServiceMockRenewalExpectation
+	defaultExpectation *UserServiceMockUnblockUserExpectation
+	expectations       []*UserServiceMockUnblockUserExpectation
 
-	callArgs []*UserServiceMockRenewalParams
+	callArgs []*UserServiceMockUnblockUserParams
 	mutex    sync.RWMutex
 }
 
-// UserServiceMockRenewalExpectation specifies expectation struct of the UserService.Renewal
-type UserServiceMockRenewalExpectation struct {
+// UserServiceMockUnblockUserExpectation specifies expectation struct of the UserService.UnblockUser
+type UserServiceMockUnblockUserExpectation struct {
 	mock    *UserServiceMock
-	params  *UserServiceMockRenewalParams
-	results *UserServiceMockRenewalResults
+	params  *UserServiceMockUnblockUserParams
+	results *UserServiceMockUnblockUserResults
 	Counter uint64
 }
 
-// UserServiceMockRenewalParams contains parameters of the UserService.Renewal
-type UserServiceMockRenewalParams struct {
-	ctx           context.Context
-	refreshToken  string
-	isRenewAccess bool
+// UserServiceMockUnblockUserParams contains parameters of the UserService.UnblockUser
+type UserServiceMockUnblockUserParams struct {
+	ctx    context.Context
+	userID int64
+	reason string
 }
 
-// UserServiceMockRenewalResults contains results of the UserService.Renewal
-type UserServiceMockRenewalResults struct {
-	s1  string
+// UserServiceMockUnblockUserResults contains results of the UserService.UnblockUser
+type UserServiceMockUnblockUserResults struct {
+	u1  def.UserDTO
 	err error
 }
 
-// Expect sets up expected params for UserService.Renewal
-func (mmRenewal *mUserServiceMockRenewal) Expect(ctx context.Context, refreshToken string, isRenewAccess bool) *mUserServiceMockRenewal {
-	if mmRenewal.mock.funcRenewal != nil {
-		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+// Expect sets up expected params for UserService.UnblockUser
+func (mmUnblockUser *mUserServiceMockUnblockUser) Expect(ctx context.Context, userID int64, reason string) *mUserServiceMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("UserServiceMock.UnblockUser mock is already set by Set")
 	}
 
-	if mmRenewal.defaultExpectation == nil {
-		mmRenewal.defaultExpectation = &UserServiceMockRenewalExpectation{}
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &UserServiceMockUnblockUserExpectation{}
 	}
 
-	mmRenewal.defaultExpectation.params = &UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
-	for _, e := range mmRenewal.expectations {
-		if minimock.Equal(e.params, mmRenewal.defaultExpectation.params) {
-			mmRenewal.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenewal.defaultExpectation.params)
+	mmUnblockUser.defaultExpectation.params = &UserServiceMockUnblockUserParams{ctx, userID, reason}
+	for _, e := range mmUnblockUser.expectations {
+		if minimock.Equal(e.params, mmUnblockUser.defaultExpectation.params) {
+			mmUnblockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnblockUser.defaultExpectation.params)
 		}
 	}
 
-	return mmRenewal
+	return mmUnblockUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Renewal
-func (mmRenewal *mUserServiceMockRenewal) Inspect(f func(ctx context.Context, refreshToken string, isRenewAccess bool)) *mUserServiceMockRenewal {
-	if mmRenewal.mock.inspectFuncRenewal != nil {
-		mmRenewal.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Renewal")
+// Inspect accepts an inspector function that has same arguments as the UserService.UnblockUser
+func (mmUnblockUser *mUserServiceMockUnblockUser) Inspect(f func(ctx context.Context, userID int64, reason string)) *mUserServiceMockUnblockUser {
+	if mmUnblockUser.mock.inspectFuncUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.UnblockUser")
 	}
 
-	mmRenewal.mock.inspectFuncRenewal = f
+	mmUnblockUser.mock.inspectFuncUnblockUser = f
 
-	return mmRenewal
+	return mmUnblockUser
 }
 
-// Return sets up results that will be returned by UserService.Renewal
-func (mmRenewal *mUserServiceMockRenewal) Return(s1 string, err error) *UserServiceMock {
-	if mmRenewal.mock.funcRenewal != nil {
-		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+// Return sets up results that will be returned by UserService.UnblockUser
+func (mmUnblockUser *mUserServiceMockUnblockUser) Return(u1 def.UserDTO, err error) *UserServiceMock {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("UserServiceMock.UnblockUser mock is already set by Set")
 	}
 
-	if mmRenewal.defaultExpectation == nil {
-		mmRenewal.defaultExpectation = &UserServiceMockRenewalExpectation{mock: mmRenewal.mock}
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &UserServiceMockUnblockUserExpectation{mock: mmUnblockUser.mock}
 	}
-	mmRenewal.defaultExpectation.results = &UserServiceMockRenewalResults{s1, err}
-	return mmRenewal.mock
+	mmUnblockUser.defaultExpectation.results = &UserServiceMockUnblockUserResults{u1, err}
+	return mmUnblockUser.mock
 }
 
-// Set uses given function f to mock the UserService.Renewal method
-func (mmRenewal *mUserServiceMockRenewal) Set(f func(ctx context.Context, refreshToken string, isRenewAccess bool) (s1 string, err error)) *UserServiceMock {
-	if mmRenewal.defaultExpectation != nil {
-		mmRenewal.mock.t.Fatalf("Default expectation is already set for the UserService.Renewal method")
+// Set uses given function f to mock the UserService.UnblockUser method
+func (mmUnblockUser *mUserServiceMockUnblockUser) Set(f func(ctx context.Context, userID int64, reason string) (u1 def.UserDTO, err error)) *UserServiceMock {
+	if mmUnblockUser.defaultExpectation != nil {
+		mmUnblockUser.mock.t.Fatalf("Default expectation is already set for the UserService.UnblockUser method")
 	}
 
-	if len(mmRenewal.expectations) > 0 {
-		mmRenewal.mock.t.Fatalf("Some expectations are already set for the UserService.Renewal method")
+	if len(mmUnblockUser.expectations) > 0 {
+		mmUnblockUser.mock.t.Fatalf("Some expectations are already set for the UserService.UnblockUser method")
 	}
 
-	mmRenewal.mock.funcRenewal = f
-	return mmRenewal.mock
+	mmUnblockUser.mock.funcUnblockUser = f
+	return mmUnblockUser.mock
 }
 
-// When sets expectation for the UserService.Renewal which will trigger the result defined by the following
+// When sets expectation for the UserService.UnblockUser which will trigger the result defined by the following
 // Then helper
-func (mmRenewal *mUserServiceMockRenewal) When(ctx context.Context, refreshToken string, isRenewAccess bool) *UserServiceMockRenewalExpectation {
-	if mmRenewal.mock.funcRenewal != nil {
-		mmRenewal.mock.t.Fatalf("UserServiceMock.Renewal mock is already set by Set")
+func (mmUnblockUser *mUserServiceMockUnblockUser) When(ctx context.Context, userID int64, reason string) *UserServiceMockUnblockUserExpectation {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("UserServiceMock.UnblockUser mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockRenewalExpectation{
-		mock:   mmRenewal.mock,
-		params: &UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess},
+	expectation := &UserServiceMockUnblockUserExpectation{
+		mock:   mmUnblockUser.mock,
+		params: &UserServiceMockUnblockUserParams{ctx, userID, reason},
 	}
-	mmRenewal.expectations = append(mmRenewal.expectations, expectation)
+	mmUnblockUser.expectations = append(mmUnblockUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Renewal return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockRenewalExpectation) Then(s1 string, err error) *UserServiceMock {
-	e.results = &UserServiceMockRenewalResults{s1, err}
+// Then sets up UserService.UnblockUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockUnblockUserExpectation) Then(u1 def.UserDTO, err error) *UserServiceMock {
+	e.results = &UserServiceMockUnblockUserResults{u1, err}
 	return e.mock
 }
 
-// Renewal implements usecases.UserService
-func (mmRenewal *UserServiceMock) Renewal(ctx context.Context, refreshToken string, isRenewAccess bool) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmRenewal.beforeRenewalCounter, 1)
-	defer mm_atomic.AddUint64(&mmRenewal.afterRenewalCounter, 1)
+// UnblockUser implements usecases.UserService
+func (mmUnblockUser *UserServiceMock) UnblockUser(ctx context.Context, userID int64, reason string) (u1 def.UserDTO, err error) {
+	mm_atomic.AddUint64(&mmUnblockUser.beforeUnblockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnblockUser.afterUnblockUserCounter, 1)
 
-	if mmRenewal.inspectFuncRenewal != nil {
-		mmRenewal.inspectFuncRenewal(ctx, refreshToken, isRenewAccess)
+	if mmUnblockUser.inspectFuncUnblockUser != nil {
+		mmUnblockUser.inspectFuncUnblockUser(ctx, userID, reason)
 	}
 
-	mm_params := UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
+	mm_params := UserServiceMockUnblockUserParams{ctx, userID, reason}
 
 	// Record call args
-	mmRenewal.RenewalMock.mutex.Lock()
-	mmRenewal.RenewalMock.callArgs = append(mmRenewal.RenewalMock.callArgs, &mm_params)
-	mmRenewal.RenewalMock.mutex.Unlock()
+	mmUnblockUser.UnblockUserMock.mutex.Lock()
+	mmUnblockUser.UnblockUserMock.callArgs = append(mmUnblockUser.UnblockUserMock.callArgs, &mm_params)
+	mmUnblockUser.UnblockUserMock.mutex.Unlock()
 
-	for _, e := range mmRenewal.RenewalMock.expectations {
+	for _, e := range mmUnblockUser.UnblockUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
-	if mmRenewal.RenewalMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRenewal.RenewalMock.defaultExpectation.Counter, 1)
-		mm_want := mmRenewal.RenewalMock.defaultExpectation.params
-		mm_got := UserServiceMockRenewalParams{ctx, refreshToken, isRenewAccess}
+	if mmUnblockUser.UnblockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnblockUser.UnblockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnblockUser.UnblockUserMock.defaultExpectation.params
+		mm_got := UserServiceMockUnblockUserParams{ctx, userID, reason}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRenewal.t.Errorf("UserServiceMock.Renewal got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnblockUser.t.Errorf("UserServiceMock.UnblockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRenewal.RenewalMock.defaultExpectation.results
+		mm_results := mmUnblockUser.UnblockUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRenewal.t.Fatal("No results are set for the UserServiceMock.Renewal")
+			mmUnblockUser.t.Fatal("No results are set for the UserServiceMock.UnblockUser")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmRenewal.funcRenewal != nil {
-		return mmRenewal.funcRenewal(ctx, refreshToken, isRenewAccess)
+	if mmUnblockUser.funcUnblockUser != nil {
+		return mmUnblockUser.funcUnblockUser(ctx, userID, reason)
 	}
-	mmRenewal.t.Fatalf("Unexpected call to UserServiceMock.Renewal. %v %v %v", ctx, refreshToken, isRenewAccess)
+	mmUnblockUser.t.Fatalf("Unexpected call to UserServiceMock.UnblockUser. %v %v %v", ctx, userID, reason)
 	return
 }
 
-// RenewalAfterCounter returns a count of finished UserServiceMock.Renewal invocations
-func (mmRenewal *UserServiceMock) RenewalAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRenewal.afterRenewalCounter)
+// UnblockUserAfterCounter returns a count of finished UserServiceMock.UnblockUser invocations
+func (mmUnblockUser *UserServiceMock) UnblockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.afterUnblockUserCounter)
 }
 
-// RenewalBeforeCounter returns a count of UserServiceMock.Renewal invocations
-func (mmRenewal *UserServiceMock) RenewalBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRenewal.beforeRenewalCounter)
+// UnblockUserBeforeCounter returns a count of UserServiceMock.UnblockUser invocations
+func (mmUnblockUser *UserServiceMock) UnblockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.beforeUnblockUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Renewal.
+// Calls returns a list of arguments used in each call to UserServiceMock.UnblockUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRenewal *mUserServiceMockRenewal) Calls() []*UserServiceMockRenewalParams {
-	mmRenewal.mutex.RLock()
+func (mmUnblockUser *mUserServiceMockUnblockUser) Calls() []*UserServiceMockUnblockUserParams {
+	mmUnblockUser.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockRenewalParams, len(mmRenewal.callArgs))
-	copy(argCopy, mmRenewal.callArgs)
+	argCopy := make([]*UserServiceMockUnblockUserParams, len(mmUnblockUser.callArgs))
+	copy(argCopy, mmUnblockUser.callArgs)
 
-	mmRenewal.mutex.RUnlock()
+	mmUnblockUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRenewalDone returns true if the count of the Renewal invocations corresponds
+// MinimockUnblockUserDone returns true if the count of the UnblockUser invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockRenewalDone() bool {
-	for _, e := range m.RenewalMock.expectations {
+func (m *UserServiceMock) MinimockUnblockUserDone() bool {
+	for _, e := range m.UnblockUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RenewalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+	if m.UnblockUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnblockUserCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRenewal != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
+	if m.funcUnblockUser != nil && mm_atomic.LoadUint64(&m.afterUnblockUserCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockRenewalInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockRenewalInspect() {
-	for _, e := range m.RenewalMock.expectations {
+// MinimockUnblockUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockUnblockUserInspect() {
+	for _, e := range m.UnblockUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Renewal with params: %#v", *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.UnblockUser with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RenewalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
-		if m.RenewalMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to UserServiceMock.Renewal")
+	if m.UnblockUserMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnblockUserCounter) < 1 {
+		if m.UnblockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.UnblockUser")
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Renewal with params: %#v", *m.RenewalMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.UnblockUser with params: %#v", *m.UnblockUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRenewal != nil && mm_atomic.LoadUint64(&m.afterRenewalCounter) < 1 {
-		m.t.Error("Expected call to UserServiceMock.Renewal")
+	if m.funcUnblockUser != nil && mm_atomic.LoadUint64(&m.afterUnblockUserCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.UnblockUser")
 	}
 }
 
@@ -1614,23 +6372,283 @@ func (m *UserServiceMock) MinimockUpdateInspect() {
 	}
 }
 
+type mUserServiceMockVerifyAuditLog struct {
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockVerifyAuditLogExpectation
+	expectations       []*UserServiceMockVerifyAuditLogExpectation
+
+	callArgs []*UserServiceMockVerifyAuditLogParams
+	mutex    sync.RWMutex
+}
+
+// UserServiceMockVerifyAuditLogExpectation specifies expectation struct of the UserService.VerifyAuditLog
+type UserServiceMockVerifyAuditLogExpectation struct {
+	mock    *UserServiceMock
+	params  *UserServiceMockVerifyAuditLogParams
+	results *UserServiceMockVerifyAuditLogResults
+	Counter uint64
+}
+
+// UserServiceMockVerifyAuditLogParams contains parameters of the UserService.VerifyAuditLog
+type UserServiceMockVerifyAuditLogParams struct {
+	ctx context.Context
+}
+
+// UserServiceMockVerifyAuditLogResults contains results of the UserService.VerifyAuditLog
+type UserServiceMockVerifyAuditLogResults struct {
+	a1  def.AuditVerification
+	err error
+}
+
+// Expect sets up expected params for UserService.VerifyAuditLog
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) Expect(ctx context.Context) *mUserServiceMockVerifyAuditLog {
+	if mmVerifyAuditLog.mock.funcVerifyAuditLog != nil {
+		mmVerifyAuditLog.mock.t.Fatalf("UserServiceMock.VerifyAuditLog mock is already set by Set")
+	}
+
+	if mmVerifyAuditLog.defaultExpectation == nil {
+		mmVerifyAuditLog.defaultExpectation = &UserServiceMockVerifyAuditLogExpectation{}
+	}
+
+	mmVerifyAuditLog.defaultExpectation.params = &UserServiceMockVerifyAuditLogParams{ctx}
+	for _, e := range mmVerifyAuditLog.expectations {
+		if minimock.Equal(e.params, mmVerifyAuditLog.defaultExpectation.params) {
+			mmVerifyAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.VerifyAuditLog
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) Inspect(f func(ctx context.Context)) *mUserServiceMockVerifyAuditLog {
+	if mmVerifyAuditLog.mock.inspectFuncVerifyAuditLog != nil {
+		mmVerifyAuditLog.mock.t.Fatalf("Inspect function is already set for UserServiceMock.VerifyAuditLog")
+	}
+
+	mmVerifyAuditLog.mock.inspectFuncVerifyAuditLog = f
+
+	return mmVerifyAuditLog
+}
+
+// Return sets up results that will be returned by UserService.VerifyAuditLog
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) Return(a1 def.AuditVerification, err error) *UserServiceMock {
+	if mmVerifyAuditLog.mock.funcVerifyAuditLog != nil {
+		mmVerifyAuditLog.mock.t.Fatalf("UserServiceMock.VerifyAuditLog mock is already set by Set")
+	}
+
+	if mmVerifyAuditLog.defaultExpectation == nil {
+		mmVerifyAuditLog.defaultExpectation = &UserServiceMockVerifyAuditLogExpectation{mock: mmVerifyAuditLog.mock}
+	}
+	mmVerifyAuditLog.defaultExpectation.results = &UserServiceMockVerifyAuditLogResults{a1, err}
+	return mmVerifyAuditLog.mock
+}
+
+// Set uses given function f to mock the UserService.VerifyAuditLog method
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) Set(f func(ctx context.Context) (a1 def.AuditVerification, err error)) *UserServiceMock {
+	if mmVerifyAuditLog.defaultExpectation != nil {
+		mmVerifyAuditLog.mock.t.Fatalf("Default expectation is already set for the UserService.VerifyAuditLog method")
+	}
+
+	if len(mmVerifyAuditLog.expectations) > 0 {
+		mmVerifyAuditLog.mock.t.Fatalf("Some expectations are already set for the UserService.VerifyAuditLog method")
+	}
+
+	mmVerifyAuditLog.mock.funcVerifyAuditLog = f
+	return mmVerifyAuditLog.mock
+}
+
+// When sets expectation for the UserService.VerifyAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) When(ctx context.Context) *UserServiceMockVerifyAuditLogExpectation {
+	if mmVerifyAuditLog.mock.funcVerifyAuditLog != nil {
+		mmVerifyAuditLog.mock.t.Fatalf("UserServiceMock.VerifyAuditLog mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockVerifyAuditLogExpectation{
+		mock:   mmVerifyAuditLog.mock,
+		params: &UserServiceMockVerifyAuditLogParams{ctx},
+	}
+	mmVerifyAuditLog.expectations = append(mmVerifyAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.VerifyAuditLog return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockVerifyAuditLogExpectation) Then(a1 def.AuditVerification, err error) *UserServiceMock {
+	e.results = &UserServiceMockVerifyAuditLogResults{a1, err}
+	return e.mock
+}
+
+// VerifyAuditLog implements usecases.UserService
+func (mmVerifyAuditLog *UserServiceMock) VerifyAuditLog(ctx context.Context) (a1 def.AuditVerification, err error) {
+	mm_atomic.AddUint64(&mmVerifyAuditLog.beforeVerifyAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyAuditLog.afterVerifyAuditLogCounter, 1)
+
+	if mmVerifyAuditLog.inspectFuncVerifyAuditLog != nil {
+		mmVerifyAuditLog.inspectFuncVerifyAuditLog(ctx)
+	}
+
+	mm_params := UserServiceMockVerifyAuditLogParams{ctx}
+
+	// Record call args
+	mmVerifyAuditLog.VerifyAuditLogMock.mutex.Lock()
+	mmVerifyAuditLog.VerifyAuditLogMock.callArgs = append(mmVerifyAuditLog.VerifyAuditLogMock.callArgs, &mm_params)
+	mmVerifyAuditLog.VerifyAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmVerifyAuditLog.VerifyAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmVerifyAuditLog.VerifyAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyAuditLog.VerifyAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyAuditLog.VerifyAuditLogMock.defaultExpectation.params
+		mm_got := UserServiceMockVerifyAuditLogParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyAuditLog.t.Errorf("UserServiceMock.VerifyAuditLog got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyAuditLog.VerifyAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyAuditLog.t.Fatal("No results are set for the UserServiceMock.VerifyAuditLog")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmVerifyAuditLog.funcVerifyAuditLog != nil {
+		return mmVerifyAuditLog.funcVerifyAuditLog(ctx)
+	}
+	mmVerifyAuditLog.t.Fatalf("Unexpected call to UserServiceMock.VerifyAuditLog. %v", ctx)
+	return
+}
+
+// VerifyAuditLogAfterCounter returns a count of finished UserServiceMock.VerifyAuditLog invocations
+func (mmVerifyAuditLog *UserServiceMock) VerifyAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyAuditLog.afterVerifyAuditLogCounter)
+}
+
+// VerifyAuditLogBeforeCounter returns a count of UserServiceMock.VerifyAuditLog invocations
+func (mmVerifyAuditLog *UserServiceMock) VerifyAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyAuditLog.beforeVerifyAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.VerifyAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyAuditLog *mUserServiceMockVerifyAuditLog) Calls() []*UserServiceMockVerifyAuditLogParams {
+	mmVerifyAuditLog.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockVerifyAuditLogParams, len(mmVerifyAuditLog.callArgs))
+	copy(argCopy, mmVerifyAuditLog.callArgs)
+
+	mmVerifyAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyAuditLogDone returns true if the count of the VerifyAuditLog invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockVerifyAuditLogDone() bool {
+	for _, e := range m.VerifyAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyAuditLogMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterVerifyAuditLogCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyAuditLog != nil && mm_atomic.LoadUint64(&m.afterVerifyAuditLogCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockVerifyAuditLogInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockVerifyAuditLogInspect() {
+	for _, e := range m.VerifyAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyAuditLog with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyAuditLogMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterVerifyAuditLogCounter) < 1 {
+		if m.VerifyAuditLogMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.VerifyAuditLog")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyAuditLog with params: %#v", *m.VerifyAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyAuditLog != nil && mm_atomic.LoadUint64(&m.afterVerifyAuditLogCounter) < 1 {
+		m.t.Error("Expected call to UserServiceMock.VerifyAuditLog")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UserServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockArmSetupTokenInspect()
+
 			m.MinimockAuthInspect()
 
+			m.MinimockBatchGetInspect()
+
+			m.MinimockBlockUserInspect()
+
+			m.MinimockBootstrapAdminInspect()
+
 			m.MinimockCanDeleteInspect()
 
+			m.MinimockCheckStatusInspect()
+
+			m.MinimockCheckpointAuditInspect()
+
+			m.MinimockConsumeLoginLinkInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
+			m.MinimockEraseUserInspect()
+
+			m.MinimockExportMyDataInspect()
+
+			m.MinimockExportUsersInspect()
+
 			m.MinimockGetInspect()
 
+			m.MinimockImportUsersInspect()
+
+			m.MinimockListAuditEventsInspect()
+
+			m.MinimockListUsersInspect()
+
+			m.MinimockPurgeDeletedInspect()
+
 			m.MinimockRenewalInspect()
 
+			m.MinimockRequestLoginLinkInspect()
+
+			m.MinimockResetPasswordInspect()
+
+			m.MinimockRestoreInspect()
+
+			m.MinimockRevokeSessionsInspect()
+
+			m.MinimockSearchUsersInspect()
+
+			m.MinimockTrackActivityInspect()
+
+			m.MinimockUnblockUserInspect()
+
 			m.MinimockUpdateInspect()
+
+			m.MinimockVerifyAuditLogInspect()
 			m.t.FailNow()
 		}
 	})
@@ -1655,11 +6673,33 @@ func (m *UserServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockArmSetupTokenDone() &&
 		m.MinimockAuthDone() &&
+		m.MinimockBatchGetDone() &&
+		m.MinimockBlockUserDone() &&
+		m.MinimockBootstrapAdminDone() &&
 		m.MinimockCanDeleteDone() &&
+		m.MinimockCheckStatusDone() &&
+		m.MinimockCheckpointAuditDone() &&
+		m.MinimockConsumeLoginLinkDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockEraseUserDone() &&
+		m.MinimockExportMyDataDone() &&
+		m.MinimockExportUsersDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockImportUsersDone() &&
+		m.MinimockListAuditEventsDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockPurgeDeletedDone() &&
 		m.MinimockRenewalDone() &&
-		m.MinimockUpdateDone()
+		m.MinimockRequestLoginLinkDone() &&
+		m.MinimockResetPasswordDone() &&
+		m.MinimockRestoreDone() &&
+		m.MinimockRevokeSessionsDone() &&
+		m.MinimockSearchUsersDone() &&
+		m.MinimockTrackActivityDone() &&
+		m.MinimockUnblockUserDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockVerifyAuditLogDone()
 }
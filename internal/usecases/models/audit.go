@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// AuditListDTO входные данные для запроса журнала аудита
+type AuditListDTO struct {
+	UserID  int64
+	ActorID int64
+	// Actions если заданы, только действия с этими названиями
+	Actions []string
+	From    time.Time
+	To      time.Time
+	Limit   uint32
+	Cursor  string
+}
+
+// AuditEventDTO запись журнала аудита
+type AuditEventDTO struct {
+	ID        int64
+	UserID    int64
+	ActorID   int64
+	Action    string
+	OldValue  string
+	NewValue  string
+	RequestID string
+	TraceID   string
+	IP        string
+	Details   map[string]string
+	CreatedAt time.Time
+}
+
+// AuditPage страница журнала аудита, сначала последние записи
+type AuditPage struct {
+	Events     []AuditEventDTO
+	NextCursor string
+}
+
+// AuditVerification результат проверки цепочки журнала аудита
+type AuditVerification struct {
+	// Checked сколько записей цепочки проверено
+	Checked int64
+	// Checkpoints сколько подписанных контрольных точек проверено
+	Checkpoints int
+	// BrokenID id первой записи, на которой цепочка не сходится, 0 - цепочка цела
+	BrokenID int64
+	// Reason что именно не сошлось
+	Reason string
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// Форматы массового импорта и выгрузки пользователей
+const (
+	BulkCSV   = "csv"
+	BulkJSONL = "jsonl"
+)
+
+// ImportRow строка импорта пользователей. Задается либо пароль, либо его bcrypt-хэш
+type ImportRow struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	// Role user или admin, пусто - user
+	Role         string `json:"role"`
+	Password     string `json:"password"`
+	PasswordHash string `json:"password_hash"`
+	// Status active или pending, пусто - active
+	Status string `json:"status"`
+}
+
+// ImportError ошибка строки импорта
+type ImportError struct {
+	// Line номер строки файла, с 1
+	Line  int64
+	Email string
+	Error string
+}
+
+// ImportResult итог импорта пользователей
+type ImportResult struct {
+	// Total сколько строк с пользователями прочитано
+	Total    int64
+	Imported int64
+	Failed   int64
+	// Errors ошибки строк, не больше первой тысячи
+	Errors []ImportError
+}
+
+// ExportRow строка выгрузки пользователей. Паролей и их хэшей в выгрузке нет
+type ExportRow struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/neracastle/chat-server/pkg/chat_v1"
 
 	"github.com/neracastle/auth/internal/domain/user"
@@ -10,25 +12,48 @@ import (
 
 // FromDomainToUsecase преобразует доменную сущность в дто из сервисного слоя
 func FromDomainToUsecase(dbUser *user.User) UserDTO {
-	return UserDTO{
+	dto := UserDTO{
 		ID:        dbUser.ID,
 		Email:     dbUser.Email,
-		Password:  dbUser.Password,
 		Name:      dbUser.Name,
 		IsAdmin:   dbUser.IsAdmin,
 		CreatedAt: dbUser.RegDate,
+		Version:   dbUser.Version,
+		Status:    string(dbUser.StatusAt(time.Now())),
+	}
+
+	if dto.Status == string(dbUser.Status) {
+		dto.StatusReason = dbUser.StatusReason
+		dto.SuspendedUntil = dbUser.SuspendedUntil
 	}
+
+	return dto
 }
 
 // FromDomainToJWT преобразует доменную сущность в дто для генерации токенов
 func FromDomainToJWT(dbUser *user.User) auth.JWTUser {
 	return auth.JWTUser{
-		ID:      dbUser.ID,
-		IsAdmin: dbUser.IsAdmin,
+		ID:             dbUser.ID,
+		IsAdmin:        dbUser.IsAdmin,
+		SessionVersion: dbUser.SessionVersion,
 		Scope: []string{
 			user_v1.UserV1_Get_FullMethodName,
+			user_v1.UserV1_BatchGet_FullMethodName,
 			user_v1.UserV1_Update_FullMethodName,
 			user_v1.UserV1_Delete_FullMethodName,
+			user_v1.UserV1_Restore_FullMethodName,
+			user_v1.UserV1_ListUsers_FullMethodName,
+			user_v1.UserV1_SearchUsers_FullMethodName,
+			user_v1.UserV1_ResetPassword_FullMethodName,
+			user_v1.UserV1_RevokeSessions_FullMethodName,
+			user_v1.UserV1_ListAuditEvents_FullMethodName,
+			user_v1.UserV1_VerifyAuditLog_FullMethodName,
+			user_v1.UserV1_ExportMyData_FullMethodName,
+			user_v1.UserV1_EraseUser_FullMethodName,
+			user_v1.UserV1_ImportUsers_FullMethodName,
+			user_v1.UserV1_ExportUsers_FullMethodName,
+			user_v1.UserV1_BlockUser_FullMethodName,
+			user_v1.UserV1_UnblockUser_FullMethodName,
 			chat_v1.ChatV1_Create_FullMethodName,
 			chat_v1.ChatV1_Delete_FullMethodName,
 			chat_v1.ChatV1_SendMessage_FullMethodName,
@@ -7,4 +7,6 @@ type CreateDTO struct {
 	PasswordConfirm string
 	Name            string
 	IsAdmin         bool
+	// SetupToken одноразовый токен создания первого администратора
+	SetupToken string
 }
@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// DataExport архив персональных данных пользователя по запросу субъекта данных
+type DataExport struct {
+	ExportedAt       time.Time        `json:"exported_at"`
+	Profile          ExportProfile    `json:"profile"`
+	Sessions         ExportSessions   `json:"sessions"`
+	AuditEvents      []ExportEvent    `json:"audit_events"`
+	LinkedIdentities []ExportIdentity `json:"linked_identities"`
+}
+
+// ExportProfile данные профиля
+type ExportProfile struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportSessions сессии пользователя. Refresh-токены на сервере не хранятся,
+// поэтому сессии описываются поколением (при отзыве растет) и историей входов
+type ExportSessions struct {
+	Generation int64         `json:"generation"`
+	Logins     []ExportLogin `json:"logins"`
+}
+
+// ExportLogin вход в аккаунт
+type ExportLogin struct {
+	At     time.Time `json:"at"`
+	Method string    `json:"method"`
+	IP     string    `json:"ip"`
+}
+
+// ExportEvent запись журнала аудита над пользователем или совершенная им
+type ExportEvent struct {
+	ID        int64             `json:"id"`
+	UserID    int64             `json:"user_id"`
+	ActorID   int64             `json:"actor_id"`
+	Action    string            `json:"action"`
+	OldValue  string            `json:"old_value,omitempty"`
+	NewValue  string            `json:"new_value,omitempty"`
+	IP        string            `json:"ip,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ExportIdentity способ входа, привязанный к аккаунту
+type ExportIdentity struct {
+	// Provider password или login_link
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+}
+
+// Erasure результат стирания персональных данных
+type Erasure struct {
+	// RedactedActions сколько записей журнала обезличено
+	RedactedActions int64
+	ErasedAt        time.Time
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ListDTO входные данные для запроса списка пользователей
+type ListDTO struct {
+	Limit  uint32
+	Cursor string
+	// IsAdmin если задан, фильтр по роли
+	IsAdmin     *bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Query подстрока почты или имени
+	Query string
+	// SortBy поле сортировки: id, email, name, created_at
+	SortBy string
+	Desc   bool
+}
+
+// UsersPage страница списка пользователей
+type UsersPage struct {
+	Users      []UserDTO
+	NextCursor string
+}
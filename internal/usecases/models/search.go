@@ -0,0 +1,11 @@
+package models
+
+// SearchHitDTO найденный пользователь
+type SearchHitDTO struct {
+	ID    int64
+	Name  string
+	Email string
+	Rank  float32
+	// Highlight фрагмент с выделенным совпадением
+	Highlight string
+}
@@ -1,10 +1,20 @@
 package models
 
+// Изменяемые поля пользователя для UpdateDTO.Fields
+const (
+	UpdateFieldName  = "name"
+	UpdateFieldEmail = "email"
+	UpdateFieldRole  = "role"
+)
+
 // UpdateDTO входные данные для запроса обновления юзера
 type UpdateDTO struct {
-	ID       int64
-	Email    string
-	Password string
-	Name     string
-	Role     uint8
+	ID      int64
+	Email   string
+	Name    string
+	IsAdmin bool
+	// Fields поля, которые нужно изменить, остальные значения игнорируются
+	Fields []string
+	// Version ожидаемая версия пользователя, 0 - без проверки
+	Version int64
 }
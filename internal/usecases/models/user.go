@@ -6,8 +6,12 @@ import "time"
 type UserDTO struct {
 	ID        int64
 	Email     string
-	Password  string
 	Name      string
 	IsAdmin   bool
 	CreatedAt time.Time
+	Version   int64
+	// Status статус на текущий момент: истекшая приостановка уже снята
+	Status         string
+	StatusReason   string
+	SuspendedUntil time.Time
 }
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+)
+
+// PurgeDeleted окончательно удаляет пользователей, срок хранения которых после удаления истек.
+// Возвращает кол-во удаленных записей
+func (s *Service) PurgeDeleted(ctx context.Context) (int64, error) {
+	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.PurgeDeleted"))
+
+	cnt, err := s.usersRepo.Purge(ctx, s.Config.DeletedUsersTTL)
+	if err != nil {
+		log.Error("failed to purge deleted users", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	if cnt > 0 {
+		log.Info("deleted users purged", slog.Int64("count", cnt))
+
+		dto := newAction(ctx, 0, actionPurge)
+		dto.Details = map[string]string{"count": strconv.FormatInt(cnt, 10)}
+		if err = s.actionsRepo.Save(ctx, dto); err != nil {
+			log.Error("failed to record purge", slog.String("error", err.Error()))
+		}
+	}
+
+	return cnt, nil
+}
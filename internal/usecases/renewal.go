@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	syserr "github.com/neracastle/go-libs/pkg/sys/error"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/usecases/models"
 	"github.com/neracastle/auth/pkg/user_v1/auth"
 )
 
@@ -16,7 +19,7 @@ func (s *Service) Renewal(ctx context.Context, refreshToken string, isRenewAcces
 	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.Renewal"))
 	log.Debug("called")
 
-	parsed, err := auth.ParseToken(refreshToken, []byte(s.Config.SecretKey))
+	parsed, err := auth.ParseToken(refreshToken, []byte(s.Config.SecretKey), s.previousKeys()...)
 	if err != nil {
 		log.Error("failed to parse refresh token", err.Error())
 
@@ -27,12 +30,32 @@ func (s *Service) Renewal(ctx context.Context, refreshToken string, isRenewAcces
 		return "", err
 	}
 
+	//отзыв сессий проверяем по бд, а не кэшу, чтобы он действовал сразу
+	dbUser, err := s.usersRepo.Get(ctx, user.SearchFilter{ID: parsed.ID})
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return "", ErrSessionRevoked
+		}
+
+		return "", err
+	}
+
+	if !dbUser.SessionValid(parsed.SessionVersion) {
+		return "", ErrSessionRevoked
+	}
+
+	//заблокированный или приостановленный пользователь сессии не продлевает
+	if err = signInError(dbUser.CanSignIn(time.Now())); err != nil {
+		return "", err
+	}
+
 	duration := s.Config.AccessDuration
 	if !isRenewAccess {
 		duration = s.Config.RefreshDuration
 	}
 
-	token, err := auth.GenerateToken(parsed, []byte(s.Config.SecretKey), duration)
+	//роль и права берутся из бд, а не из продлеваемого токена
+	token, err := auth.GenerateToken(models.FromDomainToJWT(dbUser), []byte(s.Config.SecretKey), duration)
 	if err != nil {
 		log.Error("failed to generate token", err.Error())
 		return "", syserr.New("Не удалось перевыпустить токен", syserr.Internal)
@@ -40,3 +63,13 @@ func (s *Service) Renewal(ctx context.Context, refreshToken string, isRenewAcces
 
 	return token, nil
 }
+
+// previousKeys прежние ключи подписи токенов
+func (s *Service) previousKeys() [][]byte {
+	keys := make([][]byte, 0, len(s.Config.PreviousSecretKeys))
+	for _, key := range s.Config.PreviousSecretKeys {
+		keys = append(keys, []byte(key))
+	}
+
+	return keys
+}
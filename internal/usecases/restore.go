@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Restore восстанавливает удаленного пользователя. Доступно только администраторам
+func (s *Service) Restore(ctx context.Context, userID int64) error {
+	log := logger.GetLogger(ctx)
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("method", "usecases.Restore"), slog.Int64("user_id", userID))
+
+	if !tokenUser.IsAdmin {
+		return ErrUserPermissionDenied
+	}
+
+	err := s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.usersRepo.Restore(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.actionsRepo.Save(ctx, newAction(ctx, userID, actionRestore))
+	})
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		if errors.Is(err, user.ErrEmailTaken) {
+			return syserr.New("Почта пользователя уже занята другим аккаунтом", syserr.AlreadyExists)
+		}
+
+		return syserr.New("Не удалось восстановить пользователя", syserr.Internal)
+	}
+
+	s.evictCached(ctx, userID)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxAdminSearchHits = 50
+	// обычным пользователям отдаем меньше результатов, чтобы поиск нельзя было использовать для выгрузки базы
+	maxUserSearchHits = 10
+	minSearchQueryLen = 2
+)
+
+// ErrSearchQueryTooShort слишком короткий поисковый запрос
+var ErrSearchQueryTooShort = syserr.New("Поисковый запрос слишком короткий", syserr.InvalidArgument)
+
+// SearchUsers нечеткий поиск пользователей по имени и почте.
+// Администраторы ищут по всем полям, обычные пользователи только по имени и не видят почту
+func (s *Service) SearchUsers(ctx context.Context, query string, limit uint32) ([]models.SearchHitDTO, error) {
+	const method = "usecases.SearchUsers"
+	var span trace.Span
+	ctx, span = tracer.Span(ctx, method)
+	defer span.End()
+
+	log := logger.GetLogger(ctx).With(slog.String("method", method))
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.Int64("auth_user_id", tokenUser.ID))
+
+	query = strings.TrimSpace(query)
+	if len([]rune(query)) < minSearchQueryLen {
+		return nil, ErrSearchQueryTooShort
+	}
+
+	maxHits := uint64(maxUserSearchHits)
+	if tokenUser.IsAdmin {
+		maxHits = maxAdminSearchHits
+	}
+
+	opts := user.SearchOptions{
+		Limit:     uint64(limit),
+		WithEmail: tokenUser.IsAdmin,
+	}
+
+	if opts.Limit == 0 {
+		opts.Limit = defaultSearchLimit
+	}
+
+	if opts.Limit > maxHits {
+		opts.Limit = maxHits
+	}
+
+	hits, err := s.usersRepo.Search(ctx, query, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, syserr.New("Не удалось выполнить поиск", syserr.Internal)
+	}
+
+	res := make([]models.SearchHitDTO, 0, len(hits))
+	for _, hit := range hits {
+		dto := models.SearchHitDTO{
+			ID:        hit.User.ID,
+			Name:      hit.User.Name,
+			Rank:      hit.Rank,
+			Highlight: hit.Highlight,
+		}
+
+		if tokenUser.IsAdmin {
+			dto.Email = hit.User.Email
+		}
+
+		res = append(res, dto)
+	}
+
+	return res, nil
+}
@@ -2,14 +2,19 @@ package usecases
 
 import (
 	"context"
+	"io"
 	"time"
 
-	"github.com/IBM/sarama"
 	"github.com/neracastle/go-libs/pkg/db"
 	"github.com/neracastle/go-libs/pkg/kafka"
 	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"golang.org/x/sync/singleflight"
 
+	"github.com/neracastle/auth/internal/errcode"
+	"github.com/neracastle/auth/internal/mailer"
 	"github.com/neracastle/auth/internal/repository/action"
+	"github.com/neracastle/auth/internal/repository/loginlink"
+	"github.com/neracastle/auth/internal/repository/outbox"
 	"github.com/neracastle/auth/internal/repository/user"
 	def "github.com/neracastle/auth/internal/usecases/models"
 )
@@ -17,6 +22,10 @@ import (
 var (
 	ErrUserNotFound         = syserr.New("Пользователь не найден", syserr.NotFound)
 	ErrUserPermissionDenied = syserr.New("Нет доступа к данному id", syserr.PermissionDenied)
+	// ErrVersionConflict пользователя изменили после того, как клиент его прочитал
+	ErrVersionConflict = syserr.New("Пользователь был изменен, получите актуальные данные и повторите", errcode.Aborted)
+	// ErrEmailTaken почта уже используется другим аккаунтом
+	ErrEmailTaken = syserr.New("Почта уже используется", syserr.AlreadyExists)
 )
 
 // UserService возможные сценарии с пользователем
@@ -24,20 +33,49 @@ type UserService interface {
 	Create(ctx context.Context, req def.CreateDTO) (int64, error)
 	Update(ctx context.Context, user def.UpdateDTO) error
 	Get(ctx context.Context, userID int64) (def.UserDTO, error)
+	BatchGet(ctx context.Context, userIDs []int64) ([]def.UserDTO, []int64, error)
 	Delete(ctx context.Context, userID int64) error
+	Restore(ctx context.Context, userID int64) error
+	PurgeDeleted(ctx context.Context) (int64, error)
+	TrackActivity(ctx context.Context, userID int64, at time.Time) error
+	ListUsers(ctx context.Context, req def.ListDTO) (def.UsersPage, error)
+	SearchUsers(ctx context.Context, query string, limit uint32) ([]def.SearchHitDTO, error)
 	Auth(ctx context.Context, login string, pwd string) (def.AuthTokens, error)
 	Renewal(ctx context.Context, refreshToken string, isRenewAccess bool) (string, error)
+	ResetPassword(ctx context.Context, userID int64, password string, passwordConfirm string) error
+	RevokeSessions(ctx context.Context, userID int64) error
 	CanDelete(ctx context.Context, userID int64) bool
+	RequestLoginLink(ctx context.Context, email string) error
+	ConsumeLoginLink(ctx context.Context, token string) (def.AuthTokens, error)
+	ListAuditEvents(ctx context.Context, req def.AuditListDTO) (def.AuditPage, error)
+	CheckpointAudit(ctx context.Context) error
+	VerifyAuditLog(ctx context.Context) (def.AuditVerification, error)
+	ExportMyData(ctx context.Context, userID int64) ([]byte, error)
+	EraseUser(ctx context.Context, userID int64) (def.Erasure, error)
+	ImportUsers(ctx context.Context, format string, r io.Reader) (def.ImportResult, error)
+	ExportUsers(ctx context.Context, format string, w io.Writer) (int64, error)
+	BlockUser(ctx context.Context, userID int64, reason string, until time.Time) (def.UserDTO, error)
+	UnblockUser(ctx context.Context, userID int64, reason string) (def.UserDTO, error)
+	CheckStatus(ctx context.Context, userID int64) error
+	BootstrapAdmin(ctx context.Context, req def.CreateDTO) (int64, error)
+	ArmSetupToken(ctx context.Context, token string) (string, error)
 }
 
 // Service сервис сценарием пользователя
 type Service struct {
-	usersRepo   user.Repository
-	usersCache  user.Cache
-	actionsRepo action.Repository
-	db          db.DB
-	producer    sarama.SyncProducer
-	consumer    kafka.Consumer
+	usersRepo      user.Repository
+	usersCache     user.Cache
+	invalidator    user.Invalidator
+	actionsRepo    action.Repository
+	loginLinksRepo loginlink.Repository
+	mailer         mailer.Mailer
+	db             db.DB
+	outboxRepo     outbox.Repository
+	consumer       kafka.Consumer
+	// loads объединяет одновременные загрузки пользователя из бд при промахе кэша
+	loads singleflight.Group
+	// setup токен создания первого администратора
+	setup setupToken
 	Config
 }
 
@@ -45,37 +83,73 @@ type Service struct {
 type Config struct {
 	// время кэширования данных о пользователе
 	CacheTTL time.Duration
-	// топик для отправки событий пользователя
-	NewUserTopic string
+	// топики событий пользователя по типу события (events.UserCreated и т.д.)
+	EventTopics map[string]string
+	// формат событий: events.FormatJSON или events.FormatProtobuf
+	EventsFormat string
 	// ключ подписи jwt-токенов
 	SecretKey string
+	// прежние ключи подписи, токены которых еще принимаются после ротации
+	PreviousSecretKeys []string
 	// срок жизни access-токена
 	AccessDuration time.Duration
 	// срок жизни refresh-токена
 	RefreshDuration time.Duration
+	// адрес страницы входа, к которому добавляется токен ссылки
+	LoginLinkURL string
+	// срок жизни ссылки для входа
+	LoginLinkTTL time.Duration
+	// сколько ссылок можно запросить на один адрес за LoginLinkRatePeriod
+	LoginLinkRateLimit int
+	// период ограничения запросов ссылки
+	LoginLinkRatePeriod time.Duration
+	// тема письма со ссылкой
+	LoginLinkSubject string
+	// сколько хранить мягко удаленных пользователей
+	DeletedUsersTTL time.Duration
+	// ключ подписи контрольных точек журнала аудита
+	AuditSigningKey string
+	// прежние ключи подписи контрольных точек, которыми проверяются старые точки
+	AuditVerifyKeys []string
 }
 
 // NewService новый экзмепляр usecase-сервиса
 func NewService(usersRepo user.Repository,
 	usersCache user.Cache,
+	invalidator user.Invalidator,
 	actionsRepo action.Repository,
+	loginLinksRepo loginlink.Repository,
+	mailer mailer.Mailer,
 	db db.DB,
-	producer sarama.SyncProducer,
+	outboxRepo outbox.Repository,
 	consumer kafka.Consumer,
 	config Config) *Service {
 	return &Service{
-		usersRepo:   usersRepo,
-		usersCache:  usersCache,
-		actionsRepo: actionsRepo,
-		db:          db,
-		producer:    producer,
-		consumer:    consumer,
+		usersRepo:      usersRepo,
+		usersCache:     usersCache,
+		invalidator:    invalidator,
+		actionsRepo:    actionsRepo,
+		loginLinksRepo: loginLinksRepo,
+		mailer:         mailer,
+		db:             db,
+		outboxRepo:     outboxRepo,
+		consumer:       consumer,
 		Config: Config{
-			CacheTTL:        config.CacheTTL,
-			NewUserTopic:    config.NewUserTopic,
-			SecretKey:       config.SecretKey,
-			AccessDuration:  config.AccessDuration,
-			RefreshDuration: config.RefreshDuration,
+			CacheTTL:            config.CacheTTL,
+			EventTopics:         config.EventTopics,
+			EventsFormat:        config.EventsFormat,
+			SecretKey:           config.SecretKey,
+			PreviousSecretKeys:  config.PreviousSecretKeys,
+			AccessDuration:      config.AccessDuration,
+			RefreshDuration:     config.RefreshDuration,
+			LoginLinkURL:        config.LoginLinkURL,
+			LoginLinkTTL:        config.LoginLinkTTL,
+			LoginLinkRateLimit:  config.LoginLinkRateLimit,
+			LoginLinkRatePeriod: config.LoginLinkRatePeriod,
+			LoginLinkSubject:    config.LoginLinkSubject,
+			DeletedUsersTTL:     config.DeletedUsersTTL,
+			AuditSigningKey:     config.AuditSigningKey,
+			AuditVerifyKeys:     config.AuditVerifyKeys,
 		},
 	}
 }
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Названия действий с сессиями в журнале user_actions
+const (
+	actionResetPassword  = "ResetPassword"
+	actionRevokeSessions = "RevokeSessions"
+)
+
+// ErrSessionRevoked токен выпущен до отзыва сессий пользователя
+var ErrSessionRevoked = syserr.New("Сессия отозвана, войдите заново", syserr.Unauthenticated)
+
+// ResetPassword задает пользователю новый пароль и отзывает его сессии. Доступно только администраторам
+func (s *Service) ResetPassword(ctx context.Context, userID int64, password string, passwordConfirm string) error {
+	log := logger.GetLogger(ctx)
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("method", "usecases.ResetPassword"), slog.Int64("user_id", userID))
+
+	if !tokenUser.IsAdmin {
+		return ErrUserPermissionDenied
+	}
+
+	if password != passwordConfirm {
+		return syserr.New("пароли не совпадают", syserr.InvalidArgument)
+	}
+
+	return s.changeSessions(ctx, userID, actionResetPassword, func(u *domain.User) error {
+		err := u.ChangePassword(password)
+		if err != nil {
+			return syserr.NewFromError(err, syserr.InvalidArgument)
+		}
+
+		//репозиторий при обновлении сохраняет пароль как есть
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		u.Password = string(hash)
+
+		return nil
+	})
+}
+
+// RevokeSessions отзывает все выданные пользователю refresh-токены.
+// Уже выданные access-токены действуют до истечения своего короткого срока
+func (s *Service) RevokeSessions(ctx context.Context, userID int64) error {
+	log := logger.GetLogger(ctx)
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("method", "usecases.RevokeSessions"), slog.Int64("user_id", userID))
+
+	//выйти со всех устройств пользователь может сам, а админ - за любого
+	if tokenUser.ID != userID && !tokenUser.IsAdmin {
+		return ErrUserPermissionDenied
+	}
+
+	return s.changeSessions(ctx, userID, actionRevokeSessions, func(*domain.User) error { return nil })
+}
+
+// changeSessions применяет change к пользователю, отзывает его сессии и пишет действие в журнал
+func (s *Service) changeSessions(ctx context.Context, userID int64, actionName string, change func(u *domain.User) error) error {
+	dbUser, err := s.usersRepo.Get(ctx, userRepo.SearchFilter{ID: userID})
+	if err != nil {
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		return err
+	}
+
+	err = change(dbUser)
+	if err != nil {
+		return err
+	}
+
+	dbUser.RevokeSessions()
+
+	err = s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.usersRepo.Update(ctx, dbUser)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.actionsRepo.Save(ctx, newAction(ctx, dbUser.ID, actionName))
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to revoke sessions", slog.String("error", err.Error()))
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		if errors.Is(err, userRepo.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
+
+		return syserr.New("Не удалось отозвать сессии", syserr.Internal)
+	}
+
+	s.refreshCached(ctx, dbUser)
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"golang.org/x/exp/slog"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	userRepo "github.com/neracastle/auth/internal/repository/user"
+	def "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Названия действий со статусом в журнале user_actions
+const (
+	actionBlock   = "Block"
+	actionSuspend = "Suspend"
+	actionUnblock = "Unblock"
+)
+
+var (
+	// ErrBlockSelf администратор пытается заблокировать сам себя
+	ErrBlockSelf = syserr.New("Нельзя заблокировать самого себя", syserr.InvalidArgument)
+	// ErrTokenUserNotFound владельца токена нет: он удален или токен выпущен не на пользователя
+	ErrTokenUserNotFound = syserr.New("Пользователь токена не найден, войдите заново", syserr.Unauthenticated)
+)
+
+// BlockUser блокирует пользователя, а если задан until - приостанавливает до этого времени.
+// Сессии пользователя отзываются, а выданные access-токены перестают приниматься сразу.
+// Доступно только администраторам
+func (s *Service) BlockUser(ctx context.Context, userID int64, reason string, until time.Time) (def.UserDTO, error) {
+	log := logger.GetLogger(ctx)
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("method", "usecases.BlockUser"), slog.Int64("user_id", userID))
+
+	if !tokenUser.IsAdmin {
+		return def.UserDTO{}, ErrUserPermissionDenied
+	}
+
+	//иначе последний администратор может запереть всех снаружи
+	if tokenUser.ID == userID {
+		return def.UserDTO{}, ErrBlockSelf
+	}
+
+	to := domain.StatusBlocked
+	if !until.IsZero() {
+		to = domain.StatusSuspended
+	}
+
+	return s.changeStatus(ctx, userID, to, reason, until)
+}
+
+// UnblockUser снимает блокировку или приостановку, а еще не активированного пользователя активирует.
+// Доступно только администраторам
+func (s *Service) UnblockUser(ctx context.Context, userID int64, reason string) (def.UserDTO, error) {
+	log := logger.GetLogger(ctx)
+	tokenUser := auth.UserFromContext(ctx)
+	log.Debug("called", slog.String("method", "usecases.UnblockUser"), slog.Int64("user_id", userID))
+
+	if !tokenUser.IsAdmin {
+		return def.UserDTO{}, ErrUserPermissionDenied
+	}
+
+	return s.changeStatus(ctx, userID, domain.StatusActive, reason, time.Time{})
+}
+
+// changeStatus переводит пользователя в статус to, пишет действие в журнал и публикует user.status_changed
+func (s *Service) changeStatus(ctx context.Context, userID int64, to domain.Status, reason string, until time.Time) (def.UserDTO, error) {
+	dbUser, err := s.usersRepo.Get(ctx, userRepo.SearchFilter{ID: userID})
+	if err != nil {
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return def.UserDTO{}, ErrUserNotFound
+		}
+
+		return def.UserDTO{}, err
+	}
+
+	//в бд срок хранится с точностью до секунды
+	until = until.UTC().Truncate(time.Second)
+	now := time.Now()
+	from := dbUser.StatusAt(now)
+	err = dbUser.ChangeStatus(to, reason, until, now)
+	if err != nil {
+		if errors.Is(err, domain.ErrStatusTransition) {
+			return def.UserDTO{}, syserr.NewFromError(err, syserr.DomainLogic)
+		}
+
+		return def.UserDTO{}, syserr.NewFromError(err, syserr.InvalidArgument)
+	}
+
+	changed := events.StatusChanged{UserID: userID, From: string(from), To: string(to), Reason: reason}
+	dto := newAction(ctx, userID, statusActionName(to))
+	dto.OldValue = string(from)
+	dto.NewValue = string(to)
+	dto.Details = map[string]string{"reason": reason}
+	if !until.IsZero() {
+		changed.SuspendedUntil = &until
+		dto.Details["suspended_until"] = until.Format(time.RFC3339)
+	}
+
+	err = s.db.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.usersRepo.Update(ctx, dbUser)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.actionsRepo.Save(ctx, dto)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.publish(ctx, events.UserStatusChanged, userID, changed)
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("failed to change user status", slog.String("error", err.Error()))
+		if errors.Is(err, userRepo.ErrUserNotFound) {
+			return def.UserDTO{}, ErrUserNotFound
+		}
+
+		if errors.Is(err, userRepo.ErrVersionConflict) {
+			return def.UserDTO{}, ErrVersionConflict
+		}
+
+		return def.UserDTO{}, syserr.New("Не удалось сменить статус пользователя", syserr.Internal)
+	}
+
+	//кэш читает CheckStatus, поэтому он обновляется сразу
+	s.refreshCached(ctx, dbUser)
+
+	return def.FromDomainToUsecase(dbUser), nil
+}
+
+func statusActionName(to domain.Status) string {
+	switch to {
+	case domain.StatusBlocked:
+		return actionBlock
+	case domain.StatusSuspended:
+		return actionSuspend
+	default:
+		return actionUnblock
+	}
+}
+
+// CheckStatus проверяет, что владелец токена не заблокирован и не приостановлен, на каждом защищенном запросе.
+// Пользователь читается через кэш, который обновляется при смене статуса. Токен удаленного пользователя
+// и токен без пользователя не принимаются
+func (s *Service) CheckStatus(ctx context.Context, userID int64) error {
+	if userID <= 0 {
+		return ErrTokenUserNotFound
+	}
+
+	cached, err := s.usersCache.GetByID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, userRepo.ErrUserNotCached) {
+			logger.GetLogger(ctx).Error("failed to get user from redis cache", slog.Int64("user_id", userID), slog.String("error", err.Error()))
+		}
+
+		loaded, errLoad, _ := s.loads.Do(strconv.FormatInt(userID, 10), func() (interface{}, error) {
+			return s.loadUser(context.WithoutCancel(ctx), userID)
+		})
+		if errLoad != nil {
+			if errors.Is(errLoad, userRepo.ErrUserNotFound) {
+				return ErrTokenUserNotFound
+			}
+
+			logger.GetLogger(ctx).Error("failed to check user status", slog.Int64("user_id", userID), slog.String("error", errLoad.Error()))
+			return syserr.New("Не удалось проверить статус пользователя", syserr.Internal)
+		}
+		cached = loaded.(*domain.User)
+	}
+
+	return signInError(cached.CanSignIn(time.Now()))
+}
+
+// checkSignIn не дает войти заблокированному, приостановленному или не активированному пользователю.
+// Отказ пишется в журнал как неудачный вход
+func (s *Service) checkSignIn(ctx context.Context, dbUser *domain.User, method string) error {
+	err := dbUser.CanSignIn(time.Now())
+	if err == nil {
+		return nil
+	}
+
+	dto := newAction(ctx, dbUser.ID, actionLoginFailed)
+	dto.Details = map[string]string{"method": method, "status": string(dbUser.Status)}
+	if errSave := s.actionsRepo.Save(ctx, dto); errSave != nil {
+		logger.GetLogger(ctx).Error("failed to record failed login", slog.String("error", errSave.Error()))
+	}
+
+	return signInError(err)
+}
+
+// signInError ошибка статуса для клиента
+func signInError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return syserr.NewFromError(err, syserr.PermissionDenied)
+}
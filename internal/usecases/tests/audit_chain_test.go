@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neracastle/auth/internal/repository/action"
+	actionMemory "github.com/neracastle/auth/internal/repository/action/memory"
+	actionModel "github.com/neracastle/auth/internal/repository/action/postgres/model"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// tamperedActions журнал, записи которого подменяются при чтении, как если бы их изменили в бд
+type tamperedActions struct {
+	action.Repository
+	tamper func([]actionModel.ActionDTO) []actionModel.ActionDTO
+}
+
+func (r tamperedActions) List(ctx context.Context, filter action.Filter) ([]actionModel.ActionDTO, error) {
+	actions, err := r.Repository.List(ctx, filter)
+	if err != nil || r.tamper == nil {
+		return actions, err
+	}
+
+	return r.tamper(actions), nil
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	newService := func(repo action.Repository, key string) *usecases2.Service {
+		return usecases2.NewService(nil, nil, nil, repo, nil, nil, fakeDB{}, nopOutbox{}, nil,
+			usecases2.Config{AuditSigningKey: key})
+	}
+
+	//5 записей, контрольная точка на 4-й
+	repo := actionMemory.New()
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, repo.Save(ctx, actionModel.ActionDTO{UserID: i, Name: "ChangeName", NewValue: "v"}))
+		if i == 4 {
+			require.NoError(t, newService(repo, "key").CheckpointAudit(ctx))
+		}
+	}
+
+	without := func(id int64) func([]actionModel.ActionDTO) []actionModel.ActionDTO {
+		return func(actions []actionModel.ActionDTO) []actionModel.ActionDTO {
+			return slices.DeleteFunc(actions, func(a actionModel.ActionDTO) bool { return a.ID == id })
+		}
+	}
+
+	tests := []struct {
+		name       string
+		key        string
+		tamper     func([]actionModel.ActionDTO) []actionModel.ActionDTO
+		wantBroken int64
+	}{
+		{name: "intact", key: "key"},
+		{
+			name: "changed content",
+			key:  "key",
+			tamper: func(actions []actionModel.ActionDTO) []actionModel.ActionDTO {
+				for i := range actions {
+					if actions[i].ID == 3 {
+						actions[i].NewValue = "forged"
+					}
+				}
+				return actions
+			},
+			wantBroken: 3,
+		},
+		{name: "deleted record", key: "key", tamper: without(3), wantBroken: 4},
+		{name: "deleted checkpointed tail", key: "key", tamper: func(actions []actionModel.ActionDTO) []actionModel.ActionDTO {
+			return without(5)(without(4)(actions))
+		}, wantBroken: 4},
+		{name: "checkpoint signed by another key", key: "other", wantBroken: 4},
+		{
+			name: "redacted without erasure",
+			key:  "key",
+			tamper: func(actions []actionModel.ActionDTO) []actionModel.ActionDTO {
+				now := time.Now()
+				for i := range actions {
+					if actions[i].ID == 2 {
+						actions[i].NewValue = ""
+						actions[i].RedactedAt = &now
+					}
+				}
+				return actions
+			},
+			wantBroken: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newService(tamperedActions{Repository: repo, tamper: tt.tamper}, tt.key)
+
+			res, err := srv.VerifyAuditLog(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantBroken, res.BrokenID, res.Reason)
+
+			if tt.wantBroken == 0 {
+				require.Equal(t, int64(5), res.Checked)
+				require.Equal(t, 1, res.Checkpoints)
+			}
+		})
+	}
+}
+
+func TestVerifyAuditLogAfterErasure(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	//пользователь 2 меняет имя себе и пользователю 3, пользователь 3 - себе
+	repo := actionMemory.New()
+	for _, ids := range [][2]int64{{2, 2}, {3, 3}, {2, 2}, {3, 2}} {
+		require.NoError(t, repo.Save(ctx, actionModel.ActionDTO{UserID: ids[0], ActorID: ids[1], Name: "ChangeName", NewValue: "v", IP: "10.0.0.1"}))
+	}
+
+	srv := usecases2.NewService(nil, nil, nil, repo, nil, nil, fakeDB{}, nopOutbox{}, nil, usecases2.Config{AuditSigningKey: "key"})
+	require.NoError(t, srv.CheckpointAudit(ctx))
+
+	redacted, err := repo.Redact(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), redacted)
+
+	//обезличенные записи без записи о стирании - разрыв
+	res, err := srv.VerifyAuditLog(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.BrokenID, res.Reason)
+
+	require.NoError(t, repo.Save(ctx, actionModel.ActionDTO{UserID: 2, ActorID: 1, Name: "Erase"}))
+
+	res, err = srv.VerifyAuditLog(ctx)
+	require.NoError(t, err)
+	require.Zero(t, res.BrokenID, res.Reason)
+	require.Equal(t, int64(5), res.Checked)
+	require.Equal(t, 1, res.Checkpoints)
+
+	actions, err := repo.List(ctx, action.Filter{})
+	require.NoError(t, err)
+	for _, a := range []actionModel.ActionDTO{actions[0], actions[2]} {
+		require.Empty(t, a.NewValue)
+		require.Empty(t, a.IP)
+	}
+	//в чужой записи стирается только ip инициатора
+	require.Equal(t, "v", actions[3].NewValue)
+	require.Empty(t, actions[3].IP)
+
+	now := time.Now()
+	tests := []struct {
+		name   string
+		id     int64
+		tamper func(a *actionModel.ActionDTO)
+	}{
+		{name: "ip is back on redacted record", id: 1, tamper: func(a *actionModel.ActionDTO) { a.IP = "10.0.0.2" }},
+		{name: "redacted record of other user is rewritten", id: 4, tamper: func(a *actionModel.ActionDTO) { a.NewValue = "forged" }},
+		{name: "redacted record is moved to other user", id: 3, tamper: func(a *actionModel.ActionDTO) { a.UserID = 3 }},
+		{
+			name: "record is forged as redacted by erased user",
+			id:   2,
+			tamper: func(a *actionModel.ActionDTO) {
+				a.UserID, a.ActorID, a.NewValue, a.IP, a.RedactedAt = 2, 2, "", "", &now
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := usecases2.NewService(nil, nil, nil, tamperedActions{Repository: repo, tamper: func(actions []actionModel.ActionDTO) []actionModel.ActionDTO {
+				for i := range actions {
+					if actions[i].ID == tt.id {
+						tt.tamper(&actions[i])
+					}
+				}
+				return actions
+			}}, nil, nil, fakeDB{}, nopOutbox{}, nil, usecases2.Config{AuditSigningKey: "key"})
+
+			res, err := srv.VerifyAuditLog(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.id, res.BrokenID, res.Reason)
+		})
+	}
+}
+
+func TestVerifyAuditLogAfterKeyRotations(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	repo := actionMemory.New()
+	//ключ jwt ротируется независимо и к точкам отношения не имеет
+	configs := []usecases2.Config{
+		{SecretKey: "jwt-1", AuditSigningKey: "audit-1"},
+		{SecretKey: "jwt-2", PreviousSecretKeys: []string{"jwt-1"}, AuditSigningKey: "audit-2", AuditVerifyKeys: []string{"audit-1"}},
+		{SecretKey: "jwt-3", PreviousSecretKeys: []string{"jwt-2"}, AuditSigningKey: "audit-3", AuditVerifyKeys: []string{"audit-2", "audit-1"}},
+	}
+
+	for i, cfg := range configs {
+		require.NoError(t, repo.Save(ctx, actionModel.ActionDTO{UserID: int64(i + 2), Name: "ChangeName", NewValue: "v"}))
+		srv := usecases2.NewService(nil, nil, nil, repo, nil, nil, fakeDB{}, nopOutbox{}, nil, cfg)
+		require.NoError(t, srv.CheckpointAudit(ctx))
+	}
+
+	//после двух ротаций проверяются точки, подписанные всеми ключами
+	srv := usecases2.NewService(nil, nil, nil, repo, nil, nil, fakeDB{}, nopOutbox{}, nil, configs[2])
+	res, err := srv.VerifyAuditLog(ctx)
+	require.NoError(t, err)
+	require.Zero(t, res.BrokenID, res.Reason)
+	require.Equal(t, 3, res.Checkpoints)
+
+	//точку нельзя подписать ключом jwt
+	forger := usecases2.NewService(nil, nil, nil, repo, nil, nil, fakeDB{}, nopOutbox{}, nil,
+		usecases2.Config{SecretKey: "jwt-3", AuditSigningKey: "jwt-3"})
+	require.NoError(t, repo.Save(ctx, actionModel.ActionDTO{UserID: 9, Name: "ChangeName", NewValue: "v"}))
+	require.NoError(t, forger.CheckpointAudit(ctx))
+
+	res, err = srv.VerifyAuditLog(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), res.BrokenID, res.Reason)
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+func TestBatchGet(t *testing.T) {
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	var (
+		ctx      = logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+		adminCtx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+		cached = &domain.User{ID: 1, Email: "cached@example.com", Status: domain.StatusActive}
+		stored = &domain.User{ID: 2, Email: "stored@example.com", Status: domain.StatusActive}
+	)
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		ids            []int64
+		want           []usecases.UserDTO
+		wantNotFound   []int64
+		err            error
+		usersRepoMock  func(mc *minimock.Controller) user.Repository
+		usersCacheMock func(mc *minimock.Controller) user.Cache
+	}{
+		{
+			name:         "Success. Cache hits, one db query for misses, order and duplicates",
+			ctx:          adminCtx,
+			ids:          []int64{2, 1, 3, 2},
+			want:         []usecases.UserDTO{usecases.FromDomainToUsecase(stored), usecases.FromDomainToUsecase(cached)},
+			wantNotFound: []int64{3},
+			usersRepoMock: func(mc *minimock.Controller) user.Repository {
+				repoMock := mocks.NewRepositoryMock(mc)
+				repoMock.GetManyMock.Set(func(_ context.Context, ids []int64) ([]*domain.User, error) {
+					require.Equal(t, []int64{2, 3}, ids)
+					return []*domain.User{stored}, nil
+				})
+
+				return repoMock
+			},
+			usersCacheMock: func(mc *minimock.Controller) user.Cache {
+				cacheMock := mocks.NewCacheMock(mc)
+				cacheMock.GetManyMock.Set(func(_ context.Context, ids []int64) (map[int64]*domain.User, error) {
+					require.Equal(t, []int64{2, 1, 3}, ids)
+					return map[int64]*domain.User{1: cached}, nil
+				})
+				cacheMock.SaveManyMock.Set(func(_ context.Context, users []*domain.User, ttl time.Duration) error {
+					require.Equal(t, []*domain.User{stored}, users)
+					require.Equal(t, time.Minute, ttl)
+					return nil
+				})
+
+				return cacheMock
+			},
+		},
+		{
+			name:         "Success. Cache is down",
+			ctx:          adminCtx,
+			ids:          []int64{1},
+			want:         []usecases.UserDTO{usecases.FromDomainToUsecase(cached)},
+			wantNotFound: []int64{},
+			usersRepoMock: func(mc *minimock.Controller) user.Repository {
+				repoMock := mocks.NewRepositoryMock(mc)
+				repoMock.GetManyMock.Set(func(_ context.Context, ids []int64) ([]*domain.User, error) {
+					require.Equal(t, []int64{1}, ids)
+					return []*domain.User{cached}, nil
+				})
+
+				return repoMock
+			},
+			usersCacheMock: func(mc *minimock.Controller) user.Cache {
+				cacheMock := mocks.NewCacheMock(mc)
+				cacheMock.GetManyMock.Set(func(context.Context, []int64) (map[int64]*domain.User, error) {
+					return nil, errors.New("redis is down")
+				})
+				cacheMock.SaveManyMock.Set(func(context.Context, []*domain.User, time.Duration) error {
+					return errors.New("redis is down")
+				})
+
+				return cacheMock
+			},
+		},
+		{
+			name: "Error. Other user's id",
+			ctx:  auth.AddUserToContext(ctx, auth.JWTUser{ID: 1}),
+			ids:  []int64{1, 2},
+			err:  usecases2.ErrUserPermissionDenied,
+			usersRepoMock: func(mc *minimock.Controller) user.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+			usersCacheMock: func(mc *minimock.Controller) user.Cache {
+				return mocks.NewCacheMock(mc)
+			},
+		},
+		{
+			name: "Error. Too many ids",
+			ctx:  adminCtx,
+			ids:  tooManyIDs(),
+			err:  usecases2.ErrBatchTooLarge,
+			usersRepoMock: func(mc *minimock.Controller) user.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+			usersCacheMock: func(mc *minimock.Controller) user.Cache {
+				return mocks.NewCacheMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			srv := usecases2.NewService(tt.usersRepoMock(mc), tt.usersCacheMock(mc), nil, nil, nil, nil, nil, nil, nil,
+				usecases2.Config{CacheTTL: time.Minute})
+
+			got, notFound, err := srv.BatchGet(tt.ctx, tt.ids)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantNotFound, notFound)
+		})
+	}
+}
+
+func tooManyIDs() []int64 {
+	ids := make([]int64, 0, usecases2.MaxBatchGetIDs+1)
+	for i := int64(1); i <= usecases2.MaxBatchGetIDs+1; i++ {
+		ids = append(ids, i)
+	}
+
+	return ids
+}
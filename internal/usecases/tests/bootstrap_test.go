@@ -0,0 +1,37 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+)
+
+func TestBootstrapAdminLostRace(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+
+	//администратора еще нет, но пока эта реплика создавала своего, его создала другая
+	var admins []*domain.User
+	repoMock := mocks.NewRepositoryMock(mc)
+	repoMock.ListMock.Set(func(context.Context, user.SearchFilter, user.ListOptions) ([]*domain.User, error) {
+		return admins, nil
+	})
+	repoMock.SaveMock.Set(func(context.Context, *domain.User) error {
+		admins = []*domain.User{{ID: 1, Email: "admin@example.com", IsAdmin: true}}
+		return user.ErrEmailTaken
+	})
+
+	srv := usecases2.NewService(repoMock, nil, nil, nil, nil, nil, fakeDB{}, nil, nil, usecases2.Config{})
+	id, err := srv.BootstrapAdmin(ctx, usecases.CreateDTO{Email: "admin@example.com", Password: "secret123", Name: "admin"})
+	require.NoError(t, err)
+	require.Zero(t, id)
+}
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/db"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/repository/action"
+	actionModel "github.com/neracastle/auth/internal/repository/action/postgres/model"
+	"github.com/neracastle/auth/internal/repository/outbox"
+	outboxModel "github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+	"github.com/neracastle/auth/internal/repository/user"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// fakeDB выполняет транзакцию без БД
+type fakeDB struct {
+	db.DB
+}
+
+func (fakeDB) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+// usersStore пользователи в памяти вместо postgres
+type usersStore struct {
+	user.Repository
+	mu    sync.Mutex
+	users map[int64]domain.User
+}
+
+func (s *usersStore) Get(_ context.Context, filter user.SearchFilter) (*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[filter.ID]
+	if !ok {
+		return nil, user.ErrUserNotFound
+	}
+
+	return &u, nil
+}
+
+func (s *usersStore) Update(_ context.Context, u *domain.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.users[u.ID].Version != u.Version {
+		return user.ErrVersionConflict
+	}
+
+	u.Version++
+	s.users[u.ID] = *u
+
+	return nil
+}
+
+func (s *usersStore) Delete(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return user.ErrUserNotFound
+	}
+	delete(s.users, id)
+
+	return nil
+}
+
+// memCache кэш в памяти вместо redis
+type memCache struct {
+	user.Cache
+	mu    sync.Mutex
+	users map[int64]domain.User
+}
+
+func (c *memCache) Save(_ context.Context, u *domain.User, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users[u.ID] = *u
+
+	return nil
+}
+
+func (c *memCache) GetByID(_ context.Context, id int64) (*domain.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	u, ok := c.users[id]
+	if !ok {
+		return nil, user.ErrUserNotCached
+	}
+
+	return &u, nil
+}
+
+func (c *memCache) Delete(_ context.Context, id int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.users, id)
+
+	return nil
+}
+
+// invalidations запоминает разосланные оповещения
+type invalidations struct {
+	ids []int64
+}
+
+func (i *invalidations) Publish(_ context.Context, id int64) error {
+	i.ids = append(i.ids, id)
+	return nil
+}
+
+func (i *invalidations) Subscribe(ctx context.Context, _ func(id int64)) error {
+	<-ctx.Done()
+	return nil
+}
+
+type nopActions struct {
+	action.Repository
+}
+
+func (nopActions) Save(context.Context, actionModel.ActionDTO) error { return nil }
+
+type nopOutbox struct {
+	outbox.Repository
+}
+
+func (nopOutbox) Save(context.Context, outboxModel.MessageDTO) error { return nil }
+
+func newCacheFixture(t *testing.T, u domain.User) (*usecases2.Service, *memCache, *invalidations, context.Context) {
+	t.Helper()
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	cache := &memCache{users: map[int64]domain.User{}}
+	inv := &invalidations{}
+	repo := &usersStore{users: map[int64]domain.User{u.ID: u}}
+
+	srv := usecases2.NewService(repo, cache, inv, nopActions{}, nil, nil, fakeDB{}, nopOutbox{}, nil,
+		usecases2.Config{
+			CacheTTL:     time.Minute,
+			EventsFormat: events.FormatJSON,
+			EventTopics: map[string]string{
+				events.UserUpdated:      "user.updated",
+				events.UserEmailChanged: "user.email_changed",
+				events.UserRoleChanged:  "user.role_changed",
+				events.UserDeleted:      "user.deleted",
+			},
+		})
+
+	return srv, cache, inv, ctx
+}
+
+func TestCacheConsistencyAfterUpdate(t *testing.T) {
+	srv, cache, inv, ctx := newCacheFixture(t, domain.User{
+		ID: 42, Email: "old@example.com", Name: "Old", Version: 1,
+	})
+
+	//прогреваем кэш
+	got, err := srv.Get(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, "old@example.com", got.Email)
+
+	err = srv.Update(ctx, usecases.UpdateDTO{
+		ID:      42,
+		Email:   "new@example.com",
+		IsAdmin: true,
+		Fields:  []string{usecases.UpdateFieldEmail, usecases.UpdateFieldRole},
+	})
+	require.NoError(t, err)
+
+	cached, err := cache.GetByID(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", cached.Email)
+	require.True(t, cached.IsAdmin)
+	require.Equal(t, int64(2), cached.Version)
+
+	got, err = srv.Get(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", got.Email)
+	require.True(t, got.IsAdmin)
+	require.Equal(t, []int64{42}, inv.ids)
+}
+
+func TestCacheConsistencyAfterDelete(t *testing.T) {
+	srv, cache, inv, ctx := newCacheFixture(t, domain.User{ID: 42, Email: "user@example.com", Version: 1})
+
+	_, err := srv.Get(ctx, 42)
+	require.NoError(t, err)
+
+	err = srv.Delete(ctx, 42)
+	require.NoError(t, err)
+
+	_, err = cache.GetByID(ctx, 42)
+	require.ErrorIs(t, err, user.ErrUserNotCached)
+
+	_, err = srv.Get(ctx, 42)
+	require.ErrorIs(t, err, usecases2.ErrUserNotFound)
+	require.Equal(t, []int64{42}, inv.ids)
+}
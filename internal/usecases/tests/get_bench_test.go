@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/lru"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// Задержки, приближенные к походу в postgres и redis по сети
+const (
+	dbLatency    = 500 * time.Microsecond
+	redisLatency = 100 * time.Microsecond
+)
+
+// slowStore считает запросы в бд и имитирует их задержку
+type slowStore struct {
+	*usersStore
+	delay time.Duration
+	calls atomic.Int64
+}
+
+func (s *slowStore) Get(ctx context.Context, filter user.SearchFilter) (*domain.User, error) {
+	s.calls.Add(1)
+	time.Sleep(s.delay)
+
+	return s.usersStore.Get(ctx, filter)
+}
+
+// slowCache имитирует задержку redis
+type slowCache struct {
+	*memCache
+}
+
+func (c slowCache) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+	time.Sleep(redisLatency)
+	return c.memCache.GetByID(ctx, id)
+}
+
+func (c slowCache) Save(ctx context.Context, u *domain.User, ttl time.Duration) error {
+	time.Sleep(redisLatency)
+	return c.memCache.Save(ctx, u, ttl)
+}
+
+// noCache кэш, в котором ничего не находится
+type noCache struct {
+	user.Cache
+}
+
+func (noCache) GetByID(context.Context, int64) (*domain.User, error) {
+	return nil, user.ErrUserNotCached
+}
+
+func (noCache) Save(context.Context, *domain.User, time.Duration) error { return nil }
+
+func newGetService(repo user.Repository, cache user.Cache) (*usecases2.Service, context.Context) {
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	srv := usecases2.NewService(repo, cache, &invalidations{}, nil, nil, nil, nil, nil, nil,
+		usecases2.Config{CacheTTL: time.Minute})
+
+	return srv, ctx
+}
+
+func newSlowStore(n int64, delay time.Duration) *slowStore {
+	users := make(map[int64]domain.User, n)
+	for id := int64(1); id <= n; id++ {
+		users[id] = domain.User{ID: id, Email: "user@example.com", Version: 1}
+	}
+
+	return &slowStore{usersStore: &usersStore{users: users}, delay: delay}
+}
+
+func TestGetCoalescesMisses(t *testing.T) {
+	//запрос в бд заведомо дольше старта всех горутин
+	repo := newSlowStore(1, 100*time.Millisecond)
+	srv, ctx := newGetService(repo, noCache{})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	start := make(chan struct{})
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := srv.Get(ctx, 1)
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	//кэш ничего не хранит, все промахи должны были объединиться в один запрос
+	require.Equal(t, int64(1), repo.calls.Load())
+}
+
+func BenchmarkGet(b *testing.B) {
+	const hotUsers = 100
+
+	modes := []struct {
+		name  string
+		cache func() user.Cache
+	}{
+		{"no_cache", func() user.Cache { return noCache{} }},
+		{"redis", func() user.Cache {
+			return slowCache{&memCache{users: map[int64]domain.User{}}}
+		}},
+		{"lru+redis", func() user.Cache {
+			return lru.New(slowCache{&memCache{users: map[int64]domain.User{}}}, hotUsers, time.Minute)
+		}},
+	}
+
+	for _, mode := range modes {
+		b.Run(mode.name, func(b *testing.B) {
+			repo := newSlowStore(hotUsers, dbLatency)
+			srv, ctx := newGetService(repo, mode.cache())
+
+			var next atomic.Int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					id := next.Add(1)%hotUsers + 1
+					_, err := srv.Get(ctx, id)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.ReportMetric(float64(repo.calls.Load())/float64(b.N), "db_queries/op")
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+func TestListUsersCursor(t *testing.T) {
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+
+		//уже отсортированы по почте, как их вернула бы бд
+		sorted = []*domain.User{
+			{ID: 3, Email: "a@example.com"},
+			{ID: 1, Email: "b@example.com"},
+			{ID: 2, Email: "c@example.com"},
+		}
+	)
+	adminCtx := auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	repoMock := mocks.NewRepositoryMock(mc)
+	repoMock.ListMock.Set(func(_ context.Context, _ user.SearchFilter, opts user.ListOptions) ([]*domain.User, error) {
+		require.Equal(t, user.SortByEmail, opts.SortBy)
+
+		from := 0
+		if opts.After != nil {
+			for i, u := range sorted {
+				if u.Email == opts.After.Value && u.ID == opts.After.ID {
+					from = i + 1
+				}
+			}
+		}
+
+		to := from + int(opts.Limit)
+		if to > len(sorted) {
+			to = len(sorted)
+		}
+
+		return sorted[from:to], nil
+	})
+	srv := usecases2.NewService(repoMock, nil, nil, nil, nil, nil, nil, nil, nil, usecases2.Config{})
+
+	first, err := srv.ListUsers(adminCtx, usecases.ListDTO{Limit: 2, SortBy: "email"})
+	require.NoError(t, err)
+	require.Len(t, first.Users, 2)
+	require.Equal(t, int64(3), first.Users[0].ID)
+	require.Equal(t, int64(1), first.Users[1].ID)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := srv.ListUsers(adminCtx, usecases.ListDTO{Limit: 2, SortBy: "email", Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Users, 1)
+	require.Equal(t, int64(2), second.Users[0].ID)
+	require.Empty(t, second.NextCursor)
+
+	//курсор выдан для другой сортировки
+	_, err = srv.ListUsers(adminCtx, usecases.ListDTO{Limit: 2, SortBy: "email", Desc: true, Cursor: first.NextCursor})
+	require.ErrorIs(t, err, usecases2.ErrInvalidCursor)
+
+	_, err = srv.ListUsers(adminCtx, usecases.ListDTO{SortBy: "email", Cursor: "broken"})
+	require.ErrorIs(t, err, usecases2.ErrInvalidCursor)
+
+	_, err = srv.ListUsers(adminCtx, usecases.ListDTO{SortBy: "password"})
+	require.ErrorIs(t, err, usecases2.ErrInvalidSortField)
+
+	_, err = srv.ListUsers(auth.AddUserToContext(ctx, auth.JWTUser{ID: 2}), usecases.ListDTO{})
+	require.ErrorIs(t, err, usecases2.ErrUserPermissionDenied)
+}
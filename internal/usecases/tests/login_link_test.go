@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/mailer"
+	actionMemory "github.com/neracastle/auth/internal/repository/action/memory"
+	linkMemory "github.com/neracastle/auth/internal/repository/loginlink/memory"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+)
+
+// sentMails запоминает отправленные письма
+type sentMails struct {
+	msgs []mailer.Message
+}
+
+func (m *sentMails) Send(_ context.Context, msg mailer.Message) error {
+	m.msgs = append(m.msgs, msg)
+	return nil
+}
+
+var linkToken = regexp.MustCompile(`token=(\S+)`)
+
+// tokenFromMail токен ссылки из текста письма
+func tokenFromMail(t *testing.T, msg mailer.Message) string {
+	t.Helper()
+
+	m := linkToken.FindStringSubmatch(msg.Body)
+	require.Len(t, m, 2)
+	token, err := url.QueryUnescape(m[1])
+	require.NoError(t, err)
+
+	return token
+}
+
+func newLoginLinkFixture(t *testing.T, users []*domain.User) (*usecases2.Service, *sentMails, context.Context) {
+	t.Helper()
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	mc := minimock.NewController(t)
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+
+	repoMock := mocks.NewRepositoryMock(mc)
+	repoMock.GetMock.Set(func(_ context.Context, filter user.SearchFilter) (*domain.User, error) {
+		for _, u := range users {
+			if u.Email == filter.Email || u.ID == filter.ID {
+				copied := *u
+				return &copied, nil
+			}
+		}
+
+		return nil, user.ErrUserNotFound
+	})
+
+	mails := &sentMails{}
+	srv := usecases2.NewService(repoMock, nil, nil, actionMemory.New(), linkMemory.New(), mails, fakeDB{}, nopOutbox{}, nil,
+		usecases2.Config{
+			SecretKey:           "secret",
+			AccessDuration:      time.Minute,
+			RefreshDuration:     time.Hour,
+			LoginLinkURL:        "https://example.com/login",
+			LoginLinkTTL:        time.Minute,
+			LoginLinkRateLimit:  2,
+			LoginLinkRatePeriod: time.Hour,
+			EventsFormat:        events.FormatJSON,
+			EventTopics:         map[string]string{events.UserLoggedIn: "user.logged_in"},
+		})
+
+	return srv, mails, ctx
+}
+
+func TestLoginLink(t *testing.T) {
+	users := []*domain.User{{ID: 7, Email: "user@example.com", Name: "User", Status: domain.StatusActive}}
+
+	t.Run("Success. Link works once, forged token is rejected", func(t *testing.T) {
+		srv, mails, ctx := newLoginLinkFixture(t, users)
+
+		require.NoError(t, srv.RequestLoginLink(ctx, "user@example.com"))
+		require.Len(t, mails.msgs, 1)
+		require.Equal(t, "user@example.com", mails.msgs[0].To)
+		require.True(t, strings.Contains(mails.msgs[0].Body, "https://example.com/login?token="))
+
+		token := tokenFromMail(t, mails.msgs[0])
+		tokens, err := srv.ConsumeLoginLink(ctx, token)
+		require.NoError(t, err)
+		require.NotEmpty(t, tokens.AccessToken)
+		require.NotEmpty(t, tokens.RefreshToken)
+
+		_, err = srv.ConsumeLoginLink(ctx, token)
+		require.ErrorIs(t, err, usecases2.ErrLoginLinkInvalid)
+
+		_, err = srv.ConsumeLoginLink(ctx, "not-a-token")
+		require.ErrorIs(t, err, usecases2.ErrLoginLinkInvalid)
+	})
+
+	t.Run("Unknown email. No error and no mail", func(t *testing.T) {
+		srv, mails, ctx := newLoginLinkFixture(t, users)
+
+		require.NoError(t, srv.RequestLoginLink(ctx, "nobody@example.com"))
+		require.Empty(t, mails.msgs)
+	})
+
+	t.Run("Error. Too many requests", func(t *testing.T) {
+		srv, mails, ctx := newLoginLinkFixture(t, users)
+
+		require.NoError(t, srv.RequestLoginLink(ctx, "user@example.com"))
+		require.NoError(t, srv.RequestLoginLink(ctx, "user@example.com"))
+		require.ErrorIs(t, srv.RequestLoginLink(ctx, "user@example.com"), usecases2.ErrLoginLinkTooMany)
+		require.Len(t, mails.msgs, 2)
+	})
+
+	t.Run("Error. Email changed after link was sent", func(t *testing.T) {
+		changed := []*domain.User{{ID: 7, Email: "user@example.com", Status: domain.StatusActive}}
+		srv, mails, ctx := newLoginLinkFixture(t, changed)
+
+		require.NoError(t, srv.RequestLoginLink(ctx, "user@example.com"))
+		changed[0].Email = "other@example.com"
+
+		_, err := srv.ConsumeLoginLink(ctx, tokenFromMail(t, mails.msgs[0]))
+		require.ErrorIs(t, err, usecases2.ErrLoginLinkInvalid)
+	})
+}
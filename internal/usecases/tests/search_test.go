@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/neracastle/go-libs/pkg/sys/tracer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+func TestSearchUsers(t *testing.T) {
+	tracer.Init(noop.NewTracerProvider().Tracer("test"))
+
+	var (
+		ctx = logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+		hit = user.SearchHit{
+			User:      &domain.User{ID: 5, Email: "ivan@example.com", Name: "Иван"},
+			Rank:      0.5,
+			Highlight: "<b>Иван</b>",
+		}
+	)
+
+	tests := []struct {
+		name      string
+		tokenUser auth.JWTUser
+		query     string
+		limit     uint32
+		want      []usecases.SearchHitDTO
+		err       error
+		wantOpts  user.SearchOptions
+	}{
+		{
+			name:      "Success. Admin sees email, limit is capped",
+			tokenUser: auth.JWTUser{ID: 1, IsAdmin: true},
+			query:     "  иван ",
+			limit:     1000,
+			want:      []usecases.SearchHitDTO{{ID: 5, Name: "Иван", Email: "ivan@example.com", Rank: 0.5, Highlight: "<b>Иван</b>"}},
+			wantOpts:  user.SearchOptions{Limit: 50, WithEmail: true},
+		},
+		{
+			name:      "Success. User does not see email",
+			tokenUser: auth.JWTUser{ID: 2},
+			query:     "иван",
+			limit:     1000,
+			want:      []usecases.SearchHitDTO{{ID: 5, Name: "Иван", Rank: 0.5, Highlight: "<b>Иван</b>"}},
+			wantOpts:  user.SearchOptions{Limit: 10},
+		},
+		{
+			name:      "Error. Query too short",
+			tokenUser: auth.JWTUser{ID: 2},
+			query:     " и ",
+			err:       usecases2.ErrSearchQueryTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mocks.NewRepositoryMock(mc)
+			if tt.err == nil {
+				repoMock.SearchMock.Set(func(_ context.Context, query string, opts user.SearchOptions) ([]user.SearchHit, error) {
+					require.Equal(t, "иван", query)
+					require.Equal(t, tt.wantOpts, opts)
+
+					return []user.SearchHit{hit}, nil
+				})
+			}
+
+			srv := usecases2.NewService(repoMock, nil, nil, nil, nil, nil, nil, nil, nil, usecases2.Config{})
+			got, err := srv.SearchUsers(auth.AddUserToContext(ctx, tt.tokenUser), tt.query, tt.limit)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	syserr "github.com/neracastle/go-libs/pkg/sys/error"
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/repository/action"
+	actionMemory "github.com/neracastle/auth/internal/repository/action/memory"
+	"github.com/neracastle/auth/internal/repository/user"
+	"github.com/neracastle/auth/internal/repository/user/mocks"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+func TestSoftDelete(t *testing.T) {
+	var (
+		ctx      = logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+		adminCtx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+		userCtx  = auth.AddUserToContext(ctx, auth.JWTUser{ID: 7})
+		config   = usecases2.Config{
+			DeletedUsersTTL: 24 * time.Hour,
+			EventsFormat:    events.FormatJSON,
+			EventTopics:     map[string]string{events.UserDeleted: "user.deleted"},
+		}
+	)
+
+	t.Run("Delete. User deletes himself, sessions are revoked, cache is evicted", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		repoMock := mocks.NewRepositoryMock(mc)
+		repoMock.GetMock.Set(func(_ context.Context, filter user.SearchFilter) (*domain.User, error) {
+			require.Equal(t, int64(7), filter.ID)
+			return &domain.User{ID: 7, SessionVersion: 2}, nil
+		})
+		repoMock.UpdateMock.Set(func(_ context.Context, u *domain.User) error {
+			require.Equal(t, int64(3), u.SessionVersion)
+			return nil
+		})
+		repoMock.DeleteMock.Set(func(_ context.Context, id int64) error {
+			require.Equal(t, int64(7), id)
+			return nil
+		})
+		cacheMock := mocks.NewCacheMock(mc)
+		cacheMock.DeleteMock.Expect(userCtx, 7).Return(nil)
+		inv := &invalidations{}
+
+		srv := usecases2.NewService(repoMock, cacheMock, inv, nopActions{}, nil, nil, fakeDB{}, nopOutbox{}, nil, config)
+		require.NoError(t, srv.Delete(userCtx, 7))
+		require.Equal(t, []int64{7}, inv.ids)
+	})
+
+	t.Run("Delete. Other user is denied", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		srv := usecases2.NewService(mocks.NewRepositoryMock(mc), mocks.NewCacheMock(mc), nil, nil, nil, nil, fakeDB{}, nil, nil, config)
+		require.ErrorIs(t, srv.Delete(userCtx, 8), usecases2.ErrUserPermissionDenied)
+	})
+
+	t.Run("Delete. Already deleted", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		repoMock := mocks.NewRepositoryMock(mc)
+		repoMock.GetMock.Set(func(context.Context, user.SearchFilter) (*domain.User, error) { return nil, user.ErrUserNotFound })
+
+		srv := usecases2.NewService(repoMock, mocks.NewCacheMock(mc), nil, nopActions{}, nil, nil, fakeDB{}, nopOutbox{}, nil, config)
+		require.ErrorIs(t, srv.Delete(adminCtx, 7), usecases2.ErrUserNotFound)
+	})
+
+	t.Run("Restore. Admin only", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		repoMock := mocks.NewRepositoryMock(mc)
+		repoMock.RestoreMock.Set(func(_ context.Context, id int64) error {
+			require.Equal(t, int64(7), id)
+			return nil
+		})
+		cacheMock := mocks.NewCacheMock(mc)
+		cacheMock.DeleteMock.Expect(adminCtx, 7).Return(nil)
+
+		srv := usecases2.NewService(repoMock, cacheMock, &invalidations{}, nopActions{}, nil, nil, fakeDB{}, nil, nil, config)
+		require.ErrorIs(t, srv.Restore(userCtx, 7), usecases2.ErrUserPermissionDenied)
+		require.NoError(t, srv.Restore(adminCtx, 7))
+	})
+
+	t.Run("Restore. Email is taken by new account", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		repoMock := mocks.NewRepositoryMock(mc)
+		repoMock.RestoreMock.Set(func(context.Context, int64) error { return user.ErrEmailTaken })
+
+		srv := usecases2.NewService(repoMock, mocks.NewCacheMock(mc), nil, nopActions{}, nil, nil, fakeDB{}, nil, nil, config)
+		err := srv.Restore(adminCtx, 7)
+		require.True(t, syserr.IsCommonError(err))
+		require.Equal(t, syserr.AlreadyExists, syserr.GetCommonError(err).Code())
+	})
+
+	t.Run("PurgeDeleted. Retention is passed, purge is audited", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		repoMock := mocks.NewRepositoryMock(mc)
+		repoMock.PurgeMock.Expect(ctx, 24*time.Hour).Return(3, nil)
+		actions := actionMemory.New()
+
+		srv := usecases2.NewService(repoMock, nil, nil, actions, nil, nil, nil, nil, nil, config)
+		cnt, err := srv.PurgeDeleted(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), cnt)
+
+		saved, err := actions.List(ctx, action.Filter{Names: []string{"Purge"}})
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		require.Equal(t, "3", saved[0].Details["count"])
+	})
+}
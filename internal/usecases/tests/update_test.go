@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neracastle/go-libs/pkg/sys/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
+	"github.com/neracastle/auth/internal/grpc-server/interceptors"
+	"github.com/neracastle/auth/internal/repository/action"
+	actionMemory "github.com/neracastle/auth/internal/repository/action/memory"
+	"github.com/neracastle/auth/internal/repository/outbox"
+	outboxModel "github.com/neracastle/auth/internal/repository/outbox/postgres/model"
+	usecases2 "github.com/neracastle/auth/internal/usecases"
+	usecases "github.com/neracastle/auth/internal/usecases/models"
+	"github.com/neracastle/auth/pkg/user_v1/auth"
+)
+
+// outboxRecorder запоминает сохраненные в outbox события
+type outboxRecorder struct {
+	outbox.Repository
+	mu   sync.Mutex
+	msgs []outboxModel.MessageDTO
+}
+
+func (o *outboxRecorder) Save(_ context.Context, msg outboxModel.MessageDTO) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.msgs = append(o.msgs, msg)
+
+	return nil
+}
+
+// eventTypes типы сохраненных событий по порядку
+func (o *outboxRecorder) eventTypes() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	types := make([]string, 0, len(o.msgs))
+	for _, msg := range o.msgs {
+		types = append(types, msg.Headers["event_type"])
+	}
+
+	return types
+}
+
+func TestUpdateRoleChangedEvent(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	repo := &usersStore{users: map[int64]domain.User{42: {ID: 42, Email: "user@example.com", Version: 1}}}
+	rec := &outboxRecorder{}
+	srv := usecases2.NewService(repo, &memCache{users: map[int64]domain.User{}}, &invalidations{}, nopActions{}, nil, nil,
+		fakeDB{}, rec, nil, usecases2.Config{
+			CacheTTL:     time.Minute,
+			EventsFormat: events.FormatJSON,
+			EventTopics: map[string]string{
+				events.UserUpdated:     "user.updated",
+				events.UserRoleChanged: "user.role_changed",
+			},
+		})
+
+	err := srv.Update(ctx, usecases.UpdateDTO{ID: 42, IsAdmin: true, Fields: []string{usecases.UpdateFieldRole}})
+	require.NoError(t, err)
+	require.Equal(t, []string{events.UserUpdated, events.UserRoleChanged}, rec.eventTypes())
+
+	env, err := events.Unmarshal(rec.msgs[1].Payload, rec.msgs[1].Headers[events.ContentTypeHeader])
+	require.NoError(t, err)
+	var changed events.RoleChanged
+	require.NoError(t, json.Unmarshal(env.Payload, &changed))
+	require.Equal(t, events.RoleChanged{UserID: 42, WasAdmin: false, IsAdmin: true}, changed)
+}
+
+func TestUpdateActionPerChangedField(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	adminCtx := auth.AddUserToContext(ctx, auth.JWTUser{ID: 1, IsAdmin: true})
+
+	repo := &usersStore{users: map[int64]domain.User{
+		42: {ID: 42, Email: "old@example.com", Name: "Same", Version: 1},
+	}}
+	actions := actionMemory.New()
+	srv := usecases2.NewService(repo, &memCache{users: map[int64]domain.User{}}, &invalidations{}, actions, nil, nil,
+		fakeDB{}, &outboxRecorder{}, nil, usecases2.Config{
+			CacheTTL:     time.Minute,
+			EventsFormat: events.FormatJSON,
+			EventTopics: map[string]string{
+				events.UserUpdated:      "user.updated",
+				events.UserEmailChanged: "user.email_changed",
+				events.UserRoleChanged:  "user.role_changed",
+			},
+		})
+
+	//имя не меняется, почта и роль меняются
+	err := srv.Update(adminCtx, usecases.UpdateDTO{
+		ID:      42,
+		Name:    "Same",
+		Email:   "new@example.com",
+		IsAdmin: true,
+		Fields:  []string{usecases.UpdateFieldName, usecases.UpdateFieldEmail, usecases.UpdateFieldRole},
+	})
+	require.NoError(t, err)
+
+	saved, err := actions.List(ctx, action.Filter{UserID: 42})
+	require.NoError(t, err)
+	require.Len(t, saved, 2)
+	require.Equal(t, "ChangeEmail", saved[0].Name)
+	require.Equal(t, "old@example.com", saved[0].OldValue)
+	require.Equal(t, "new@example.com", saved[0].NewValue)
+	require.Equal(t, "ChangeRole", saved[1].Name)
+	require.Equal(t, "user", saved[1].OldValue)
+	require.Equal(t, "admin", saved[1].NewValue)
+
+	//повтор с теми же значениями ничего не меняет и в журнал не пишется
+	err = srv.Update(adminCtx, usecases.UpdateDTO{
+		ID:     42,
+		Email:  "new@example.com",
+		Fields: []string{usecases.UpdateFieldEmail},
+	})
+	require.NoError(t, err)
+
+	saved, err = actions.List(ctx, action.Filter{UserID: 42})
+	require.NoError(t, err)
+	require.Len(t, saved, 2)
+
+	//роль может менять только админ, даже себе
+	err = srv.Update(auth.AddUserToContext(ctx, auth.JWTUser{ID: 42}), usecases.UpdateDTO{
+		ID:      42,
+		IsAdmin: false,
+		Fields:  []string{usecases.UpdateFieldRole},
+	})
+	require.ErrorIs(t, err, usecases2.ErrUserPermissionDenied)
+}
+
+func TestUpdateEmptyEmailIsFailedPrecondition(t *testing.T) {
+	ctx := logger.AssignLogger(context.Background(), logger.SetupLogger("disable"))
+	ctx = auth.AddUserToContext(ctx, auth.JWTUser{ID: 42})
+
+	repo := &usersStore{users: map[int64]domain.User{42: {ID: 42, Email: "user@example.com", Version: 1}}}
+	srv := usecases2.NewService(repo, &memCache{users: map[int64]domain.User{}}, &invalidations{}, nopActions{}, nil, nil,
+		fakeDB{}, &outboxRecorder{}, nil, usecases2.Config{CacheTTL: time.Minute})
+
+	//ошибка домена (syserr.DomainLogic) отдается клиенту как FailedPrecondition, раньше была Unknown
+	_, err := interceptors.ErrorCodesInterceptor(ctx, nil, nil, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, srv.Update(ctx, usecases.UpdateDTO{ID: 42, Fields: []string{usecases.UpdateFieldEmail}})
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, "user@example.com", repo.users[42].Email)
+}
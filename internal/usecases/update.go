@@ -3,19 +3,33 @@ package usecases
 import (
 	"context"
 	"errors"
-	"time"
 
 	syserr "github.com/neracastle/go-libs/pkg/sys/error"
 	"github.com/neracastle/go-libs/pkg/sys/logger"
 	"golang.org/x/exp/slog"
 
-	"github.com/neracastle/auth/internal/repository/action/postgres/model"
+	domain "github.com/neracastle/auth/internal/domain/user"
+	"github.com/neracastle/auth/internal/events"
 	userRepo "github.com/neracastle/auth/internal/repository/user"
 	def "github.com/neracastle/auth/internal/usecases/models"
 	"github.com/neracastle/auth/pkg/user_v1/auth"
 )
 
-// Update обновляет данные пользователя
+// Названия действий в журнале user_actions
+const (
+	actionChangeName  = "ChangeName"
+	actionChangeEmail = "ChangeEmail"
+	actionChangeRole  = "ChangeRole"
+)
+
+// fieldChange изменение одного поля пользователя
+type fieldChange struct {
+	action   string
+	oldValue string
+	newValue string
+}
+
+// Update обновляет поля пользователя, перечисленные в user.Fields
 func (s *Service) Update(ctx context.Context, user def.UpdateDTO) error {
 	log := logger.GetLogger(ctx).With(slog.String("method", "usecases.Update"))
 	log.Debug("called", slog.Int64("user_id", user.ID))
@@ -36,19 +50,48 @@ func (s *Service) Update(ctx context.Context, user def.UpdateDTO) error {
 		return err
 	}
 
-	dbUser.Name = user.Name
-
-	switch user.Role {
-	case 1:
-		dbUser.IsAdmin = false
-	case 2:
-		dbUser.IsAdmin = true
+	//клиент обновляет данные, которые видел: если с тех пор их изменили, перезаписывать нельзя
+	if user.Version != 0 && user.Version != dbUser.Version {
+		return ErrVersionConflict
 	}
 
 	oldEmail := dbUser.Email
-	err = dbUser.ChangeEmail(user.Email)
-	if err != nil {
-		return syserr.NewFromError(err, syserr.DomainLogic)
+	wasAdmin := dbUser.IsAdmin
+	var changes []fieldChange
+
+	for _, field := range user.Fields {
+		switch field {
+		case def.UpdateFieldName:
+			if dbUser.Name != user.Name {
+				changes = append(changes, fieldChange{actionChangeName, dbUser.Name, user.Name})
+				dbUser.Name = user.Name
+			}
+		case def.UpdateFieldEmail:
+			if dbUser.Email != domain.NormalizeEmail(user.Email) {
+				err = dbUser.ChangeEmail(user.Email)
+				if err != nil {
+					return syserr.NewFromError(err, syserr.DomainLogic)
+				}
+				changes = append(changes, fieldChange{actionChangeEmail, oldEmail, dbUser.Email})
+			}
+		case def.UpdateFieldRole:
+			//роль может менять только админ, иначе пользователь назначит админом сам себя
+			if !tokenUser.IsAdmin {
+				return ErrUserPermissionDenied
+			}
+			if dbUser.IsAdmin != user.IsAdmin {
+				changes = append(changes, fieldChange{actionChangeRole, roleName(wasAdmin), roleName(user.IsAdmin)})
+				dbUser.IsAdmin = user.IsAdmin
+				//роль зашита в токены: выданные с прежней ролью больше не продлеваются
+				dbUser.RevokeSessions()
+			}
+		default:
+			return syserr.New("Неизвестное поле: "+field, syserr.InvalidArgument)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
 	}
 
 	err = s.db.ReadCommitted(ctx, func(ctx context.Context) error {
@@ -57,20 +100,66 @@ func (s *Service) Update(ctx context.Context, user def.UpdateDTO) error {
 			return err
 		}
 
-		err = s.actionsRepo.Save(ctx, model.ActionDTO{
-			UserID:    dbUser.ID,
-			Name:      "ChangeEmail",
-			OldValue:  oldEmail,
-			NewValue:  dbUser.Email,
-			CreatedAt: time.Now(),
-		})
+		for _, ch := range changes {
+			dto := newAction(ctx, dbUser.ID, ch.action)
+			dto.OldValue = ch.oldValue
+			dto.NewValue = ch.newValue
+
+			err = s.actionsRepo.Save(ctx, dto)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.publish(ctx, events.UserUpdated, dbUser.ID, events.FromDomainUser(dbUser))
+		if err != nil {
+			return err
+		}
+
+		if oldEmail != dbUser.Email {
+			err = s.publish(ctx, events.UserEmailChanged, dbUser.ID, events.EmailChanged{
+				UserID:   dbUser.ID,
+				OldEmail: oldEmail,
+				NewEmail: dbUser.Email,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if wasAdmin != dbUser.IsAdmin {
+			err = s.publish(ctx, events.UserRoleChanged, dbUser.ID, events.RoleChanged{
+				UserID:   dbUser.ID,
+				WasAdmin: wasAdmin,
+				IsAdmin:  dbUser.IsAdmin,
+			})
+		}
 
 		return err
 	})
 
 	if err != nil {
+		if errors.Is(err, userRepo.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
+
+		if errors.Is(err, userRepo.ErrEmailTaken) {
+			return ErrEmailTaken
+		}
+
 		return syserr.New("Не удалось обновить пользователя", syserr.Internal)
 	}
 
+	s.refreshCached(ctx, dbUser)
+
 	return nil
 }
+
+// roleName значение роли для журнала действий
+func roleName(isAdmin bool) string {
+	if isAdmin {
+		return "admin"
+	}
+
+	return "user"
+}
@@ -6,5 +6,6 @@ ALTER TABLE auth.users ADD constraint users_role_check check (role >= 0);
 
 -- +goose Down
 -- +goose StatementBegin
-
+-- прежнюю проверку role > 0 не вернуть: роль 0 - обычный пользователь, а поднять ее до 1 значит сделать всех админами
+SELECT 1;
 -- +goose StatementEnd
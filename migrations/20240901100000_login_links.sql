@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.login_links
+(
+    id uuid primary key,
+    user_id bigint not null references auth.users(id) on delete cascade,
+    email text not null,
+    created_at timestamp(0) default CURRENT_TIMESTAMP,
+    expires_at timestamp(0) not null,
+    used_at timestamp(0)
+);
+CREATE INDEX login_links_email_created_idx ON auth.login_links(email, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE auth.login_links;
+-- +goose StatementEnd
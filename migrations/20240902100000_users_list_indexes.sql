@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX users_created_at_id_idx ON auth.users(created_at, id);
+CREATE INDEX users_name_id_idx ON auth.users((coalesce(name, '')), id);
+CREATE INDEX users_email_id_idx ON auth.users(email, id);
+CREATE INDEX users_role_id_idx ON auth.users(role, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX auth.users_role_id_idx;
+DROP INDEX auth.users_email_id_idx;
+DROP INDEX auth.users_name_id_idx;
+DROP INDEX auth.users_created_at_id_idx;
+-- +goose StatementEnd
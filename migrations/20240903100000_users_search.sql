@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX users_name_trgm_idx ON auth.users USING gin ((coalesce(name, '')) gin_trgm_ops);
+CREATE INDEX users_email_trgm_idx ON auth.users USING gin (email gin_trgm_ops);
+CREATE INDEX users_name_tsv_idx ON auth.users USING gin (to_tsvector('simple', coalesce(name, '')));
+CREATE INDEX users_email_tsv_idx ON auth.users USING gin (to_tsvector('simple', email));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX auth.users_email_tsv_idx;
+DROP INDEX auth.users_name_tsv_idx;
+DROP INDEX auth.users_email_trgm_idx;
+DROP INDEX auth.users_name_trgm_idx;
+DROP EXTENSION IF EXISTS pg_trgm;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth.users ADD COLUMN deleted_at timestamp(0);
+
+-- почта должна быть уникальна только среди неудаленных, чтобы после удаления можно было зарегистрироваться заново
+ALTER TABLE auth.users DROP CONSTRAINT users_email_key;
+CREATE UNIQUE INDEX users_email_active_idx ON auth.users(email) WHERE deleted_at IS NULL;
+CREATE INDEX users_deleted_at_idx ON auth.users(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- история действий переживает окончательное удаление пользователя, поэтому ссылка на users снимается
+ALTER TABLE auth.user_actions DROP CONSTRAINT user_actions_user_id_fkey;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- без deleted_at удаленных пользователей не отличить от обычных, поэтому они удаляются вместе с историей,
+-- как и история уже окончательно удаленных, иначе ссылку на users не восстановить
+DELETE FROM auth.user_actions a
+WHERE a.user_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM auth.users u WHERE u.id = a.user_id AND u.deleted_at IS NULL);
+DELETE FROM auth.users WHERE deleted_at IS NOT NULL;
+ALTER TABLE auth.user_actions ADD CONSTRAINT user_actions_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES auth.users(id);
+
+DROP INDEX auth.users_deleted_at_idx;
+DROP INDEX auth.users_email_active_idx;
+ALTER TABLE auth.users ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE auth.users DROP COLUMN deleted_at;
+-- +goose StatementEnd
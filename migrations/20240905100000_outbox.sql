@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.outbox
+(
+    id uuid primary key,
+    -- порядок сохранения: created_at с точностью до секунды его не задает
+    seq bigserial not null,
+    topic text not null,
+    key text not null default '',
+    payload bytea not null,
+    headers jsonb not null default '{}',
+    attempts int not null default 0,
+    last_error text,
+    created_at timestamp(0) default CURRENT_TIMESTAMP,
+    next_attempt_at timestamp(0) default CURRENT_TIMESTAMP,
+    sent_at timestamp(0)
+);
+CREATE INDEX outbox_pending_idx ON auth.outbox(seq) WHERE sent_at IS NULL;
+CREATE INDEX outbox_pending_key_idx ON auth.outbox(key, seq) WHERE sent_at IS NULL;
+CREATE INDEX outbox_sent_at_idx ON auth.outbox(sent_at) WHERE sent_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE auth.outbox;
+-- +goose StatementEnd
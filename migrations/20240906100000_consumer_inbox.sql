@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth.users ADD COLUMN last_activity_at timestamp(0);
+CREATE TABLE auth.consumer_inbox
+(
+    consumer text not null,
+    message_id text not null,
+    processed_at timestamp(0) default CURRENT_TIMESTAMP,
+    primary key (consumer, message_id)
+);
+CREATE INDEX consumer_inbox_processed_idx ON auth.consumer_inbox(processed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE auth.consumer_inbox;
+ALTER TABLE auth.users DROP COLUMN last_activity_at;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- журнал аудита: у записи есть id для курсора, инициатор и обстоятельства запроса
+ALTER TABLE auth.user_actions
+    ADD COLUMN id bigserial PRIMARY KEY,
+    ADD COLUMN actor_id bigint,
+    ADD COLUMN request_id text NOT NULL DEFAULT '',
+    ADD COLUMN trace_id text NOT NULL DEFAULT '',
+    ADD COLUMN ip text NOT NULL DEFAULT '',
+    ADD COLUMN details jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX user_actions_user_id_idx ON auth.user_actions(user_id, id);
+CREATE INDEX user_actions_actor_id_idx ON auth.user_actions(actor_id, id) WHERE actor_id IS NOT NULL;
+CREATE INDEX user_actions_created_at_idx ON auth.user_actions(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX auth.user_actions_created_at_idx;
+DROP INDEX auth.user_actions_actor_id_idx;
+DROP INDEX auth.user_actions_user_id_idx;
+
+ALTER TABLE auth.user_actions
+    DROP COLUMN details,
+    DROP COLUMN ip,
+    DROP COLUMN trace_id,
+    DROP COLUMN request_id,
+    DROP COLUMN actor_id,
+    DROP COLUMN id;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- цепочка хэшей журнала: записи до ее введения остаются без хэшей и не проверяются
+ALTER TABLE auth.user_actions
+    ADD COLUMN prev_hash bytea,
+    ADD COLUMN content_hash bytea,
+    ADD COLUMN hash bytea;
+
+CREATE TABLE auth.audit_checkpoints
+(
+    id bigserial PRIMARY KEY,
+    action_id bigint NOT NULL,
+    hash bytea NOT NULL,
+    signature bytea NOT NULL,
+    created_at timestamp(0) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX audit_checkpoints_action_id_idx ON auth.audit_checkpoints(action_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE auth.audit_checkpoints;
+
+ALTER TABLE auth.user_actions
+    DROP COLUMN hash,
+    DROP COLUMN content_hash,
+    DROP COLUMN prev_hash;
+-- +goose StatementEnd
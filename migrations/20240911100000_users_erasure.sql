@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- стертый пользователь остается строкой без персональных данных, чтобы ссылки на его id не повисли
+ALTER TABLE auth.users ADD COLUMN erased_at timestamp(0);
+
+-- обезличенные записи журнала: их содержимое больше не сходится с content_hash и сверяется с хэшем
+-- обезличенного вида, который входит в звено цепочки. Само стирание подтверждает запись Erase
+ALTER TABLE auth.user_actions ADD COLUMN redacted_at timestamp(0),
+    ADD COLUMN user_redacted_hash bytea,
+    ADD COLUMN actor_redacted_hash bytea;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auth.user_actions DROP COLUMN redacted_at, DROP COLUMN user_redacted_hash, DROP COLUMN actor_redacted_hash;
+ALTER TABLE auth.users DROP COLUMN erased_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- статус учетной записи: переходы между статусами проверяет домен, здесь только допустимые значения
+ALTER TABLE auth.users
+    ADD COLUMN status text NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'blocked', 'suspended', 'pending')),
+    ADD COLUMN status_reason text,
+    ADD COLUMN suspended_until timestamp(0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auth.users
+    DROP COLUMN suspended_until,
+    DROP COLUMN status_reason,
+    DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- почта хранится в нижнем регистре, как ее нормализует домен. Записи, которые после приведения
+-- совпали бы с другой активной, остаются как есть: их разбирает администратор
+UPDATE auth.users u SET email = lower(trim(u.email))
+WHERE u.email <> lower(trim(u.email))
+  AND NOT EXISTS (SELECT 1 FROM auth.users o
+                  WHERE o.id <> u.id AND o.deleted_at IS NULL AND lower(trim(o.email)) = lower(trim(u.email)));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- исходный регистр не сохранялся, откатывать нечего
+SELECT 1;
+-- +goose StatementEnd
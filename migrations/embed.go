@@ -0,0 +1,9 @@
+// Package migrations sql-миграции схемы auth, встроенные в бинарник сервиса
+package migrations
+
+import "embed"
+
+// FS файлы миграций в формате goose
+//
+//go:embed *.sql
+var FS embed.FS
@@ -24,3 +24,17 @@ func UserFromContext(ctx context.Context) *JWTUser {
 
 	return &userID
 }
+
+// LookupUser как UserFromContext, но для методов без обязательной авторизации: ok=false, если токена в запросе не было
+func LookupUser(ctx context.Context) (*JWTUser, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	user, ok := ctx.Value(AuthorisedUserIDKey{}).(JWTUser)
+	if !ok {
+		return nil, false
+	}
+
+	return &user, true
+}
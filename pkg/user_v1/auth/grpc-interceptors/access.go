@@ -18,11 +18,22 @@ const authPrefix = "Bearer "
 
 var secureMethodsMap map[string]struct{}
 var secretKey string
+var previousKeys [][]byte
+var statusCheck StatusCheck
+
+// StatusCheck проверяет, что владелец валидного токена еще может работать, например что его не заблокировали.
+// Возвращаемая ошибка уходит клиенту как есть
+type StatusCheck func(ctx context.Context, user auth.JWTUser) error
 
 // NewAccessInterceptor для заданных методов проверяет наличие access-токена и наличие соответствующего scope в нем
-// так же при успешной проверке записывает данные из токена в контекст
-func NewAccessInterceptor(secureMethods []string, jwtSecretKey string) grpc.UnaryServerInterceptor {
+// так же при успешной проверке записывает данные из токена в контекст.
+// previousSecretKeys - прежние ключи подписи, токены которых еще принимаются после ротации
+func NewAccessInterceptor(secureMethods []string, jwtSecretKey string, previousSecretKeys ...string) grpc.UnaryServerInterceptor {
 	secretKey = jwtSecretKey
+	previousKeys = make([][]byte, 0, len(previousSecretKeys))
+	for _, key := range previousSecretKeys {
+		previousKeys = append(previousKeys, []byte(key))
+	}
 
 	if len(secureMethods) > 0 {
 		secureMethodsMap = make(map[string]struct{}, len(secureMethods))
@@ -34,36 +45,63 @@ func NewAccessInterceptor(secureMethods []string, jwtSecretKey string) grpc.Unar
 	return accessInterceptor
 }
 
+// SetStatusCheck включает проверку владельца токена на каждом запросе с токеном: так блокировка действует сразу,
+// не дожидаясь истечения access-токена. Защищенный метод при ошибке проверки отклоняется,
+// а открытый выполняется как анонимный
+func SetStatusCheck(check StatusCheck) {
+	statusCheck = check
+}
+
 func accessInterceptor(ctx context.Context, req interface{}, i *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	//смотрим требует ли метод проверки доступа
 	//если да, то смотрим наличие метода в scope разделе токена
 	if _, needCheck := secureMethodsMap[i.FullMethod]; needCheck {
-		meta, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
-		}
-
-		token := meta.Get(authHeader)
-		if len(token) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "token is not provided")
-		}
-
-		if !strings.HasPrefix(token[0], authPrefix) {
-			return nil, status.Error(codes.Unauthenticated, "invalid auth header format")
-		}
-
-		accessToken := strings.TrimPrefix(token[0], authPrefix)
-		user, err := auth.ParseToken(accessToken, []byte(secretKey))
+		user, err := userFromMetadata(ctx)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, err
 		}
 
 		if !slices.Contains(user.Scope, i.FullMethod) {
 			return nil, status.Error(codes.PermissionDenied, "нет доступа")
 		}
 
+		if statusCheck != nil {
+			if err = statusCheck(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+
+		ctx = auth.AddUserToContext(ctx, user)
+	} else if user, err := userFromMetadata(ctx); err == nil && (statusCheck == nil || statusCheck(ctx, user) == nil) {
+		//открытый метод тоже получает пользователя, если передан валидный токен:
+		//например Create, где администратор создает администратора
 		ctx = auth.AddUserToContext(ctx, user)
 	}
 
 	return handler(ctx, req)
 }
+
+// userFromMetadata разбирает access-токен из заголовка Authorization
+func userFromMetadata(ctx context.Context) (auth.JWTUser, error) {
+	meta, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return auth.JWTUser{}, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	token := meta.Get(authHeader)
+	if len(token) == 0 {
+		return auth.JWTUser{}, status.Error(codes.Unauthenticated, "token is not provided")
+	}
+
+	if !strings.HasPrefix(token[0], authPrefix) {
+		return auth.JWTUser{}, status.Error(codes.Unauthenticated, "invalid auth header format")
+	}
+
+	accessToken := strings.TrimPrefix(token[0], authPrefix)
+	user, err := auth.ParseToken(accessToken, []byte(secretKey), previousKeys...)
+	if err != nil {
+		return auth.JWTUser{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return user, nil
+}
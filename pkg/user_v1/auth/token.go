@@ -28,8 +28,21 @@ func GenerateToken(user JWTUser, secretKey []byte, duration time.Duration) (stri
 	return token.SignedString(secretKey)
 }
 
-// ParseToken парсит токен и проверяет его валидность
-func ParseToken(tokenString string, secretKey []byte) (JWTUser, error) {
+// ParseToken парсит токен и проверяет его валидность.
+// previousKeys - прежние ключи подписи, токены которых еще принимаются после ротации ключа
+func ParseToken(tokenString string, secretKey []byte, previousKeys ...[]byte) (JWTUser, error) {
+	user, err := parseToken(tokenString, secretKey)
+	for _, key := range previousKeys {
+		if !errors.Is(err, ErrTokenInvalid) {
+			break
+		}
+		user, err = parseToken(tokenString, key)
+	}
+
+	return user, err
+}
+
+func parseToken(tokenString string, secretKey []byte) (JWTUser, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &ClaimUser{}, func(token *jwt.Token) (interface{}, error) {
 		return secretKey, nil
 	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
@@ -48,8 +61,9 @@ func ParseToken(tokenString string, secretKey []byte) (JWTUser, error) {
 	}
 
 	return JWTUser{
-		ID:      claims.JWTUser.ID,
-		IsAdmin: claims.JWTUser.IsAdmin,
-		Scope:   claims.JWTUser.Scope,
+		ID:             claims.JWTUser.ID,
+		IsAdmin:        claims.JWTUser.IsAdmin,
+		Scope:          claims.JWTUser.Scope,
+		SessionVersion: claims.JWTUser.SessionVersion,
 	}, nil
 }
@@ -7,6 +7,8 @@ type JWTUser struct {
 	ID      int64    `json:"user_id"`
 	IsAdmin bool     `json:"is_admin"`
 	Scope   []string `json:"scope"`
+	// SessionVersion поколение сессий пользователя: после отзыва сессий токены прежних поколений не продлеваются
+	SessionVersion int64 `json:"sv,omitempty"`
 }
 
 // ClaimUser данные для помещения в токен